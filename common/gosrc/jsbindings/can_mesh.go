@@ -0,0 +1,60 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for CanMesh.                                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function CanMesh. The inputs are the number of points  *
+ *  along the horizontal and vertical axes, and optionally the mesh type, the *
+ *  square wireframe by default. Returns an object with the fields fits,      *
+ *  meshByteLength, and indexByteLength, so a front-end can rule out the      *
+ *  resolutions that do not fit before allocating its typed arrays.           */
+func CanMesh(this js.Value, args []js.Value) interface{} {
+
+    /*  The mesh type is optional, use the square wireframe by default.       */
+    var meshType uint = threetools.SquareWireframe
+
+    if len(args) < 2 {
+        return jsResult(ErrMissingArguments)
+    }
+
+    if len(args) > 2 {
+        meshType = uint(args[2].Int())
+    }
+
+    fits, meshBytes, indexBytes := threetools.CanMesh(
+        uint32(args[0].Int()), uint32(args[1].Int()), meshType,
+    )
+
+    return map[string]interface{}{
+        "fits": fits,
+        "meshByteLength": meshBytes,
+        "indexByteLength": indexBytes,
+    }
+}
+/*  End of CanMesh.                                                           */
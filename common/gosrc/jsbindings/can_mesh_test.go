@@ -0,0 +1,85 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the canMesh binding.                                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the fields of the result, threetools gives the grid limits.      */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  The largest grid, 512x512, fits and a single extra point along either     *
+ *  axis does not. The byte lengths are reported either way.                  */
+func TestCanMeshBoundary(t *testing.T) {
+    var cases = []struct {
+        nxPts, nyPts int
+        fits bool
+    }{
+        {512, 512, true},
+        {513, 512, false},
+        {512, 513, false},
+        {2, 2, true},
+    }
+
+    for _, c := range cases {
+        var result js.Value = js.ValueOf(
+            CanMesh(js.Undefined(), jsArgs(c.nxPts, c.nyPts)),
+        )
+
+        _, _, indexBytes := threetools.CanMesh(
+            uint32(c.nxPts), uint32(c.nyPts), threetools.SquareWireframe,
+        )
+
+        if result.Get("fits").Bool() != c.fits {
+            t.Errorf("%dx%d: fits is %v, want %v",
+                     c.nxPts, c.nyPts, result.Get("fits"), c.fits)
+        }
+
+        if result.Get("meshByteLength").Int() != 12 * c.nxPts * c.nyPts {
+            t.Errorf("%dx%d: meshByteLength is %v, want %d", c.nxPts,
+                     c.nyPts, result.Get("meshByteLength"),
+                     12 * c.nxPts * c.nyPts)
+        }
+
+        if result.Get("indexByteLength").Int() != indexBytes {
+            t.Errorf("%dx%d: indexByteLength is %v, want %d", c.nxPts,
+                     c.nyPts, result.Get("indexByteLength"), indexBytes)
+        }
+    }
+}
+/*  End of TestCanMeshBoundary.                                               */
+
+/*  Without both dimensions the binding reports the missing arguments.        */
+func TestCanMeshMissingArguments(t *testing.T) {
+    var result js.Value = js.ValueOf(CanMesh(js.Undefined(), jsArgs(512)))
+
+    if result.Get("ok").Bool() ||
+       (result.Get("error").String() != ErrMissingArguments.Error()) {
+        t.Errorf("result is {ok: %v, error: %v}, want the missing arguments",
+                 result.Get("ok"), result.Get("error"))
+    }
+}
+/*  End of TestCanMeshMissingArguments.                                       */
@@ -0,0 +1,39 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Looks up the canvas referred to by the arguments of a JS binding.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Returns the canvas given by the optional leading canvas id, and the rest  *
+ *  of the arguments. The canvas is nil if the id is not registered. The      *
+ *  caller should hold threetools.CanvasLock.                                 */
+func canvasFromArgs(args []js.Value) (*threetools.Canvas, []js.Value) {
+    id, rest := canvasIdFromArgs(args)
+    return threetools.GetCanvas(id), rest
+}
+/*  End of canvasFromArgs.                                                    */
@@ -0,0 +1,49 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Selects the canvas for bindings whose inputs are all numbers.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Returns the canvas for a binding that expects count numeric inputs, and   *
+ *  those inputs. Since the canvas id is a number as well, it is only read    *
+ *  from the first argument if there are more than count arguments. The       *
+ *  canvas is nil if the id is not registered or too few arguments were       *
+ *  given. The caller should hold threetools.CanvasLock.                      */
+func canvasFromNumericArgs(args []js.Value,
+                           count int) (*threetools.Canvas, []js.Value) {
+    if len(args) < count {
+        return nil, args
+    }
+
+    if len(args) > count {
+        return canvasFromArgs(args)
+    }
+
+    return threetools.GetCanvas(0), args
+}
+/*  End of canvasFromNumericArgs.                                             */
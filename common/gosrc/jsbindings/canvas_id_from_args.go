@@ -0,0 +1,41 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Parses the optional canvas id passed to the JS bindings.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js.Value type provided here, as is the TypeNumber constant.               */
+import "syscall/js"
+
+/*  Splits an optional leading canvas id off of the arguments of a binding.   *
+ *  If the first argument is not a number the main canvas, id 0, is used and  *
+ *  the arguments are returned unchanged. This keeps the bindings backwards   *
+ *  compatible with code written for the single canvas.                       */
+func canvasIdFromArgs(args []js.Value) (int, []js.Value) {
+    if (len(args) > 0) && (args[0].Type() == js.TypeNumber) {
+        return args[0].Int(), args[1:]
+    }
+
+    return 0, args
+}
+/*  End of canvasIdFromArgs.                                                  */
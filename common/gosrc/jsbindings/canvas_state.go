@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for reading the geometry of a canvas.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Returns the geometry and sizes of a canvas, for debugging from the        *
+ *  browser console. An optional canvas id may be passed, the main canvas is  *
+ *  used by default. The canvas is not modified. Returns an object with the   *
+ *  fields of the canvas, or nil if the canvas does not exist.                */
+func CanvasState(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being read.       */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, _ := canvasFromArgs(args)
+
+    if canvas == nil {
+        return nil
+    }
+
+    return map[string]interface{}{
+        "nxPts": canvas.NxPts,
+        "nyPts": canvas.NyPts,
+        "width": canvas.Width,
+        "height": canvas.Height,
+        "horizontalStart": canvas.HorizontalStart,
+        "verticalStart": canvas.VerticalStart,
+        "meshType": canvas.MeshType,
+        "numberOfPoints": canvas.NumberOfPoints,
+        "meshSize": canvas.MeshSize,
+        "indexSize": canvas.IndexSize,
+    }
+}
+/*  End of CanvasState.                                                       */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for reading the state of a canvas through the bindings.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the fields of the result, threetools gives the mesh types.       */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  A 6x4 paraboloid on the default domain, [-1, 1] x [-1, 1], is reported    *
+ *  with its grid, domain, mesh type, and the sizes of its buffers.           */
+func TestCanvasStateFields(t *testing.T) {
+    var id int = newBindingCanvas(t, 6, 4)
+    var state js.Value = js.ValueOf(CanvasState(js.Undefined(), jsArgs(id)))
+
+    var want map[string]float64 = map[string]float64{
+        "nxPts": 6,
+        "nyPts": 4,
+        "width": 2.0,
+        "height": 2.0,
+        "horizontalStart": -1.0,
+        "verticalStart": -1.0,
+        "meshType": float64(threetools.SquareWireframe),
+        "numberOfPoints": 24,
+        "meshSize": 72,
+        "indexSize": 2 * (5 * 4 + 3 * 6),
+    }
+
+    if state.Type() != js.TypeObject {
+        t.Fatalf("canvasState(%d) is %v, want an object", id, state)
+    }
+
+    for name, value := range want {
+        if got := state.Get(name); got.Float() != value {
+            t.Errorf("%s is %v, want %g", name, got, value)
+        }
+    }
+}
+/*  End of TestCanvasStateFields.                                             */
+
+/*  A canvas that does not exist gives null.                                  */
+func TestCanvasStateUnknownCanvas(t *testing.T) {
+    var state js.Value = js.ValueOf(CanvasState(js.Undefined(), jsArgs(-1)))
+
+    if !state.IsNull() {
+        t.Errorf("canvasState(-1) is %v, want null", state)
+    }
+}
+/*  End of TestCanvasStateUnknownCanvas.                                      */
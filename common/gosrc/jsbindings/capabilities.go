@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for Capabilities.                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Converts a list of names into a value that js.ValueOf accepts.            */
+func namesToArray(names []string) []interface{} {
+    var array []interface{} = make([]interface{}, len(names))
+
+    for index, name := range names {
+        array[index] = name
+    }
+
+    return array
+}
+
+/*  Wrapper for the Go function Capabilities. Takes no arguments and returns  *
+ *  an object with the version and the lists of mesh types, export formats,   *
+ *  surfaces, colormaps, and feature flags this build supports.               */
+func Capabilities(this js.Value, args []js.Value) interface{} {
+
+    /*  The surface registry is shared, hold the lock while reading it.       */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    var report threetools.CapabilityReport = threetools.Capabilities()
+
+    return map[string]interface{}{
+        "version": report.Version,
+        "meshTypes": namesToArray(report.MeshTypes),
+        "exportFormats": namesToArray(report.ExportFormats),
+        "surfaces": namesToArray(report.Surfaces),
+        "colormaps": namesToArray(report.Colormaps),
+        "features": namesToArray(report.Features),
+    }
+}
+/*  End of Capabilities.                                                      */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the capabilities binding.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the fields of the result, threetools gives the expected version. */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  The capabilities object has the version and a non-empty list for each of  *
+ *  the expected keys, with every mesh type and the main feature flags.       */
+func TestCapabilitiesKeys(t *testing.T) {
+    var report js.Value = js.ValueOf(Capabilities(js.Undefined(), nil))
+
+    if report.Get("version").String() != threetools.Version {
+        t.Errorf("version is %v, want %q",
+                 report.Get("version"), threetools.Version)
+    }
+
+    var keys = []string{
+        "meshTypes", "exportFormats", "surfaces", "colormaps", "features",
+    }
+
+    for _, key := range keys {
+        var list js.Value = report.Get(key)
+
+        if (list.Type() != js.TypeObject) || (list.Length() == 0) {
+            t.Errorf("%s is %v, want a non-empty array", key, list)
+        }
+    }
+
+    if report.Get("meshTypes").Length() != 12 {
+        t.Errorf("%d mesh types, want 12", report.Get("meshTypes").Length())
+    }
+
+    /*  The flags a front-end checks before using the newer bindings.         */
+    var wanted = []string{"multipleCanvases", "doubleBuffering", "wrap"}
+    var features js.Value = report.Get("features")
+
+    for _, feature := range wanted {
+        var found bool = false
+
+        for index := 0; index < features.Length(); index++ {
+            found = found || (features.Index(index).String() == feature)
+        }
+
+        if !found {
+            t.Errorf("the feature %q is missing", feature)
+        }
+    }
+}
+/*  End of TestCapabilitiesKeys.                                              */
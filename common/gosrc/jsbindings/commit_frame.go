@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for CommitFrame.                                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function CommitFrame, called once per animation frame  *
+ *  after the rotation is stepped, in place of flushMesh and swapBuffers. An  *
+ *  optional canvas id may be passed, the main canvas is used by default.     *
+ *  Returns a result object, see jsResult, with the fields regenerated, true  *
+ *  if the index buffer may have changed too, and frontAddress, the address   *
+ *  of the mesh to upload.                                                    */
+func CommitFrame(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, _ := canvasFromArgs(args)
+
+    if canvas == nil {
+        return jsResult(ErrUnknownCanvas)
+    }
+
+    regenerated, err := canvas.CommitFrame()
+    var result map[string]interface{} = jsResult(err)
+
+    result["regenerated"] = regenerated
+    result["frontAddress"] = canvas.FrontMeshAddress()
+    return result
+}
+/*  End of CommitFrame.                                                       */
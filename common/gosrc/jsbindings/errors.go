@@ -0,0 +1,39 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Error values reported by the JavaScript bindings.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  Standard library package for creating error values.                       */
+import "errors"
+
+var (
+    /*  The canvas id passed to a binding has not been registered.            */
+    ErrUnknownCanvas = errors.New("jsbindings: no canvas with this id")
+
+    /*  A binding was called with fewer arguments than it needs.              */
+    ErrMissingArguments = errors.New("jsbindings: too few arguments")
+
+    /*  A required field of a JavaScript object is missing or not a number.   */
+    ErrMissingField = errors.New("jsbindings: required field is missing")
+)
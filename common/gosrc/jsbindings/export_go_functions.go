@@ -34,10 +34,64 @@ func ExportGoFunctions() {
     var window js.Value = js.Global()
 
     /*  Create JavaScript wrappers for the functions with standard camel case.*/
+    window.Set("animateRipple", js.FuncOf(AnimateRipple))
+    window.Set("animateSurface", js.FuncOf(AnimateSurface))
+    window.Set("buildInterleaved", js.FuncOf(BuildInterleaved))
+    window.Set("canMesh", js.FuncOf(CanMesh))
+    window.Set("canvasState", js.FuncOf(CanvasState))
+    window.Set("capabilities", js.FuncOf(Capabilities))
+    window.Set("commitFrame", js.FuncOf(CommitFrame))
+    window.Set("computeGradient", js.FuncOf(ComputeGradient))
+    window.Set("computeNormals", js.FuncOf(ComputeNormals))
+    window.Set("computeTangents", js.FuncOf(ComputeTangents))
+    window.Set("dragRotate", js.FuncOf(DragRotate))
+    window.Set("faceCount", js.FuncOf(FaceCount))
+    window.Set("fitCamera", js.FuncOf(FitCamera))
+    window.Set("flushMesh", js.FuncOf(FlushMesh))
+    window.Set("frontMeshAddress", js.FuncOf(FrontMeshAddress))
+    window.Set("generateQuadFaces", js.FuncOf(GenerateQuadFaces))
+    window.Set("generateReferenceGrid", js.FuncOf(GenerateReferenceGrid))
+    window.Set("generateUVs", js.FuncOf(GenerateUVs))
+    window.Set("generateWireframeAndFaces",
+               js.FuncOf(GenerateWireframeAndFaces))
+    window.Set("heightRange", js.FuncOf(HeightRange))
     window.Set("indexBufferAddress", js.FuncOf(IndexBufferAddress))
+    window.Set("indexByteLength", js.FuncOf(IndexByteLength))
+    window.Set("indexSegmentCount", js.FuncOf(IndexSegmentCount))
+    window.Set("interleavedStride", js.FuncOf(InterleavedStride))
+    window.Set("loadMeshFromBase64", js.FuncOf(LoadMeshFromBase64))
     window.Set("mainCanvasAddress", js.FuncOf(MainCanvasAddress))
+    window.Set("memoryUsage", js.FuncOf(MemoryUsage))
+    window.Set("meshAsBase64", js.FuncOf(MeshAsBase64))
     window.Set("meshBufferAddress", js.FuncOf(MeshBufferAddress))
+    window.Set("meshByteLength", js.FuncOf(MeshByteLength))
+    window.Set("meshVertexCount", js.FuncOf(MeshVertexCount))
+    window.Set("newCanvas", js.FuncOf(NewCanvas))
+    window.Set("oscillateRotation", js.FuncOf(OscillateRotation))
+    window.Set("pauseRotation", js.FuncOf(PauseRotation))
+    window.Set("pickNearestVertex", js.FuncOf(PickNearestVertex))
+    window.Set("quadFaceCount", js.FuncOf(QuadFaceCount))
+    window.Set("regenerateMesh", js.FuncOf(RegenerateMesh))
+    window.Set("removeDegenerateSegments", js.FuncOf(RemoveDegenerateSegments))
+    window.Set("resumeRotation", js.FuncOf(ResumeRotation))
     window.Set("zRotateMainCanvas", js.FuncOf(RotateMainCanvas))
+    window.Set("scalarFieldAddress", js.FuncOf(ScalarFieldAddress))
+    window.Set("selectSurface", js.FuncOf(SelectSurface))
+    window.Set("setAngularSpeed", js.FuncOf(SetAngularSpeed))
+    window.Set("setAngularVelocity", js.FuncOf(SetAngularVelocity))
+    window.Set("setDomain", js.FuncOf(SetDomain))
+    window.Set("setHeightData", js.FuncOf(SetHeightData))
+    window.Set("setHeightTarget", js.FuncOf(SetHeightTarget))
+    window.Set("setMeshType", js.FuncOf(SetMeshType))
+    window.Set("setParaboloidShape", js.FuncOf(SetParaboloidShape))
     window.Set("setRotationAngle", js.FuncOf(SetRotationAngle))
+    window.Set("setRotationDamping", js.FuncOf(SetRotationDamping))
+    window.Set("setSurfaceCoefficients", js.FuncOf(SetSurfaceCoefficients))
+    window.Set("setTumble", js.FuncOf(SetTumble))
+    window.Set("stepByTime", js.FuncOf(StepByTime))
+    window.Set("stepRotation", js.FuncOf(StepRotation))
+    window.Set("swapBuffers", js.FuncOf(SwapBuffers))
+    window.Set("trianglePickBuffer", js.FuncOf(TrianglePickBuffer))
+    window.Set("vertexAt", js.FuncOf(VertexAt))
 }
 /*  End of ExportGoFunctions.                                                 */
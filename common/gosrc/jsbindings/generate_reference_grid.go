@@ -0,0 +1,59 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for GenerateReferenceGrid.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function GenerateReferenceGrid. The input is the       *
+ *  number of divisions along each axis, optionally preceded by a canvas id,  *
+ *  the main canvas is used by default. Returns a result object, see          *
+ *  jsResult, with the addresses and lengths of the vertex and index buffers  *
+ *  of the grid, vertexAddress, vertexCount, indexAddress, and indexCount.    */
+func GenerateReferenceGrid(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, values := canvasFromNumericArgs(args, 1)
+
+    if canvas == nil {
+        return jsResult(numericArgsError(values, 1))
+    }
+
+    var divisions uint32 = uint32(values[len(values) - 1].Int())
+    threetools.GenerateReferenceGrid(canvas, divisions)
+
+    var result map[string]interface{} = jsResult(nil)
+    result["vertexAddress"] = threetools.SliceAddress(canvas.ReferenceMesh)
+    result["vertexCount"] = len(canvas.ReferenceMesh) / 3
+    result["indexAddress"] = threetools.SliceAddress(canvas.ReferenceIndices)
+    result["indexCount"] = len(canvas.ReferenceIndices)
+    return result
+}
+/*  End of GenerateReferenceGrid.                                             */
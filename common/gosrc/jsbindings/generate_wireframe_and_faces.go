@@ -0,0 +1,62 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for GenerateWireframeAndFaces.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function GenerateWireframeAndFaces. An optional canvas *
+ *  id may be passed, followed by an optional boolean for generating the      *
+ *  faces, true by default. Returns a result object, see jsResult, with the   *
+ *  fields indexSize, faceAddress, and faceCount added.                       */
+func GenerateWireframeAndFaces(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, rest := canvasFromArgs(args)
+
+    if canvas == nil {
+        return jsResult(ErrUnknownCanvas)
+    }
+
+    var withFaces bool = true
+
+    if (len(rest) > 0) && (rest[0].Type() == js.TypeBoolean) {
+        withFaces = rest[0].Bool()
+    }
+
+    var result map[string]interface{} =
+        jsResult(canvas.GenerateWireframeAndFaces(withFaces))
+
+    result["indexSize"] = canvas.IndexSize
+    result["faceAddress"] = threetools.SliceAddress(canvas.Faces)
+    result["faceCount"] = canvas.FaceCount()
+    return result
+}
+/*  End of GenerateWireframeAndFaces.                                         */
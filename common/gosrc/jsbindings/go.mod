@@ -1,4 +1,7 @@
 module jsbindings
+
 go 1.19
 
 replace common/threetools => ../threetools
+
+require common/threetools v0.0.0-00010101000000-000000000000
@@ -0,0 +1,78 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Helpers shared by the tests of the JavaScript bindings. The tests run *
+ *      under node, see go_js_wasm_exec in the lib/wasm directory of the Go   *
+ *      installation.                                                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js gives the argument values, threetools the canvases.                    */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Creates a canvas with the graph of z = x^2 + y^2 on the given grid and    *
+ *  its wireframe, and returns its id.                                        */
+func newBindingCanvas(t *testing.T, nxPts, nyPts uint32) int {
+    t.Helper()
+
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    id, err := threetools.NewCanvas(threetools.WithGrid(nxPts, nyPts))
+
+    if err != nil {
+        t.Fatalf("NewCanvas: %v", err)
+    }
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    err = canvas.GenerateMeshFromParametrization(
+        func(x, y float32) float32 { return x*x + y*y },
+    )
+
+    if err == nil {
+        err = canvas.GenerateRectangularWireframe()
+    }
+
+    if err != nil {
+        t.Fatalf("setting up canvas %d: %v", id, err)
+    }
+
+    return id
+}
+/*  End of newBindingCanvas.                                                  */
+
+/*  Converts Go values into the arguments of a binding.                       */
+func jsArgs(values ...interface{}) []js.Value {
+    var args []js.Value = make([]js.Value, len(values))
+
+    for index, value := range values {
+        args[index] = js.ValueOf(value)
+    }
+
+    return args
+}
+/*  End of jsArgs.                                                            */
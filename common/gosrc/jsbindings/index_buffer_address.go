@@ -29,8 +29,21 @@ import (
     "common/threetools"
 )
 
-/*  Wrapper for the Go function IndexBufferAddress.                           */
+/*  Wrapper for the Go function IndexBufferAddress. An optional canvas id may *
+ *  be passed, the main canvas is used by default. Returns zero for unknown   *
+ *  ids.                                                                      */
 func IndexBufferAddress(this js.Value, args []js.Value) interface{} {
-    return threetools.IndexBufferAddress()
+    id, _ := canvasIdFromArgs(args)
+
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return 0
+    }
+
+    return canvas.IndexStorageAddress()
 }
 /*  End of IndexBufferAddress.                                                */
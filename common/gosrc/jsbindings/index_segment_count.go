@@ -0,0 +1,50 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for the number of line segments in the main     *
+ *      canvas.                                                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Returns the number of line segments in the index buffer of a canvas, see  *
+ *  SegmentCount. An optional canvas id may be passed, the main canvas is     *
+ *  used by default.                                                          */
+func IndexSegmentCount(this js.Value, args []js.Value) interface{} {
+    id, _ := canvasIdFromArgs(args)
+
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return 0
+    }
+
+    return canvas.SegmentCount()
+}
+/*  End of IndexSegmentCount.                                                 */
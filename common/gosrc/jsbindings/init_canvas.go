@@ -25,35 +25,146 @@
 package jsbindings
 
 import (
+    "fmt"
+    "math"
     "syscall/js"
     "common/threetools"
 )
 
-/*  Initializes the global canvas from a JavaScript struct.                   */
-func InitCanvas(args []js.Value) {
+/*  Returns the field of a JavaScript object as a number, and false if the    *
+ *  field is missing, or is not a number, or is NaN.                          */
+func numberField(jsObject js.Value, name string) (float64, bool) {
+    var field js.Value = jsObject.Get(name)
+
+    if field.Type() != js.TypeNumber {
+        return 0.0, false
+    }
+
+    var value float64 = field.Float()
+    return value, !math.IsNaN(value)
+}
+
+/*  Initializes a canvas from a JavaScript struct. An optional canvas id may  *
+ *  be passed before the struct, the main canvas is used by default. Only     *
+ *  nxPts and nyPts are required, the other fields have defaults. Returns the *
+ *  id of the canvas, or -1 and the reason if the canvas could not be set up. *
+ *  The caller should hold threetools.CanvasLock.                             */
+func InitCanvas(args []js.Value) (int, error) {
+
+    /*  Split off the canvas id, if one was given.                            */
+    id, rest := canvasIdFromArgs(args)
+
+    if len(rest) == 0 {
+        return -1, ErrMissingArguments
+    }
 
     /*  The input is a JavaScript struct with the requested geometry.         */
-    var jsObject js.Value = args[0]
-
-    /*  We store all of the information in the global Go variables.           */
-    var canvas *threetools.Canvas = &threetools.MainCanvas
-    var meshBuffer []float32 = threetools.MeshBuffer[:]
-    var indexBuffer []uint32 = threetools.IndexBuffer[:]
-
-    /*  The JavaScript struct contains the number of points in the x and y    *
-     *  axes, the physical width and height (in the same units) of the mesh,  *
-     *  the starting points for the x and y axes, and the type of mesh being  *
-     *  used. Unpack all of this from the input.                              */
-    canvas.NxPts = uint32(jsObject.Get("nxPts").Int())
-    canvas.NyPts = uint32(jsObject.Get("nyPts").Int())
-    canvas.Width = float32(jsObject.Get("width").Float())
-    canvas.Height = float32(jsObject.Get("height").Float())
-    canvas.HorizontalStart = float32(jsObject.Get("xStart").Float())
-    canvas.VerticalStart = float32(jsObject.Get("yStart").Float())
-    canvas.MeshType = uint(jsObject.Get("meshType").Int())
-
-    /*  The main canvas variables are set, we can compute the rest from this. */
-    canvas.ResetMeshBuffer(meshBuffer)
-    canvas.ResetIndexBuffer(indexBuffer)
+    var jsObject js.Value = rest[0]
+
+    /*  We store all of the information in the requested canvas, using the    *
+     *  memory that belongs to it for the mesh and index buffers.             */
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return -1, ErrUnknownCanvas
+    }
+
+    /*  The number of points in the x and y axes are required, a missing      *
+     *  field would otherwise be read as zero.                                */
+    nxPts, hasNx := numberField(jsObject, "nxPts")
+    nyPts, hasNy := numberField(jsObject, "nyPts")
+
+    if !hasNx || !hasNy {
+        return -1, fmt.Errorf(
+            "%w: nxPts and nyPts must be numbers", ErrMissingField,
+        )
+    }
+
+    /*  The physical width and height (in the same units) of the mesh, the    *
+     *  starting points for the x and y axes, and the type of mesh are        *
+     *  optional. The domain defaults to a width and height of 2, centered    *
+     *  at the origin, and the mesh to a square wireframe.                    */
+    width, hasWidth := numberField(jsObject, "width")
+    height, hasHeight := numberField(jsObject, "height")
+
+    if !hasWidth {
+        width = 2.0
+    }
+
+    if !hasHeight {
+        height = 2.0
+    }
+
+    xStart, hasXStart := numberField(jsObject, "xStart")
+    yStart, hasYStart := numberField(jsObject, "yStart")
+
+    if !hasXStart {
+        xStart = -0.5 * width
+    }
+
+    if !hasYStart {
+        yStart = -0.5 * height
+    }
+
+    var meshType uint = threetools.SquareWireframe
+
+    if jsObject.Get("meshType").Type() == js.TypeNumber {
+        meshType = uint(jsObject.Get("meshType").Int())
+    }
+
+    var options []threetools.CanvasOption = []threetools.CanvasOption{
+        threetools.WithGrid(uint32(int(nxPts)), uint32(int(nyPts))),
+        threetools.WithDomain(
+            float32(width), float32(height), float32(xStart), float32(yStart),
+        ),
+        threetools.WithMeshType(meshType),
+    }
+
+    /*  The wireframe stride and direction are optional, the defaults draw    *
+     *  every line of the full grid.                                          */
+    if jsObject.Get("wireframeStride").Type() == js.TypeNumber {
+        var stride uint32 = uint32(jsObject.Get("wireframeStride").Int())
+        options = append(options, threetools.WithWireframeStride(stride))
+    }
+
+    if jsObject.Get("wireframeDirection").Type() == js.TypeNumber {
+        var direction uint = uint(jsObject.Get("wireframeDirection").Int())
+        options = append(options, threetools.WithWireframeDirection(direction))
+    }
+
+    /*  The seams are open unless closed explicitly, see WrapU and WrapV.     */
+    if jsObject.Get("wrapU").Type() == js.TypeBoolean ||
+       jsObject.Get("wrapV").Type() == js.TypeBoolean {
+        var wrapU bool = jsObject.Get("wrapU").Truthy()
+        var wrapV bool = jsObject.Get("wrapV").Truthy()
+        options = append(options, threetools.WithWrap(wrapU, wrapV))
+    }
+
+    /*  The wireframe is written as disjoint segments unless strips are       *
+     *  requested, see LineStrips.                                            */
+    if jsObject.Get("lineStrips").Type() == js.TypeBoolean {
+        var strips bool = jsObject.Get("lineStrips").Truthy()
+        options = append(options, threetools.WithLineStrips(strips))
+    }
+
+    /*  The grid is stored row by row unless columns are requested.           */
+    if jsObject.Get("columnMajor").Type() == js.TypeBoolean {
+        var columnMajor bool = jsObject.Get("columnMajor").Truthy()
+        options = append(options, threetools.WithColumnMajor(columnMajor))
+    }
+
+    /*  Apply the geometry, this also resets the mesh and index buffers.      */
+    err := canvas.Configure(threetools.NewCanvasConfig(options...))
+
+    if err != nil {
+        return -1, err
+    }
+
+    /*  Fail now, rather than in a generator, if the buffers do not fit.      */
+    if err = canvas.ValidateBuffers(); err != nil {
+        return -1, err
+    }
+
+    return id, nil
 }
 /*  End of InitCanvas.                                                        */
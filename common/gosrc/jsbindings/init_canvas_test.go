@@ -0,0 +1,101 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for setting up a canvas from a JavaScript object.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  errors compares the errors, math finds NaN, threetools has the canvases.  */
+import (
+    "errors"
+    "math"
+    "testing"
+    "common/threetools"
+)
+
+/*  Initializes a new canvas from the fields of an object, holding the lock.  */
+func initFromFields(fields map[string]interface{}) (int, error) {
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    id, err := threetools.NewCanvas()
+
+    if err != nil {
+        return -1, err
+    }
+
+    return InitCanvas(jsArgs(id, fields))
+}
+/*  End of initFromFields.                                                    */
+
+/*  An object without xStart gets the centered default, so the mesh starts at *
+ *  -width / 2 rather than at NaN, while the given yStart is kept.            */
+func TestInitCanvasMissingXStart(t *testing.T) {
+    id, err := initFromFields(map[string]interface{}{
+        "nxPts": 5, "nyPts": 4, "width": 4.0, "yStart": 1.0,
+    })
+
+    if err != nil {
+        t.Fatalf("InitCanvas: %v", err)
+    }
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if (canvas.HorizontalStart != -2.0) || (canvas.VerticalStart != 1.0) ||
+       (canvas.Width != 4.0) || (canvas.Height != 2.0) {
+        t.Errorf("domain starts at (%g, %g) with size %g x %g, " +
+                 "want (-2, 1) and 4 x 2", canvas.HorizontalStart,
+                 canvas.VerticalStart, canvas.Width, canvas.Height)
+    }
+
+    err = canvas.GenerateMeshFromParametrization(threetools.Plane)
+
+    if err != nil {
+        t.Fatalf("GenerateMeshFromParametrization: %v", err)
+    }
+
+    for index, value := range canvas.Mesh {
+        if math.IsNaN(float64(value)) {
+            t.Fatalf("mesh entry %d is NaN", index)
+        }
+    }
+
+    if canvas.Mesh[0] != -2.0 {
+        t.Errorf("first vertex has x = %g, want -2", canvas.Mesh[0])
+    }
+}
+/*  End of TestInitCanvasMissingXStart.                                       */
+
+/*  A missing or non-numeric required field is reported.                      */
+func TestInitCanvasMissingRequiredField(t *testing.T) {
+    var objects = []map[string]interface{}{
+        {"nxPts": 5},
+        {"nxPts": 5, "nyPts": "4"},
+    }
+
+    for _, fields := range objects {
+        if _, err := initFromFields(fields); !errors.Is(err, ErrMissingField) {
+            t.Errorf("fields %v: got %v, want ErrMissingField", fields, err)
+        }
+    }
+}
+/*  End of TestInitCanvasMissingRequiredField.                                */
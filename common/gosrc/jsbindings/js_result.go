@@ -0,0 +1,38 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Converts a Go error into a result object for JavaScript.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  Creates the object returned to JavaScript by a binding that may fail. On  *
+ *  success this is {ok: true}, on failure {ok: false, error: message}.       *
+ *  Bindings with an output add it as another field of the object. This is    *
+ *  converted to a JavaScript object by js.ValueOf.                           */
+func jsResult(err error) map[string]interface{} {
+    if err != nil {
+        return map[string]interface{}{"ok": false, "error": err.Error()}
+    }
+
+    return map[string]interface{}{"ok": true}
+}
+/*  End of jsResult.                                                          */
@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the loadMeshFromBase64 binding.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the result objects, threetools gives the canvases.               */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Calls loadMeshFromBase64 with the given arguments.                        */
+func loadMesh(values ...interface{}) js.Value {
+    return js.ValueOf(LoadMeshFromBase64(js.Undefined(), jsArgs(values...)))
+}
+/*  End of loadMesh.                                                          */
+
+/*  A mesh saved by meshAsBase64 is restored into another canvas of the same  *
+ *  size, and the result object reports success.                              */
+func TestLoadMeshFromBase64RoundTrip(t *testing.T) {
+    var source int = newBindingCanvas(t, 5, 4)
+    var target int = newBindingCanvas(t, 5, 4)
+    var encoded string = MeshAsBase64(js.Undefined(), jsArgs(source)).(string)
+
+    threetools.GetCanvas(target).Mesh[2] = 100.0
+    expectBindingOk(t, "loadMeshFromBase64", LoadMeshFromBase64,
+                    target, encoded)
+
+    var want []float32 = threetools.GetCanvas(source).Mesh
+    var got []float32 = threetools.GetCanvas(target).Mesh
+
+    for index := range want {
+        if got[index] != want[index] {
+            t.Fatalf("mesh[%d] is %g, want %g", index, got[index], want[index])
+        }
+    }
+}
+/*  End of TestLoadMeshFromBase64RoundTrip.                                   */
+
+/*  A failed load gives the reason rather than only false.                    */
+func TestLoadMeshFromBase64Errors(t *testing.T) {
+    var id int = newBindingCanvas(t, 5, 4)
+
+    var cases = []struct {
+        name string
+        result js.Value
+        want string
+    }{
+        {"unknown canvas", loadMesh(-1, "AAAA"), ErrUnknownCanvas.Error()},
+        {"no string", loadMesh(id), ErrMissingArguments.Error()},
+    }
+
+    for _, c := range cases {
+        if c.result.Get("ok").Bool() ||
+           (c.result.Get("error").String() != c.want) {
+            t.Errorf("%s: result is {ok: %v, error: %v}, want %q", c.name,
+                     c.result.Get("ok"), c.result.Get("error"), c.want)
+        }
+    }
+
+    var invalid js.Value = loadMesh(id, "not base64!")
+
+    if invalid.Get("ok").Bool() || (invalid.Get("error").String() == "") {
+        t.Errorf("invalid input: result is {ok: %v, error: %v}, want an error",
+                 invalid.Get("ok"), invalid.Get("error"))
+    }
+}
+/*  End of TestLoadMeshFromBase64Errors.                                      */
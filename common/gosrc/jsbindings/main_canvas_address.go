@@ -29,8 +29,21 @@ import (
     "common/threetools"
 )
 
-/*  Wrapper for the Go function MainCanvasAddress.                            */
+/*  Wrapper for the Go function MainCanvasAddress. An optional canvas id may  *
+ *  be passed, the main canvas is used by default. Returns zero for unknown   *
+ *  ids.                                                                      */
 func MainCanvasAddress(this js.Value, args []js.Value) interface{} {
-    return threetools.MainCanvasAddress()
+    id, _ := canvasIdFromArgs(args)
+
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return 0
+    }
+
+    return canvas.Address()
 }
 /*  End of MainCanvasAddress.                                                 */
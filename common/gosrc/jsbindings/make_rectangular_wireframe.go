@@ -29,11 +29,30 @@ import (
     "common/threetools"
 )
 
-/*  Function for creating a rectangular wireframe in JavaScript.              */
+/*  Function for creating a rectangular wireframe in JavaScript. The          *
+ *  arguments are those of InitCanvas. Returns {ok: true, id: id} with the id *
+ *  of the canvas, or {ok: false, error: message} on failure.                 */
 func
-MakeRectangularWireframe(args []js.Value, f threetools.SurfaceParametrization) {
-    InitCanvas(args)
-    threetools.MainCanvas.GenerateMeshFromParametrization(f)
-    threetools.MainCanvas.GenerateRectangularWireframe()
+MakeRectangularWireframe(args []js.Value,
+                         f threetools.SurfaceParametrization) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    /*  Initialize the requested canvas and generate the wireframe in it.     */
+    id, err := InitCanvas(args)
+
+    if err != nil {
+        return jsResult(err)
+    }
+
+    /*  Keep the surface so that the mesh may be regenerated later.           */
+    threetools.Canvases[id].Surface = f
+    err = threetools.Canvases[id].Regenerate()
+
+    var result map[string]interface{} = jsResult(err)
+    result["id"] = id
+    return result
 }
 /*  End of MakeRectangularWireframe.                                          */
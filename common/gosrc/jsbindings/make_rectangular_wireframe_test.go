@@ -0,0 +1,96 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the result objects returned by MakeRectangularWireframe.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js converts the result to a JavaScript object, threetools gives the       *
+ *  surface and the expected error.                                           */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  A grid wider than MaxWidth is reported to JavaScript as {ok: false,       *
+ *  error: message}, with no id, rather than panicking.                       */
+func TestOversizedGridResult(t *testing.T) {
+    var id int = newBindingCanvas(t, 4, 4)
+    var geometry map[string]interface{} = map[string]interface{}{
+        "nxPts": int(threetools.MaxWidth) + 1,
+        "nyPts": 4,
+    }
+
+    var result js.Value = js.ValueOf(
+        MakeRectangularWireframe(jsArgs(id, geometry), threetools.Plane),
+    )
+
+    if result.Type() != js.TypeObject {
+        t.Fatalf("result has type %v, want an object", result.Type())
+    }
+
+    if result.Get("ok").Bool() {
+        t.Error("ok is true for an oversized grid")
+    }
+
+    var message js.Value = result.Get("error")
+    var want string = threetools.ErrGridTooLarge.Error()
+
+    if (message.Type() != js.TypeString) || (message.String() != want) {
+        t.Errorf("error is %v, want %q", message, want)
+    }
+
+    if !result.Get("id").IsUndefined() {
+        t.Errorf("id is %v for a failed setup", result.Get("id"))
+    }
+
+    /*  The canvas keeps its previous grid.                                   */
+    if threetools.GetCanvas(id).NxPts != 4 {
+        t.Errorf("NxPts is %d, want the previous value 4",
+                 threetools.GetCanvas(id).NxPts)
+    }
+}
+/*  End of TestOversizedGridResult.                                           */
+
+/*  A grid that fits is reported as {ok: true, id: id}.                       */
+func TestValidGridResult(t *testing.T) {
+    var id int = newBindingCanvas(t, 4, 4)
+    var geometry map[string]interface{} = map[string]interface{}{
+        "nxPts": 6,
+        "nyPts": 5,
+    }
+
+    var result js.Value = js.ValueOf(
+        MakeRectangularWireframe(jsArgs(id, geometry), threetools.Plane),
+    )
+
+    if !result.Get("ok").Bool() || (result.Get("id").Int() != id) {
+        t.Errorf("ok = %v, id = %v, want true and %d",
+                 result.Get("ok"), result.Get("id"), id)
+    }
+
+    if !result.Get("error").IsUndefined() {
+        t.Errorf("error is %v for a successful setup", result.Get("error"))
+    }
+}
+/*  End of TestValidGridResult.                                               */
@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for MemoryUsage.                                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function MemoryUsage. An optional canvas id may be     *
+ *  passed, the main canvas is used by default. Returns an object with the    *
+ *  byte counts of the buffers, or nil if the canvas does not exist.          */
+func MemoryUsage(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being read.       */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, _ := canvasFromArgs(args)
+
+    if canvas == nil {
+        return nil
+    }
+
+    var report threetools.MemoryReport = threetools.MemoryUsage(canvas)
+
+    return map[string]interface{}{
+        "meshBytes": report.MeshBytes,
+        "maxMeshBytes": report.MaxMeshBytes,
+        "indexBytes": report.IndexBytes,
+        "maxIndexBytes": report.MaxIndexBytes,
+        "auxiliaryBytes": report.AuxiliaryBytes,
+    }
+}
+/*  End of MemoryUsage.                                                       */
@@ -29,8 +29,21 @@ import (
     "common/threetools"
 )
 
-/*  Wrapper for the Go function MeshBufferAddress.                            */
+/*  Wrapper for the Go function MeshBufferAddress. An optional canvas id may  *
+ *  be passed, the main canvas is used by default. Returns zero for unknown   *
+ *  ids.                                                                      */
 func MeshBufferAddress(this js.Value, args []js.Value) interface{} {
-    return threetools.MeshBufferAddress()
+    id, _ := canvasIdFromArgs(args)
+
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return 0
+    }
+
+    return canvas.MeshStorageAddress()
 }
 /*  End of MeshBufferAddress.                                                 */
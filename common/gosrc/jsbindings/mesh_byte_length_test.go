@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the bindings giving the lengths of the buffers in use.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js gives the receiver, threetools the canvas the lengths are compared     *
+ *  with.                                                                     */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  The byte lengths are four bytes per float of the active mesh and per      *
+ *  index of the active wireframe, MeshSize * 4 and IndexSize * 4, not the    *
+ *  size of the whole buffers.                                                */
+func TestByteLengthsMatchActiveSizes(t *testing.T) {
+    var id int = newBindingCanvas(t, 5, 4)
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+    var meshLength interface{} = MeshByteLength(js.Undefined(), jsArgs(id))
+    var indexLength interface{} = IndexByteLength(js.Undefined(), jsArgs(id))
+
+    if (meshLength != 4 * canvas.MeshSize) ||
+       (meshLength == 4 * len(canvas.MeshStorage)) {
+        t.Errorf("mesh byte length %v, want %d",
+                 meshLength, 4 * canvas.MeshSize)
+    }
+
+    if (indexLength != 4 * canvas.IndexSize) ||
+       (indexLength == 4 * len(canvas.IndexStorage)) {
+        t.Errorf("index byte length %v, want %d",
+                 indexLength, 4 * canvas.IndexSize)
+    }
+
+    /*  An unknown canvas has nothing in use.                                 */
+    if length := MeshByteLength(js.Undefined(), jsArgs(-1)); length != 0 {
+        t.Errorf("mesh byte length %v for an unknown canvas, want 0", length)
+    }
+}
+/*  End of TestByteLengthsMatchActiveSizes.                                   */
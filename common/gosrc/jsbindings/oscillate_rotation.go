@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for OscillateRotation.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function OscillateRotation. The inputs are the         *
+ *  amplitude in radians, the period and the current time in seconds,         *
+ *  optionally preceded by a canvas id. Returns a result object, see          *
+ *  jsResult.                                                                 */
+func OscillateRotation(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, args := canvasFromNumericArgs(args, 3)
+
+    if canvas == nil {
+        return jsResult(numericArgsError(args, 3))
+    }
+
+    threetools.OscillateRotation(
+        canvas,
+        float32(args[0].Float()),
+        float32(args[1].Float()),
+        float32(args[2].Float()),
+    )
+
+    return jsResult(nil)
+}
+/*  End of OscillateRotation.                                                 */
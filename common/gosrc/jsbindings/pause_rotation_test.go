@@ -0,0 +1,107 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for pausing and resuming the rotation through the bindings.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the result objects, threetools gives the canvases.               */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Calls a binding with the given arguments and fails the test if the result *
+ *  object reports an error.                                                  */
+func expectBindingOk(t *testing.T, name string,
+                     binding func(js.Value, []js.Value) interface{},
+                     values ...interface{}) {
+    t.Helper()
+
+    var result js.Value = js.ValueOf(binding(js.Undefined(), jsArgs(values...)))
+
+    if !result.Get("ok").Bool() {
+        t.Fatalf("%s failed: %v", name, result.Get("error"))
+    }
+}
+/*  End of expectBindingOk.                                                   */
+
+/*  Time passed while paused is dropped. A canvas paused between two quarter  *
+ *  second frames, with four frames advanced in between, ends in the same     *
+ *  orientation as one that only saw the two frames, and the first frame      *
+ *  after resuming turns it by one step from where it was paused.             */
+func TestPauseRotationContinuesSmoothly(t *testing.T) {
+    const dt float64 = 0.25
+
+    var previous float32 = threetools.AngularSpeed
+    threetools.SetAngularSpeed(2.0)
+    t.Cleanup(func() { threetools.SetAngularSpeed(previous) })
+
+    var paused int = newBindingCanvas(t, 5, 4)
+    var steady int = newBindingCanvas(t, 5, 4)
+    var canvas *threetools.Canvas = threetools.GetCanvas(paused)
+
+    expectBindingOk(t, "stepByTime", StepByTime, paused, dt)
+    expectBindingOk(t, "pauseRotation", PauseRotation, paused)
+
+    var roll float32 = canvas.Roll
+    var mesh []float32 = append([]float32(nil), canvas.Mesh...)
+
+    for frame := 0; frame < 4; frame++ {
+        expectBindingOk(t, "stepByTime", StepByTime, paused, dt)
+    }
+
+    if canvas.Roll != roll {
+        t.Errorf("roll moved from %g to %g while paused", roll, canvas.Roll)
+    }
+
+    for index := range mesh {
+        if canvas.Mesh[index] != mesh[index] {
+            t.Fatalf("mesh[%d] moved from %g to %g while paused",
+                     index, mesh[index], canvas.Mesh[index])
+        }
+    }
+
+    expectBindingOk(t, "resumeRotation", ResumeRotation, paused)
+    expectBindingOk(t, "stepByTime", StepByTime, paused, dt)
+
+    if diff := canvas.Roll - roll; (diff < 0.499) || (diff > 0.501) {
+        t.Errorf("first frame after resuming turned by %g, want 0.5", diff)
+    }
+
+    for frame := 0; frame < 2; frame++ {
+        expectBindingOk(t, "stepByTime", StepByTime, steady, dt)
+    }
+
+    var reference *threetools.Canvas = threetools.GetCanvas(steady)
+
+    for index := range reference.Mesh {
+        var diff float32 = canvas.Mesh[index] - reference.Mesh[index]
+
+        if (diff < -1.0E-5) || (diff > 1.0E-5) {
+            t.Fatalf("mesh[%d] is %g, want %g as without the pause",
+                     index, canvas.Mesh[index], reference.Mesh[index])
+        }
+    }
+}
+/*  End of TestPauseRotationContinuesSmoothly.                                */
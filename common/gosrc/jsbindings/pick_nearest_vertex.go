@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for PickNearestVertex.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function PickNearestVertex. The inputs are the origin  *
+ *  and the direction of the ray, six numbers, optionally preceded by a       *
+ *  canvas id. Returns an object with the fields xIndex, yIndex, and          *
+ *  distance, or null if the canvas does not exist or has no vertices.        */
+func PickNearestVertex(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being read.       */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, args := canvasFromNumericArgs(args, 6)
+
+    if (canvas == nil) || (canvas.NumberOfPoints == 0) {
+        return nil
+    }
+
+    var origin [3]float32 = [3]float32{
+        float32(args[0].Float()),
+        float32(args[1].Float()),
+        float32(args[2].Float()),
+    }
+
+    var direction [3]float32 = [3]float32{
+        float32(args[3].Float()),
+        float32(args[4].Float()),
+        float32(args[5].Float()),
+    }
+
+    xIndex, yIndex, distance :=
+        threetools.PickNearestVertex(canvas, origin, direction)
+
+    return map[string]interface{}{
+        "xIndex": xIndex,
+        "yIndex": yIndex,
+        "distance": distance,
+    }
+}
+/*  End of PickNearestVertex.                                                 */
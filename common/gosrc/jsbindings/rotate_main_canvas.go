@@ -29,11 +29,30 @@ import (
     "common/threetools"
 )
 
-/*  Wrapper for the Go function RotateMesh.                                   */
+/*  Wrapper for the Go function RotateMesh. An optional canvas id may be      *
+ *  passed, the main canvas is rotated by default. Returns a result object,   *
+ *  see jsResult.                                                             */
 func RotateMainCanvas(this js.Value, args []js.Value) interface{} {
 
+    id, _ := canvasIdFromArgs(args)
+    var canvas *threetools.Canvas
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    /*  Unknown ids are reported, there is nothing to rotate.                 */
+    canvas = threetools.GetCanvas(id)
+
+    if canvas == nil {
+        return jsResult(ErrUnknownCanvas)
+    }
+
     /*  Rotate the points in the mesh by the RotationVector (see globals.go). */
-    threetools.MainCanvas.RotateMesh(threetools.RotationVector)
-    return nil
+    canvas.RotateMesh(threetools.RotationVector)
+
+    /*  Keep the normals, if they have been computed, in sync with the mesh.  */
+    canvas.RotateNormals(threetools.RotationVector)
+    return jsResult(nil)
 }
 /*  End of RotateMainCanvas.                                                  */
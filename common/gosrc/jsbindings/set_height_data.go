@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the heights of the mesh from data in Wasm memory.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function GenerateMeshFromData. The inputs are the      *
+ *  address of a Float32Array of heights in Wasm memory and its length, which *
+ *  must be NxPts * NyPts, optionally preceded by a canvas id. The heights    *
+ *  are given row by row. Returns a result object, see jsResult.              */
+func SetHeightData(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, args := canvasFromNumericArgs(args, 2)
+
+    if canvas == nil {
+        return jsResult(numericArgsError(args, 2))
+    }
+
+    var address uintptr = uintptr(args[0].Int())
+    var length int = args[1].Int()
+
+    /*  The length comes from JavaScript, reject views beyond the largest     *
+     *  grid before reading the memory.                                       */
+    heights, err := threetools.SliceFromAddressChecked[float32](
+        address, length, int(threetools.MaxBufferLength),
+    )
+
+    if err != nil {
+        return jsResult(err)
+    }
+
+    return jsResult(canvas.GenerateMeshFromData(heights))
+}
+/*  End of SetHeightData.                                                     */
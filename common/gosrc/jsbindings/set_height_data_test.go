@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for building the mesh from an array of heights in memory.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the result object, runtime keeps the heights alive, and          *
+ *  threetools gives the address of the array.                                */
+import (
+    "runtime"
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Passing the address of a known array of heights sets the height of every  *
+ *  vertex to the matching entry, row by row, and an array of the wrong       *
+ *  length is rejected with a result object rather than read.                 */
+func TestSetHeightDataMatchesArray(t *testing.T) {
+    var id int = newBindingCanvas(t, 5, 4)
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+    var heights []float32 = make([]float32, 20)
+
+    for index := range heights {
+        heights[index] = 0.25 * float32(index) - 2.0
+    }
+
+    var address int = int(threetools.SliceAddress(heights))
+    var result js.Value = js.ValueOf(
+        SetHeightData(js.Undefined(), jsArgs(id, address, len(heights))),
+    )
+
+    if !result.Get("ok").Bool() {
+        t.Fatalf("setHeightData failed: %v", result.Get("error"))
+    }
+
+    for index, height := range heights {
+        if canvas.Mesh[3 * index + 2] != height {
+            t.Errorf("vertex %d has z = %g, want %g",
+                     index, canvas.Mesh[3 * index + 2], height)
+        }
+    }
+
+    result = js.ValueOf(
+        SetHeightData(js.Undefined(), jsArgs(id, address, len(heights) - 1)),
+    )
+
+    if result.Get("ok").Bool() {
+        t.Errorf("setHeightData accepted 19 heights for a 5x4 grid")
+    }
+
+    runtime.KeepAlive(heights)
+}
+/*  End of TestSetHeightDataMatchesArray.                                     */
@@ -0,0 +1,66 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for morphing the elliptic paraboloid.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Draws the elliptic paraboloid z = x^2 + a y^2 with the given a,           *
+ *  optionally preceded by a canvas id. The preset is drawn first if the      *
+ *  canvas shows another surface, after that only the coefficient changes and *
+ *  the mesh is regenerated, so a slider can morph the surface continuously.  *
+ *  a = 1 is the circular paraboloid, a > 1 is elongated along x, and as a    *
+ *  goes to 0 the surface flattens into a parabolic cylinder. Returns a       *
+ *  result object, see jsResult.                                              */
+func SetParaboloidShape(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, rest := canvasFromArgs(args)
+
+    if canvas == nil {
+        return jsResult(ErrUnknownCanvas)
+    }
+
+    if (len(rest) == 0) || (rest[0].Type() != js.TypeNumber) {
+        return jsResult(ErrMissingArguments)
+    }
+
+    if canvas.Preset != "elliptic_paraboloid" {
+        err := canvas.GenerateSurfacePreset("elliptic_paraboloid")
+
+        if err != nil {
+            return jsResult(err)
+        }
+    }
+
+    canvas.SetCoefficient("a", float32(rest[0].Float()))
+    return jsResult(canvas.Regenerate())
+}
+/*  End of SetParaboloidShape.                                                */
@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for morphing the elliptic paraboloid.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the result object, threetools gives the mesh.                    */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Returns the height of the vertex in column x and row y of a canvas.       */
+func heightAt(canvas *threetools.Canvas, xIndex, yIndex int) float32 {
+    return canvas.Mesh[3 * (yIndex * int(canvas.NxPts) + xIndex) + 2]
+}
+/*  End of heightAt.                                                          */
+
+/*  At a = 1 the surface is rotationally symmetric, swapping x and y on the   *
+ *  square grid gives the same height, so the curvature along x and along y   *
+ *  agree, while a = 3 curves more steeply along y.                           */
+func TestSetParaboloidShapeCircular(t *testing.T) {
+    var id int = newBindingCanvas(t, 7, 7)
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    for _, a := range []float64{1.0, 3.0} {
+        var result js.Value = js.ValueOf(
+            SetParaboloidShape(js.Undefined(), jsArgs(id, a)),
+        )
+
+        if !result.Get("ok").Bool() {
+            t.Fatalf("setParaboloidShape failed: %v", result.Get("error"))
+        }
+
+        for yIndex := 0; yIndex < 7; yIndex++ {
+            for xIndex := 0; xIndex < 7; xIndex++ {
+                /*  The diagonals are symmetric for any a, skip them.         */
+                if (xIndex == yIndex) || (xIndex + yIndex == 6) {
+                    continue
+                }
+
+                var z float32 = heightAt(canvas, xIndex, yIndex)
+                var swapped float32 = heightAt(canvas, yIndex, xIndex)
+                var symmetric bool = (z == swapped)
+
+                if symmetric != (a == 1.0) {
+                    t.Fatalf("a = %g: heights %g and %g at (%d, %d) and " +
+                             "its mirror", a, z, swapped, xIndex, yIndex)
+                }
+            }
+        }
+    }
+}
+/*  End of TestSetParaboloidShapeCircular.                                    */
@@ -25,18 +25,33 @@
 package jsbindings
 
 import (
+    "math"
     "syscall/js"
     "common/threetools"
 )
 
-/*  Wrapper for the Go function SetRotationAngle.                             */
+/*  Wrapper for the Go function SetRotationAngle. Returns a result object,    *
+ *  see jsResult.                                                             */
 func SetRotationAngle(this js.Value, args []js.Value) interface{} {
 
+    /*  Report a call without the input rather than reading past the end.     */
+    if len(args) == 0 {
+        return jsResult(ErrMissingArguments)
+    }
+
     /*  The input is a single float, the new rotation angle.                  */
     var angle float32 = float32(args[0].Float())
 
+    if math.IsNaN(float64(angle)) || math.IsInf(float64(angle), 0) {
+        return jsResult(threetools.ErrNonFinite)
+    }
+
+    /*  The rotation vector is shared with the main canvas, hold the lock.    */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
     /*  Pass the value to the Go function and return.                         */
     threetools.SetRotationAngle(angle)
-    return nil
+    return jsResult(nil)
 }
 /*  End of SetRotationAngle.                                                  */
@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for SetRotationDamping.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "math"
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function SetRotationDamping. Returns a result object,  *
+ *  see jsResult.                                                             */
+func SetRotationDamping(this js.Value, args []js.Value) interface{} {
+
+    /*  Report a call without the input rather than reading past the end.     */
+    if len(args) == 0 {
+        return jsResult(ErrMissingArguments)
+    }
+
+    /*  The input is a single float, the new damping coefficient.             */
+    var damping float32 = float32(args[0].Float())
+
+    if math.IsNaN(float64(damping)) || math.IsInf(float64(damping), 0) {
+        return jsResult(threetools.ErrNonFinite)
+    }
+
+    /*  The damping is shared by all canvases, hold the lock.                 */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    threetools.SetRotationDamping(damping)
+    return jsResult(nil)
+}
+/*  End of SetRotationDamping.                                                */
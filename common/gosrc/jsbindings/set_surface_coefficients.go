@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for setting the coefficients of a surface.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Copies the numeric fields of a JavaScript object, like {a: 1.5}, into the *
+ *  coefficients of the surface family drawn by a canvas, see SetCoefficient, *
+ *  and regenerates the mesh. An optional canvas id may be passed before the  *
+ *  object. Fields that are not numbers, or are not coefficients of the       *
+ *  current preset, are skipped and listed in the ignored field of the result *
+ *  object, see jsResult.                                                     */
+func SetSurfaceCoefficients(this js.Value, args []js.Value) interface{} {
+
+    /*  The names of the fields that were skipped.                            */
+    var ignored []interface{} = []interface{}{}
+
+    /*  The canvases are shared, hold the lock while one is being modified.   */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, rest := canvasFromArgs(args)
+
+    if canvas == nil {
+        return jsResult(ErrUnknownCanvas)
+    }
+
+    if (len(rest) == 0) || (rest[0].Type() != js.TypeObject) {
+        return jsResult(ErrMissingArguments)
+    }
+
+    var keys js.Value = js.Global().Get("Object").Call("keys", rest[0])
+
+    for index := 0; index < keys.Length(); index++ {
+        var name string = keys.Index(index).String()
+        var value js.Value = rest[0].Get(name)
+
+        if (value.Type() != js.TypeNumber) ||
+           !canvas.SetCoefficient(name, float32(value.Float())) {
+            ignored = append(ignored, name)
+        }
+    }
+
+    var result map[string]interface{} = jsResult(canvas.Regenerate())
+    result["ignored"] = ignored
+    return result
+}
+/*  End of SetSurfaceCoefficients.                                            */
@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the setSurfaceCoefficients binding.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the result object, threetools draws the elliptic paraboloid.     */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Setting "a" of the elliptic paraboloid z = x^2 + a y^2 regenerates the    *
+ *  mesh with the new value. Fields that are not coefficients are ignored.    */
+func TestSetSurfaceCoefficientsRegenerates(t *testing.T) {
+    var id int = newBindingCanvas(t, 6, 5)
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    threetools.CanvasLock.Lock()
+    var err error = canvas.GenerateSurfacePreset("elliptic_paraboloid")
+    threetools.CanvasLock.Unlock()
+
+    if err != nil {
+        t.Fatalf("GenerateSurfacePreset: %v", err)
+    }
+
+    var coefficients map[string]interface{} = map[string]interface{}{
+        "a": 5.0,
+        "b": 1.0,
+    }
+
+    var result js.Value = js.ValueOf(
+        SetSurfaceCoefficients(js.Undefined(), jsArgs(id, coefficients)),
+    )
+
+    if !result.Get("ok").Bool() {
+        t.Fatalf("setSurfaceCoefficients failed: %v", result.Get("error"))
+    }
+
+    var ignored js.Value = result.Get("ignored")
+
+    if (ignored.Length() != 1) || (ignored.Index(0).String() != "b") {
+        t.Errorf("ignored is %v, want [b]", ignored)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var x float32 = canvas.Mesh[3 * index]
+        var y float32 = canvas.Mesh[3 * index + 1]
+        var z float32 = canvas.Mesh[3 * index + 2]
+        var want float32 = x*x + 5.0 * y*y
+        var difference float32 = z - want
+
+        if (difference > 1.0E-5) || (difference < -1.0E-5) {
+            t.Fatalf("vertex %d has z = %g, want x^2 + 5 y^2 = %g",
+                     index, z, want)
+        }
+    }
+}
+/*  End of TestSetSurfaceCoefficientsRegenerates.                             */
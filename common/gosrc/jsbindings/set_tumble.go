@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for SetTumble.                                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "math"
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function SetTumble. The inputs are the rates about the *
+ *  x, y, and z axes in radians per second. Returns a result object, see      *
+ *  jsResult.                                                                 */
+func SetTumble(this js.Value, args []js.Value) interface{} {
+
+    /*  Variable for indexing over the rates.                                 */
+    var index int
+
+    /*  The rates about the three axes.                                       */
+    var rates [3]float32
+
+    /*  Report a call without the inputs rather than reading past the end.    */
+    if len(args) < 3 {
+        return jsResult(ErrMissingArguments)
+    }
+
+    for index = 0; index < 3; index++ {
+        rates[index] = float32(args[index].Float())
+
+        if math.IsNaN(float64(rates[index])) ||
+           math.IsInf(float64(rates[index]), 0) {
+            return jsResult(threetools.ErrNonFinite)
+        }
+    }
+
+    /*  The rates are shared by all of the canvases, hold the lock.           */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    threetools.SetTumble(rates[0], rates[1], rates[2])
+    return jsResult(nil)
+}
+/*  End of SetTumble.                                                         */
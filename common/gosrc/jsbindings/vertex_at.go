@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a JS binding for VertexAt.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+import (
+    "syscall/js"
+    "common/threetools"
+)
+
+/*  Wrapper for the Go function VertexAt. The inputs are the column and the   *
+ *  row of the vertex, optionally preceded by a canvas id. Returns the array  *
+ *  [x, y, z], or null if the canvas does not exist or the indices are out of *
+ *  range.                                                                    */
+func VertexAt(this js.Value, args []js.Value) interface{} {
+
+    /*  The canvases are shared, hold the lock while one is being read.       */
+    threetools.CanvasLock.Lock()
+    defer threetools.CanvasLock.Unlock()
+
+    canvas, args := canvasFromNumericArgs(args, 2)
+
+    if canvas == nil {
+        return nil
+    }
+
+    /*  Negative indices would wrap around as unsigned integers.              */
+    if (args[0].Int() < 0) || (args[1].Int() < 0) {
+        return nil
+    }
+
+    var xIndex uint32 = uint32(args[0].Int())
+    var yIndex uint32 = uint32(args[1].Int())
+    point, err := canvas.VertexAt(xIndex, yIndex)
+
+    if err != nil {
+        return nil
+    }
+
+    return []interface{}{point[0], point[1], point[2]}
+}
+/*  End of VertexAt.                                                          */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the vertexAt binding.                                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package jsbindings
+
+/*  js reads the returned array, threetools gives the mesh it is compared to. */
+import (
+    "syscall/js"
+    "testing"
+    "common/threetools"
+)
+
+/*  Every vertex of a 5x4 paraboloid is returned as [x, y, z], matching the   *
+ *  row-major mesh buffer, and indices outside the grid give null.            */
+func TestVertexAtMatchesBuffer(t *testing.T) {
+    var id int = newBindingCanvas(t, 5, 4)
+    var canvas *threetools.Canvas = threetools.GetCanvas(id)
+
+    for yIndex := 0; yIndex < 4; yIndex++ {
+        for xIndex := 0; xIndex < 5; xIndex++ {
+            var point js.Value = js.ValueOf(
+                VertexAt(js.Undefined(), jsArgs(id, xIndex, yIndex)),
+            )
+            var offset int = 3 * (5 * yIndex + xIndex)
+
+            if (point.Type() != js.TypeObject) || (point.Length() != 3) {
+                t.Fatalf("vertex (%d, %d) is %v, want an array of three",
+                         xIndex, yIndex, point)
+            }
+
+            for axis := 0; axis < 3; axis++ {
+                var got float32 = float32(point.Index(axis).Float())
+
+                if got != canvas.Mesh[offset + axis] {
+                    t.Errorf("vertex (%d, %d) coordinate %d is %g, want %g",
+                             xIndex, yIndex, axis, got,
+                             canvas.Mesh[offset + axis])
+                }
+            }
+        }
+    }
+
+    var outside = [][2]int{{5, 0}, {0, 4}, {-1, 2}, {2, -1}}
+
+    for _, indices := range outside {
+        var point js.Value = js.ValueOf(
+            VertexAt(js.Undefined(), jsArgs(id, indices[0], indices[1])),
+        )
+
+        if !point.IsNull() {
+            t.Errorf("vertex %v is %v, want null", indices, point)
+        }
+    }
+}
+/*  End of TestVertexAtMatchesBuffer.                                         */
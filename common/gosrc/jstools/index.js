@@ -38,10 +38,63 @@ const result = await WebAssembly.instantiateStreaming(
 go.run(result.instance);
 
 /*  Export all of the jsbindings functions and the WASM memory.               */
+export const animateRipple = window.animateRipple;
+export const animateSurface = window.animateSurface;
+export const buildInterleaved = window.buildInterleaved;
+export const canMesh = window.canMesh;
+export const canvasState = window.canvasState;
+export const capabilities = window.capabilities;
+export const commitFrame = window.commitFrame;
+export const computeGradient = window.computeGradient;
+export const computeNormals = window.computeNormals;
+export const computeTangents = window.computeTangents;
+export const dragRotate = window.dragRotate;
+export const faceCount = window.faceCount;
+export const fitCamera = window.fitCamera;
+export const flushMesh = window.flushMesh;
+export const frontMeshAddress = window.frontMeshAddress;
+export const generateQuadFaces = window.generateQuadFaces;
+export const generateReferenceGrid = window.generateReferenceGrid;
+export const generateUVs = window.generateUVs;
+export const generateWireframeAndFaces = window.generateWireframeAndFaces;
+export const heightRange = window.heightRange;
 export const indexBufferAddress = window.indexBufferAddress;
+export const indexByteLength = window.indexByteLength;
+export const indexSegmentCount = window.indexSegmentCount;
+export const interleavedStride = window.interleavedStride;
+export const loadMeshFromBase64 = window.loadMeshFromBase64;
 export const mainCanvasAddress = window.mainCanvasAddress;
+export const memoryUsage = window.memoryUsage;
+export const meshAsBase64 = window.meshAsBase64;
 export const meshBufferAddress = window.meshBufferAddress;
+export const meshByteLength = window.meshByteLength;
+export const meshVertexCount = window.meshVertexCount;
+export const newCanvas = window.newCanvas;
 export const memory = result.instance.exports.mem;
+export const oscillateRotation = window.oscillateRotation;
+export const pauseRotation = window.pauseRotation;
+export const pickNearestVertex = window.pickNearestVertex;
+export const quadFaceCount = window.quadFaceCount;
+export const regenerateMesh = window.regenerateMesh;
+export const removeDegenerateSegments = window.removeDegenerateSegments;
+export const resumeRotation = window.resumeRotation;
 export const setupMesh = window.setupMesh;
+export const scalarFieldAddress = window.scalarFieldAddress;
+export const selectSurface = window.selectSurface;
+export const setAngularSpeed = window.setAngularSpeed;
+export const setAngularVelocity = window.setAngularVelocity;
+export const setDomain = window.setDomain;
+export const setHeightData = window.setHeightData;
+export const setHeightTarget = window.setHeightTarget;
+export const setMeshType = window.setMeshType;
+export const setParaboloidShape = window.setParaboloidShape;
 export const setRotationAngle = window.setRotationAngle;
+export const setRotationDamping = window.setRotationDamping;
+export const setSurfaceCoefficients = window.setSurfaceCoefficients;
+export const setTumble = window.setTumble;
+export const stepByTime = window.stepByTime;
+export const stepRotation = window.stepRotation;
+export const swapBuffers = window.swapBuffers;
+export const trianglePickBuffer = window.trianglePickBuffer;
+export const vertexAt = window.vertexAt;
 export const zRotateMainCanvas = window.zRotateMainCanvas;
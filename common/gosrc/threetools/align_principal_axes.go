@@ -0,0 +1,78 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates a mesh so that its principal axes are the coordinate axes.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Applies the linear map whose rows are given by the matrix to each vector  *
+ *  in a buffer with three floats per vector, about the given center.         */
+func applyRowMatrix(buffer []float32, matrix [3][3]float32, center [3]float32) {
+
+    /*  Variable for indexing over the vectors in the buffer.                 */
+    var index int
+
+    for index = 0; index + 2 < len(buffer); index += 3 {
+        var p [3]float32 = [3]float32{
+            buffer[index] - center[0],
+            buffer[index + 1] - center[1],
+            buffer[index + 2] - center[2],
+        }
+
+        buffer[index] = dotProduct(matrix[0], p) + center[0]
+        buffer[index + 1] = dotProduct(matrix[1], p) + center[1]
+        buffer[index + 2] = dotProduct(matrix[2], p) + center[2]
+    }
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      AlignPrincipalAxes                                                    *
+ *  Purpose:                                                                  *
+ *      Rotates the mesh about its centroid so that the direction of largest  *
+ *      variance of the vertices lies along the x axis and the direction of   *
+ *      smallest variance lies along the z axis. This gives a consistent      *
+ *      default orientation regardless of the parametrization. The normals,   *
+ *      if computed, are rotated as well.                                     *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose mesh is being aligned.                           *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) AlignPrincipalAxes() {
+
+    /*  The axes form a rotation matrix, with the axes as its rows. This maps *
+     *  the first axis to x, the second to y, and the third to z.             */
+    center, axes, _ := self.principalAxes()
+
+    /*  Rotate the vertices about the centroid.                               */
+    applyRowMatrix(self.Mesh[0:self.MeshSize], axes, center)
+
+    /*  The normals are rotated about the origin, they are directions.        */
+    if len(self.Normals) >= self.MeshSize {
+        applyRowMatrix(self.Normals[0:self.MeshSize], axes, [3]float32{})
+    }
+
+    applyRowMatrix(self.FaceNormals, axes, [3]float32{})
+}
+/*  End of AlignPrincipalAxes.                                                */
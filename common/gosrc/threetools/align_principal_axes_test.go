@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for aligning the mesh with its principal axes.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the ellipsoid.               */
+import (
+    "math"
+    "testing"
+)
+
+/*  An ellipsoid with semi-axes 3, 1.5, and 0.5 along the directions (0, 1,   *
+ *  1), (1, 0, 0), and (0, 1, -1) ends up with its long axis along x and its  *
+ *  short axis along z after alignment.                                       */
+func TestAlignPrincipalAxesEllipsoid(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(33, 17), WithDomain(1.0, 1.0, 0.0, 0.0),
+    )
+
+    canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+        sinV, cosV := math.Sincos(math.Pi * float64(v))
+        var long float64 = 3.0 * cosV / math.Sqrt2
+        var short float64 = 0.5 * sinV * sinU / math.Sqrt2
+        return [3]float32{
+            float32(1.5 * sinV * cosU),
+            float32(long + short),
+            float32(long - short),
+        }
+    })
+
+    canvas.AlignPrincipalAxes()
+
+    low, high := canvas.BoundingBox()
+    var extent [3]float32 = vectorDifference(high, low)
+
+    if !closeTo(extent[0], 6.0, 0.05) {
+        t.Errorf("extent along x is %g, want the long axis 6", extent[0])
+    }
+
+    if !closeTo(extent[1], 3.0, 0.05) {
+        t.Errorf("extent along y is %g, want the middle axis 3", extent[1])
+    }
+
+    if !closeTo(extent[2], 1.0, 0.05) {
+        t.Errorf("extent along z is %g, want the short axis 1", extent[2])
+    }
+}
+/*  End of TestAlignPrincipalAxesEllipsoid.                                   */
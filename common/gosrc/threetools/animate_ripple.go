@@ -0,0 +1,112 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Animates a traveling radial wave over a mesh.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sin, Hypot, and Inf are provided here.                                    */
+import "math"
+
+/*  Writes the base mesh plus the ripple at time t to the mesh, adding the    *
+ *  wave only to the vertices whose base height is above the threshold, and   *
+ *  then applies the current orientation. The base mesh is never changed.     */
+func (self *Canvas) addRipple(t, threshold float32) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The phase of the wave at the origin.                                  */
+    var phase float64 = float64(RippleFrequency * t)
+
+    self.ensureBaseOrientation()
+    copy(self.Mesh[0:self.MeshSize], self.BaseMesh)
+
+    if self.hasBaseNormals() {
+        copy(self.Normals[0:self.MeshSize], self.BaseNormals)
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        if !(self.Mesh[index + 2] > threshold) {
+            continue
+        }
+
+        var r float64 = math.Hypot(
+            float64(self.Mesh[index]), float64(self.Mesh[index + 1]),
+        )
+
+        var wave float64 = math.Sin(float64(RippleWavenumber) * r - phase)
+        self.Mesh[index + 2] += RippleAmplitude * float32(wave)
+    }
+
+    self.applyOrientation()
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      AnimateRipple                                                         *
+ *  Purpose:                                                                  *
+ *      Adds the traveling radial wave A sin(k r - omega t) to the heights of *
+ *      the base mesh, where r is the distance from the z axis, and writes    *
+ *      the result to the mesh. The amplitude, wavenumber, and angular        *
+ *      frequency are RippleAmplitude, RippleWavenumber, and RippleFrequency. *
+ *      Since the wave is added to the base mesh, see CaptureBaseOrientation, *
+ *      it does not accumulate from frame to frame, and the current           *
+ *      orientation is applied afterwards. The crests move outwards as t      *
+ *      increases. The normals are not updated, compute them again.           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being animated.                          *
+ *      t (float32):                                                          *
+ *          The current time, in seconds.                                     *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) AnimateRipple(t float32) {
+    self.addRipple(t, float32(math.Inf(-1)))
+}
+/*  End of AnimateRipple.                                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      AnimateRippleAbove                                                    *
+ *  Purpose:                                                                  *
+ *      Like AnimateRipple, but the wave is only added to the vertices whose  *
+ *      height on the base mesh is above the threshold. This draws an         *
+ *      animated water layer over a static surface, like measured terrain     *
+ *      from GenerateMeshFromSamples, with the ground below the threshold     *
+ *      left still. The base mesh is not changed, so with RippleAmplitude     *
+ *      zero the mesh returns exactly to the base.                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being animated.                          *
+ *      t (float32):                                                          *
+ *          The current time, in seconds.                                     *
+ *      threshold (float32):                                                  *
+ *          Only vertices with base heights above this move.                  *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) AnimateRippleAbove(t, threshold float32) {
+    self.addRipple(t, threshold)
+}
+/*  End of AnimateRippleAbove.                                                */
@@ -0,0 +1,123 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the traveling ripple animation.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi and Inf are provided by math, used for finding the crest.              */
+import (
+    "math"
+    "testing"
+)
+
+/*  Returns the x coordinate of the highest vertex on the row y = 0 of the    *
+ *  canvas with at most the given x.                                          */
+func rippleCrest(canvas *Canvas, xMax float32) float32 {
+    var crest, height float32 = 0.0, float32(math.Inf(-1))
+
+    for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+        var p [3]float32 = canvas.point(int(canvas.gridIndex(xIndex, 1)))
+
+        if (p[0] <= xMax) && (p[2] > height) {
+            crest, height = p[0], p[2]
+        }
+    }
+
+    return crest
+}
+/*  End of rippleCrest.                                                       */
+
+/*  On a flat plane the first crest of sin(k r - w t) is at the radius (pi /  *
+ *  2 + w t) / k, so it moves outward as time goes on.                        */
+func TestAnimateRippleCrestMovesOutward(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, Plane, WithGrid(81, 3), WithDomain(2.0, 2.0, 0.0, -1.0),
+    )
+
+    var spacing float32 = canvas.Width / float32(canvas.NxPts - 1)
+    var previous float32 = -1.0
+
+    for _, time := range []float32{0.0, 0.2} {
+        var want float32 = (0.5 * math.Pi + RippleFrequency * time) /
+                           RippleWavenumber
+
+        canvas.AnimateRipple(time)
+
+        /*  Stay within half a wavelength so the next crest is not found.     */
+        var limit float32 = want + math.Pi / RippleWavenumber
+        var crest float32 = rippleCrest(canvas, limit)
+
+        if !closeTo(crest, want, spacing) {
+            t.Errorf("crest at %g at time %g, want %g", crest, time, want)
+        }
+
+        if crest <= previous {
+            t.Errorf("crest at %g at time %g did not move out from %g",
+                     crest, time, previous)
+        }
+
+        previous = crest
+    }
+}
+/*  End of TestAnimateRippleCrestMovesOutward.                                */
+
+/*  The masked ripple only moves the vertices above the threshold, and since  *
+ *  the base mesh is untouched, a ripple of zero amplitude gives back exactly *
+ *  the original mesh.                                                        */
+func TestAnimateRippleAboveIsNonDestructive(t *testing.T) {
+    const threshold float32 = 0.5
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(21, 21))
+    var original []float32 = append([]float32(nil), canvas.Mesh...)
+    var moved int = 0
+
+    var previous float32 = RippleAmplitude
+    t.Cleanup(func() { RippleAmplitude = previous })
+
+    canvas.AnimateRippleAbove(0.3, threshold)
+
+    for index := 2; index < canvas.MeshSize; index += 3 {
+        if original[index] <= threshold {
+            if canvas.Mesh[index] != original[index] {
+                t.Fatalf("vertex %d below the threshold moved", index / 3)
+            }
+        } else if canvas.Mesh[index] != original[index] {
+            moved++
+        }
+    }
+
+    if moved == 0 {
+        t.Errorf("no vertex above the threshold moved")
+    }
+
+    RippleAmplitude = 0.0
+    canvas.AnimateRippleAbove(0.7, threshold)
+
+    for index := range original {
+        if canvas.Mesh[index] != original[index] {
+            t.Fatalf("mesh[%d] = %g with no ripple, want %g",
+                     index, canvas.Mesh[index], original[index])
+        }
+    }
+}
+/*  End of TestAnimateRippleAboveIsNonDestructive.                            */
@@ -0,0 +1,86 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Animates a time dependent surface preset.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the graph of a time dependent surface at the time t.              */
+func surfaceAtTime(f TimeSurface, t float32) SurfaceParametrization {
+    return func(x, y float32) float32 {
+        return f(x, y, t)
+    }
+}
+
+/*  Returns the time dependent surface of a preset, a family evaluated with   *
+ *  the coefficients of the canvas, or nil if the preset does not depend on   *
+ *  time.                                                                     */
+func (self *Canvas) presetTimeSurface(preset SurfacePreset) TimeSurface {
+    if preset.TimeFamily != nil {
+        return preset.TimeFamily(self.presetCoefficients(preset))
+    }
+
+    return preset.Time
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      AnimateSurface                                                        *
+ *  Purpose:                                                                  *
+ *      Recomputes the heights of the mesh from the time dependent surface of *
+ *      the preset last drawn, see GenerateSurfacePreset, at the time t. The  *
+ *      front end calls this once per frame with the current time. The grid   *
+ *      and the wireframe are unchanged, so only the mesh is written. The     *
+ *      surface at time t is stored in the canvas, see Regenerate. If a       *
+ *      height target is set the heights are then scaled to stay within it,   *
+ *      see SetHeightTarget. Nothing is done if the preset does not depend on *
+ *      time.                                                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being animated.                          *
+ *      t (float32):                                                          *
+ *          The current time, in seconds.                                     *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from GenerateMeshFromParametrization.                   *
+ ******************************************************************************/
+func (self *Canvas) AnimateSurface(t float32) error {
+    preset, found := SurfacePresets[self.Preset]
+
+    if !found {
+        return nil
+    }
+
+    var f TimeSurface = self.presetTimeSurface(preset)
+
+    if f == nil {
+        return nil
+    }
+
+    self.Surface = surfaceAtTime(f, t)
+    err := self.GenerateMeshFromParametrization(self.Surface)
+
+    /*  Keep the heights in range if a limit is set, see SetHeightTarget.     */
+    self.normalizeHeights(t)
+    return err
+}
+/*  End of AnimateSurface.                                                    */
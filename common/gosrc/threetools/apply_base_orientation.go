@@ -0,0 +1,125 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates the base mesh by the accumulated orientation angles.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Forgets the base orientation. The mesh generators call this, since the    *
+ *  base is a copy of the previous mesh, even when the new one has the same   *
+ *  size. The accumulated angles are kept, see ensureBaseOrientation.         */
+func (self *Canvas) invalidateBaseOrientation() {
+    self.BaseMesh = self.BaseMesh[:0]
+    self.BaseNormals = self.BaseNormals[:0]
+}
+
+/*  Records a mesh that was changed in place as the new base orientation.     *
+ *  The functions that edit the mesh, like Bend and ClampHeights, call this,  *
+ *  since the next rotation would otherwise restore the old base and undo the *
+ *  edit. The edited mesh is the rotated one, so the accumulated angles are   *
+ *  undone before it is recorded, and the figure keeps its orientation.       *
+ *  Nothing is done if there is no base, the mesh is recorded when it is next *
+ *  rotated.                                                                  */
+func (self *Canvas) rebaseOrientation() {
+    if (self.MeshSize == 0) || (len(self.BaseMesh) != self.MeshSize) {
+        return
+    }
+
+    self.invalidateBaseOrientation()
+    self.undoOrientation()
+    self.ensureBaseOrientation()
+    self.applyBaseOrientation()
+}
+
+/*  Records the current mesh as the base orientation if there is no base for  *
+ *  it, meaning a new mesh has been generated. The new mesh is not rotated    *
+ *  yet, so the accumulated angles are kept and applied to it, and the figure *
+ *  keeps its orientation.                                                    */
+func (self *Canvas) ensureBaseOrientation() {
+    if len(self.BaseMesh) != self.MeshSize {
+        var yaw, pitch, roll float32 = self.Yaw, self.Pitch, self.Roll
+
+        self.CaptureBaseOrientation()
+        self.Yaw, self.Pitch, self.Roll = yaw, pitch, roll
+    }
+}
+
+/*  Rotates the mesh, and the normals if they were recorded, by the           *
+ *  accumulated angles. The mesh is turned about y by the yaw, tilted about x *
+ *  by the pitch, and then rolled about z.                                    */
+func (self *Canvas) applyOrientation() {
+
+    var yaw UnitVector = UnitVectorFromAngle(self.Yaw)
+    var pitch UnitVector = UnitVectorFromAngle(self.Pitch)
+    var roll UnitVector = UnitVectorFromAngle(self.Roll)
+
+    self.RotateMeshY(yaw)
+    self.RotateMeshX(pitch)
+    self.RotateMesh(roll)
+
+    /*  The normals are given the same rotation, in the same order.           */
+    if self.hasBaseNormals() {
+        rotateCoordinatePlane(self.Normals, self.NumberOfPoints, 2, 0, yaw)
+        rotateCoordinatePlane(self.Normals, self.NumberOfPoints, 1, 2, pitch)
+        self.RotateNormals(roll)
+    }
+}
+
+/*  Rotates the mesh, and the normals if there are any, back by the           *
+ *  accumulated angles, the inverse of applyOrientation.                      */
+func (self *Canvas) undoOrientation() {
+
+    var yaw UnitVector = UnitVectorFromAngle(-self.Yaw)
+    var pitch UnitVector = UnitVectorFromAngle(-self.Pitch)
+    var roll UnitVector = UnitVectorFromAngle(-self.Roll)
+
+    self.RotateMesh(roll)
+    self.RotateMeshX(pitch)
+    self.RotateMeshY(yaw)
+
+    /*  The normals are turned back the same way as the mesh.                 */
+    if len(self.Normals) >= self.MeshSize {
+        self.RotateNormals(roll)
+        rotateCoordinatePlane(self.Normals, self.NumberOfPoints, 1, 2, pitch)
+        rotateCoordinatePlane(self.Normals, self.NumberOfPoints, 2, 0, yaw)
+    }
+}
+
+/*  Determines if the normals were recorded with the base mesh, and if the    *
+ *  normal buffer is large enough to restore them into.                       */
+func (self *Canvas) hasBaseNormals() bool {
+    return (len(self.BaseNormals) == self.MeshSize) &&
+           (len(self.Normals) >= self.MeshSize)
+}
+
+/*  Overwrites the mesh, and the normals if they were recorded, with the base *
+ *  copies rotated by the accumulated angles.                                 */
+func (self *Canvas) applyBaseOrientation() {
+    copy(self.Mesh[0:self.MeshSize], self.BaseMesh)
+
+    if self.hasBaseNormals() {
+        copy(self.Normals[0:self.MeshSize], self.BaseNormals)
+    }
+
+    self.applyOrientation()
+}
+/*  End of applyBaseOrientation.                                              */
@@ -0,0 +1,218 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for recording and applying the base orientation of a mesh.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A mesh generated after the base was recorded, with the same size, must    *
+ *  not be replaced by the old base on the next rotation.                     */
+func TestRegenerateReplacesBase(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, func(x, y float32) float32 { return 0.0 }, WithGrid(3, 3),
+    )
+
+    canvas.DragRotate(0.0, 0.0)
+    canvas.GenerateMeshFromParametrization(
+        func(x, y float32) float32 { return 1.0 },
+    )
+    canvas.DragRotate(0.0, 0.0)
+
+    if canvas.Mesh[2] != 1.0 {
+        t.Errorf("z = %v after regenerating, want 1", canvas.Mesh[2])
+    }
+}
+/*  End of TestRegenerateReplacesBase.                                        */
+
+/*  SetDomain followed by StepRotation shows the surface on the new domain.   */
+func TestSetDomainReplacesBase(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+
+    canvas.StepRotation(0.0)
+
+    if err := canvas.SetDomain(2.0, 2.0, 1.0, 1.0); err != nil {
+        t.Fatalf("SetDomain: %v", err)
+    }
+
+    canvas.StepRotation(0.0)
+    expectVector(t, "first vertex", canvas.point(0), [3]float32{2, 2, 8}, 0)
+}
+/*  End of TestSetDomainReplacesBase.                                         */
+
+/*  Data loaded after a ripple is added to the new heights, not the old ones. */
+func TestRippleUsesNewData(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var heights []float32 = []float32{7, 7, 7, 7, 7, 7, 7, 7, 7}
+
+    canvas.AnimateRipple(0.0)
+
+    if err := canvas.GenerateMeshFromData(heights); err != nil {
+        t.Fatalf("GenerateMeshFromData: %v", err)
+    }
+
+    canvas.AnimateRipple(0.0)
+
+    if !closeTo(canvas.Mesh[2], 7.0, RippleAmplitude) {
+        t.Errorf("z = %v, want within the ripple of 7", canvas.Mesh[2])
+    }
+}
+/*  End of TestRippleUsesNewData.                                             */
+
+/*  A regenerated mesh keeps the accumulated angles, so the figure does not   *
+ *  snap back to its unrotated position.                                      */
+func TestRegenerateKeepsAngles(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+
+    canvas.DragRotate(0.5, 0.0)
+
+    var before [3]float32 = canvas.point(0)
+    var yaw float32 = canvas.Yaw
+
+    canvas.Regenerate()
+    canvas.DragRotate(0.0, 0.0)
+
+    if canvas.Yaw != yaw {
+        t.Errorf("yaw = %v, want %v", canvas.Yaw, yaw)
+    }
+
+    expectVector(t, "first vertex", canvas.point(0), before, 1.0E-6)
+}
+/*  End of TestRegenerateKeepsAngles.                                         */
+
+/*  The functions that edit the mesh in place, each run on a rotated canvas.  */
+var inPlaceEdits = []struct {
+    name string
+    edit func(canvas *Canvas) error
+}{
+    {"ClampHeights", func(canvas *Canvas) error {
+        canvas.ClampHeights(-0.5, 0.5)
+        return nil
+    }},
+    {"Bend", func(canvas *Canvas) error {
+        canvas.Bend(0, 0.5)
+        return nil
+    }},
+    {"Twist", func(canvas *Canvas) error {
+        canvas.Twist(0.5)
+        return nil
+    }},
+    {"Mirror", func(canvas *Canvas) error {
+        canvas.Mirror(2, true)
+        return nil
+    }},
+    {"ApplyMatrix", func(canvas *Canvas) error {
+        canvas.ApplyMatrix([16]float32{
+            2, 0, 0, 1, 0, 2, 0, 0, 0, 0, 2, 0, 0, 0, 0, 1,
+        })
+        return nil
+    }},
+    {"FitToUnitCube", func(canvas *Canvas) error {
+        canvas.FitToUnitCube()
+        return nil
+    }},
+    {"OffsetAlongNormals", func(canvas *Canvas) error {
+        return canvas.OffsetAlongNormals(0.25)
+    }},
+    {"SmoothHeights", func(canvas *Canvas) error {
+        canvas.SmoothHeights(1.0)
+        return nil
+    }},
+    {"SmoothSpectral", func(canvas *Canvas) error {
+        return SmoothSpectral(canvas, 0.5)
+    }},
+    {"SpinAboutCentroid", func(canvas *Canvas) error {
+        canvas.SpinAboutCentroid(UnitVectorFromAngle(0.3))
+        return nil
+    }},
+}
+
+/*  A mesh edited in place after it was rotated is neither restored from the  *
+ *  old base nor rotated a second time by the next rotation, so the figure    *
+ *  stays as it was edited.                                                   */
+func TestEditThenRotateKeepsEdit(t *testing.T) {
+    for _, test := range inPlaceEdits {
+        var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(6, 5))
+
+        canvas.ComputeNormals()
+        canvas.DragRotate(40.0, 25.0)
+
+        if err := test.edit(canvas); err != nil {
+            t.Fatalf("%s: %v", test.name, err)
+        }
+
+        var edited *Canvas = canvas.Clone()
+        canvas.DragRotate(0.0, 0.0)
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            expectVector(t, test.name, canvas.point(index),
+                         edited.point(index), 1.0E-5)
+        }
+    }
+}
+/*  End of TestEditThenRotateKeepsEdit.                                       */
+
+/*  The edit turns with the rest of the mesh, so dragging away and back       *
+ *  again returns to the edited mesh.                                         */
+func TestEditThenRotateTurnsEdit(t *testing.T) {
+    for _, test := range inPlaceEdits {
+        var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(6, 5))
+
+        canvas.ComputeNormals()
+        canvas.DragRotate(40.0, 25.0)
+
+        if err := test.edit(canvas); err != nil {
+            t.Fatalf("%s: %v", test.name, err)
+        }
+
+        var edited *Canvas = canvas.Clone()
+        canvas.DragRotate(30.0, -20.0)
+        canvas.DragRotate(-30.0, 20.0)
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            expectVector(t, test.name, canvas.point(index),
+                         edited.point(index), 1.0E-5)
+        }
+    }
+}
+/*  End of TestEditThenRotateTurnsEdit.                                       */
+
+/*  Rippling again after a rotation starts from the base, so the ripples do   *
+ *  not pile up on top of each other.                                         */
+func TestRippleThenRotateDoesNotAccumulate(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+
+    canvas.DragRotate(40.0, 25.0)
+    canvas.AnimateRipple(0.5)
+
+    var rippled *Canvas = canvas.Clone()
+
+    canvas.DragRotate(0.0, 0.0)
+    canvas.AnimateRipple(0.5)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        expectVector(t, "point", canvas.point(index),
+                     rippled.point(index), 1.0E-5)
+    }
+}
+/*  End of TestRippleThenRotateDoesNotAccumulate.                             */
@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Applies a homogeneous 4x4 transformation to a mesh.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ApplyMatrix                                                           *
+ *  Purpose:                                                                  *
+ *      Applies a homogeneous transformation, such as a rotation, scale,      *
+ *      translation, shear, or a product of these, to every active vertex.    *
+ *      The matrix is stored in row-major order, m[4*row + column], and acts  *
+ *      on column vectors, so a vertex (x, y, z) becomes M (x, y, z, 1). The  *
+ *      translation is hence m[3], m[7], and m[11]. Note three.js stores its  *
+ *      Matrix4 elements in column-major order, transpose those before        *
+ *      passing them here. If the last row is not (0, 0, 0, 1) the result is  *
+ *      divided by its w component, giving a projective transformation.       *
+ *      Vertices mapped to w = 0 are left unchanged. The normals are not      *
+ *      updated, compute them again.                                          *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being transformed.               *
+ *      m ([16]float32):                                                      *
+ *          The transformation matrix, in row-major order.                    *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ApplyMatrix(m [16]float32) {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    /*  Affine transformations do not need the division by w.                 */
+    var affine bool = (m[12] == 0.0) && (m[13] == 0.0) &&
+                      (m[14] == 0.0) && (m[15] == 1.0)
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        var x float32 = self.Mesh[index]
+        var y float32 = self.Mesh[index + 1]
+        var z float32 = self.Mesh[index + 2]
+
+        var xOut float32 = m[0] * x + m[1] * y + m[2] * z + m[3]
+        var yOut float32 = m[4] * x + m[5] * y + m[6] * z + m[7]
+        var zOut float32 = m[8] * x + m[9] * y + m[10] * z + m[11]
+
+        if !affine {
+            var w float32 = m[12] * x + m[13] * y + m[14] * z + m[15]
+
+            /*  Points sent to infinity have no finite image, skip them.      */
+            if w == 0.0 {
+                continue
+            }
+
+            xOut /= w
+            yOut /= w
+            zOut /= w
+        }
+
+        self.Mesh[index] = xOut
+        self.Mesh[index + 1] = yOut
+        self.Mesh[index + 2] = zOut
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of ApplyMatrix.                                                       */
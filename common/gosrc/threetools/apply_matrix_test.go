@@ -0,0 +1,60 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for applying a 4x4 transformation matrix to the mesh.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The identity leaves the mesh unchanged, and a translation matrix, with    *
+ *  the offset in the last column, moves every vertex by that offset.         */
+func TestApplyMatrixIdentityAndTranslation(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var original *Canvas = canvas.Clone()
+    var offset [3]float32 = [3]float32{1.0, -2.0, 0.5}
+
+    canvas.ApplyMatrix([16]float32{
+        1.0, 0.0, 0.0, 0.0,
+        0.0, 1.0, 0.0, 0.0,
+        0.0, 0.0, 1.0, 0.0,
+        0.0, 0.0, 0.0, 1.0,
+    })
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        expectVector(t, "identity", canvas.point(index),
+                     original.point(index), 0.0)
+    }
+
+    canvas.ApplyMatrix([16]float32{
+        1.0, 0.0, 0.0, offset[0],
+        0.0, 1.0, 0.0, offset[1],
+        0.0, 0.0, 1.0, offset[2],
+        0.0, 0.0, 0.0, 1.0,
+    })
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        expectVector(t, "translated", canvas.point(index),
+                     vectorSum(original.point(index), offset), 1.0E-6)
+    }
+}
+/*  End of TestApplyMatrixIdentityAndTranslation.                             */
@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Evaluates the associated Legendre polynomials.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt is provided here.                                                    */
+import "math"
+
+/*  Evaluates the associated Legendre polynomial P_l^m(x) for 0 <= m <= l and *
+ *  -1 <= x <= 1, without the Condon-Shortley phase. The standard recurrence  *
+ *  is used, starting from P_m^m and stepping up in degree, which is stable.  */
+func associatedLegendre(l, m int, x float64) float64 {
+
+    /*  Variable for indexing over the degrees.                               */
+    var degree int
+
+    /*  P_m^m(x) = (2m - 1)!! (1 - x^2)^(m/2).                                */
+    var pmm float64 = 1.0
+    var root float64 = math.Sqrt((1.0 - x) * (1.0 + x))
+    var factor float64 = 1.0
+
+    for degree = 1; degree <= m; degree++ {
+        pmm *= factor * root
+        factor += 2.0
+    }
+
+    if l == m {
+        return pmm
+    }
+
+    /*  P_{m+1}^m(x) = x (2m + 1) P_m^m(x).                                   */
+    var previous float64 = pmm
+    var current float64 = x * float64(2 * m + 1) * pmm
+
+    /*  (l - m) P_l^m = x (2l - 1) P_{l-1}^m - (l + m - 1) P_{l-2}^m.         */
+    for degree = m + 2; degree <= l; degree++ {
+        var next float64 = (x * float64(2 * degree - 1) * current -
+                            float64(degree + m - 1) * previous) /
+                           float64(degree - m)
+
+        previous = current
+        current = next
+    }
+
+    return current
+}
+/*  End of associatedLegendre.                                                */
@@ -0,0 +1,85 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Bends a mesh around one of the coordinate axes.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos is provided here, the bend angles need not be small.               */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Bend                                                                  *
+ *  Purpose:                                                                  *
+ *      Bends the mesh around the given axis. The next axis in cyclic order,  *
+ *      x to y to z to x, is the direction along the mesh that is bent, and   *
+ *      the third axis is the direction the mesh curls towards. Each vertex   *
+ *      is rotated about a line parallel to the bend axis, at a distance of 1 *
+ *      / amount from it, by an angle of amount times its coordinate along    *
+ *      the bent direction. Straight lines along the bent direction become    *
+ *      circular arcs, and the plane through the bend axis becomes a cylinder *
+ *      of radius 1 / amount. Distances along that plane are preserved.       *
+ *      Negative amounts curl the other way. The normals are not updated,     *
+ *      compute them again.                                                   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being bent.                      *
+ *      axis (int):                                                           *
+ *          The axis being bent around, 0 for x, 1 for y, and 2 for z.        *
+ *      amount (float32):                                                     *
+ *          The curvature of the bend, the reciprocal of its radius.          *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) Bend(axis int, amount float32) {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    /*  No bending is the identity, and avoids dividing by zero below.        */
+    if (axis < 0) || (axis > 2) || (amount == 0.0) {
+        return
+    }
+
+    /*  The coordinate that is bent along, and the one that curls.            */
+    var along int = (axis + 1) % 3
+    var across int = (axis + 2) % 3
+
+    /*  The radius of the bend, the center of the arcs is at this height.     */
+    var radius float32 = 1.0 / amount
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        var angle float64 = float64(amount * self.Mesh[index + along])
+        sinAngle, cosAngle := math.Sincos(angle)
+
+        /*  Distance from the center line of the bend to the vertex.          */
+        var distance float32 = radius - self.Mesh[index + across]
+
+        self.Mesh[index + along] = distance * float32(sinAngle)
+        self.Mesh[index + across] = radius - distance * float32(cosAngle)
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of Bend.                                                              */
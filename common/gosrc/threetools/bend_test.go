@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for bending the mesh around an axis.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Bending the flat plane z = 0 around the x axis rolls it onto a cylinder   *
+ *  of radius 1 / amount about the line y = 0, z = 1 / amount. Along each     *
+ *  line of constant x the curve rises monotonically away from y = 0 on both  *
+ *  sides, and y keeps its order.                                             */
+func TestBendPlane(t *testing.T) {
+    const amount float32 = 1.0
+
+    var canvas *Canvas = newGraphCanvas(
+        t, Plane, WithGrid(5, 9), WithDomain(2.0, 2.0, -1.0, -1.0),
+    )
+
+    canvas.Bend(0, amount)
+
+    for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+        var center uint32 = canvas.NyPts / 2
+
+        for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+            var p [3]float32 = canvas.point(
+                int(canvas.gridIndex(xIndex, yIndex)),
+            )
+            var radius float32 = vectorNorm(
+                [3]float32{0.0, p[1], p[2] - 1.0 / amount},
+            )
+
+            if !closeTo(radius, 1.0 / amount, 1.0E-5) {
+                t.Errorf("vertex %v is %g from the axis of the bend",
+                         p, radius)
+            }
+
+            if yIndex == 0 {
+                continue
+            }
+
+            var q [3]float32 = canvas.point(
+                int(canvas.gridIndex(xIndex, yIndex - 1)),
+            )
+
+            /*  Below the center the height falls, above it the height rises. */
+            var rising bool = (p[2] > q[2]) == (yIndex > center)
+
+            if (p[1] <= q[1]) || !rising {
+                t.Errorf("the bend is not monotonic from %v to %v", q, p)
+            }
+        }
+    }
+}
+/*  End of TestBendPlane.                                                     */
@@ -0,0 +1,102 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Evaluates the Bessel function of the first kind of order zero.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt, Sincos, and Abs are provided here.                                  */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BesselJ0                                                              *
+ *  Purpose:                                                                  *
+ *      Evaluates J_0(x), the Bessel function of the first kind of order      *
+ *      zero. For |x| < 8 a rational approximation is used, and for larger x  *
+ *      the asymptotic form sqrt(2 / (pi x)) (P cos(x - pi / 4) - Q sin(x -   *
+ *      pi / 4)), with P and Q given by short polynomials in 8 / x. The       *
+ *      absolute error is below 1.0E-8, well beyond single precision. The     *
+ *      coefficients are those of Numerical Recipes, which follow Hart's      *
+ *      Computer Approximations.                                              *
+ *  Arguments:                                                                *
+ *      x (float64):                                                          *
+ *          A real number.                                                    *
+ *  Output:                                                                   *
+ *      j0 (float64):                                                         *
+ *          The value J_0(x).                                                 *
+ ******************************************************************************/
+func BesselJ0(x float64) float64 {
+
+    /*  J_0 is even, work with the absolute value.                            */
+    var ax float64 = math.Abs(x)
+
+    if ax < 8.0 {
+        var y float64 = x * x
+
+        var numerator float64 = 57568490574.0 + y * (-13362590354.0 +
+            y * (651619640.7 + y * (-11214424.18 + y * (77392.33017 +
+            y * (-184.9052456)))))
+
+        var denominator float64 = 57568490411.0 + y * (1029532985.0 +
+            y * (9494680.718 + y * (59272.64853 + y * (267.8532712 +
+            y * 1.0))))
+
+        return numerator / denominator
+    }
+
+    /*  Asymptotic expansion in z = 8 / x.                                    */
+    var z float64 = 8.0 / ax
+    var y float64 = z * z
+    sinShift, cosShift := math.Sincos(ax - 0.25 * math.Pi)
+
+    var p float64 = 1.0 + y * (-0.1098628627E-02 + y * (0.2734510407E-04 +
+        y * (-0.2073370639E-05 + y * 0.2093887211E-06)))
+
+    var q float64 = -0.1562499995E-01 + y * (0.1430488765E-03 +
+        y * (-0.6911147651E-05 + y * (0.7621095161E-06 -
+        y * 0.934935152E-07)))
+
+    return math.Sqrt(2.0 / (math.Pi * ax)) * (cosShift * p - z * sinShift * q)
+}
+/*  End of BesselJ0.                                                          */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BesselRipple                                                          *
+ *  Purpose:                                                                  *
+ *      Returns the surface z = J_0(k r), with r = sqrt(x^2 + y^2). This      *
+ *      gives concentric ripples that decay slowly away from the origin.      *
+ *  Arguments:                                                                *
+ *      k (float32):                                                          *
+ *          The wavenumber of the ripples.                                    *
+ *  Output:                                                                   *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface.                                                      *
+ ******************************************************************************/
+func BesselRipple(k float32) SurfaceParametrization {
+    return func(x, y float32) float32 {
+        var r float64 = math.Sqrt(float64(x * x + y * y))
+        return float32(BesselJ0(float64(k) * r))
+    }
+}
+/*  End of BesselRipple.                                                      */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the Bessel function J_0 and the ripple surface.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  J0 from math is the reference, computed independently of BesselJ0.        */
+import (
+    "math"
+    "testing"
+)
+
+/*  BesselJ0 matches tabulated values of J_0, including its first zero and    *
+ *  points past x = 8 where the asymptotic form is used, and agrees with the  *
+ *  standard library on a sweep across both branches.                         */
+func TestBesselJ0KnownValues(t *testing.T) {
+    var cases = []struct {
+        x, want float64
+    }{
+        {0.0, 1.0},
+        {1.0, 0.7651976865579666},
+        {-3.0, -0.2600519549019334},
+        {2.404825557695773, 0.0},
+        {5.0, -0.1775967713143383},
+        {10.0, -0.2459357644513483},
+        {20.0, 0.1670246643405831},
+    }
+
+    for _, c := range cases {
+        if got := BesselJ0(c.x); math.Abs(got - c.want) > 1.0E-7 {
+            t.Errorf("BesselJ0(%g) = %.10f, want %.10f", c.x, got, c.want)
+        }
+    }
+
+    for x := -30.0; x <= 30.0; x += 0.37 {
+        if got := BesselJ0(x); math.Abs(got - math.J0(x)) > 1.0E-7 {
+            t.Errorf("BesselJ0(%g) = %.10f, math.J0 gives %.10f",
+                     x, got, math.J0(x))
+        }
+    }
+}
+/*  End of TestBesselJ0KnownValues.                                           */
+
+/*  The ripple is J_0(k r), so with k = 2 it is J_0(2) on the unit circle.    */
+func TestBesselRipple(t *testing.T) {
+    var f SurfaceParametrization = BesselRipple(2.0)
+
+    if z := f(0.6, 0.8); !closeTo(z, float32(math.J0(2.0)), 1.0E-6) {
+        t.Errorf("ripple at (0.6, 0.8) is %g, want J0(2) = %g",
+                 z, math.J0(2.0))
+    }
+}
+/*  End of TestBesselRipple.                                                  */
@@ -0,0 +1,77 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Lists the vertices on the boundary of the grid.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BoundaryVertices                                                      *
+ *  Purpose:                                                                  *
+ *      Returns the indices of the vertices on the boundary of the surface,   *
+ *      for pinning the boundary while the mesh is deformed or for checking   *
+ *      the seams. These are the vertices of the edges of the grid that are   *
+ *      not glued to the opposite edge, the same edges                        *
+ *      GenerateBoundaryOutline draws. An open grid has the vertices of all   *
+ *      four edges, a cylinder the vertices of its two boundary circles, and  *
+ *      a torus none, in which case the slice is empty. Each vertex is listed *
+ *      once, going through the grid row by row.                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose boundary is listed.                              *
+ *  Output:                                                                   *
+ *      vertices ([]uint32):                                                  *
+ *          The indices of the boundary vertices.                             *
+ ******************************************************************************/
+func (self *Canvas) BoundaryVertices() []uint32 {
+
+    /*  Variables for indexing over the grid.                                 */
+    var xIndex, yIndex uint32
+
+    /*  The output, empty rather than nil if there is no boundary.            */
+    var vertices []uint32 = make([]uint32, 0)
+
+    closedX, closedY := self.boundaryClosed()
+
+    if (self.NxPts == 0) || (self.NyPts == 0) {
+        return vertices
+    }
+
+    /*  The last column and row of the grid.                                  */
+    var right uint32 = self.NxPts - 1
+    var top uint32 = self.NyPts - 1
+
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            var onColumn bool = (xIndex == 0) || (xIndex == right)
+            var onRow bool = (yIndex == 0) || (yIndex == top)
+
+            if (onColumn && !closedX) || (onRow && !closedY) {
+                vertices = append(vertices, self.gridIndex(xIndex, yIndex))
+            }
+        }
+    }
+
+    return vertices
+}
+/*  End of BoundaryVertices.                                                  */
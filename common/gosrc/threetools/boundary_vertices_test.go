@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for listing the vertices on the boundary of the surface.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  DeepEqual compares the lists of vertices.                                 */
+import (
+    "reflect"
+    "testing"
+)
+
+/*  An open 5x4 grid lists the vertices of its perimeter row by row, a        *
+ *  cylinder only its bottom and top rows, and a torus an empty slice.        */
+func TestBoundaryVertices(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshType uint
+        want []uint32
+    }{
+        {
+            "square", SquareWireframe,
+            []uint32{0, 1, 2, 3, 4, 5, 9, 10, 14, 15, 16, 17, 18, 19},
+        },
+        {
+            "cylinder", CylindricalSquareWireframe,
+            []uint32{0, 1, 2, 3, 4, 15, 16, 17, 18, 19},
+        },
+        {
+            "torus", TorodialSquareWireframe,
+            []uint32{},
+        },
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(5, 4), WithMeshType(c.meshType),
+        )
+
+        var vertices []uint32 = canvas.BoundaryVertices()
+
+        if (vertices == nil) || !reflect.DeepEqual(vertices, c.want) {
+            t.Errorf("%s: got %v, want %v", c.name, vertices, c.want)
+        }
+    }
+}
+/*  End of TestBoundaryVertices.                                              */
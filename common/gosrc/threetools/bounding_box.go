@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the axis-aligned bounding box of a mesh.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BoundingBox                                                           *
+ *  Purpose:                                                                  *
+ *      Computes the smallest axis-aligned box containing the active vertices *
+ *      of the mesh. Both corners are the origin for an empty mesh.           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose bounding box is being computed.                  *
+ *  Output:                                                                   *
+ *      lower ([3]float32):                                                   *
+ *          The corner with the smallest coordinates.                         *
+ *      upper ([3]float32):                                                   *
+ *          The corner with the largest coordinates.                          *
+ ******************************************************************************/
+func (self *Canvas) BoundingBox() ([3]float32, [3]float32) {
+
+    /*  Variables for indexing over the vertices and their coordinates.       */
+    var index, axis int
+
+    /*  The corners of the box, the output.                                   */
+    var lower, upper [3]float32
+
+    if self.NumberOfPoints == 0 {
+        return lower, upper
+    }
+
+    /*  Start with the first vertex and grow the box to fit the rest.         */
+    lower = self.point(0)
+    upper = lower
+
+    for index = 3; index < self.MeshSize; index += 3 {
+        for axis = 0; axis < 3; axis++ {
+            var value float32 = self.Mesh[index + axis]
+
+            if value < lower[axis] {
+                lower[axis] = value
+            } else if value > upper[axis] {
+                upper[axis] = value
+            }
+        }
+    }
+
+    return lower, upper
+}
+/*  End of BoundingBox.                                                       */
@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Packs the positions, normals, and colors of a mesh into one buffer.   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BuildInterleaved                                                      *
+ *  Purpose:                                                                  *
+ *      Packs the position, normal, and color of each vertex into a single    *
+ *      buffer with InterleavedStride floats per vertex, in that order.       *
+ *      three.js can use this as one interleaved buffer attribute, which      *
+ *      needs only one upload per frame. If the normal or color buffer has    *
+ *      not been populated the corresponding entries are set to zero. Only    *
+ *      the red, green, and blue channels of the colors are packed, not the   *
+ *      opacity.                                                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose buffers are being packed.                        *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) BuildInterleaved() {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    /*  The normals and colors are optional, check if they are available.     */
+    var hasNormals bool = len(self.Normals) >= self.MeshSize
+    var hasColors bool = len(self.Colors) >= 4 * self.NumberOfPoints
+
+    /*  Reuse the interleaved buffer if it is already large enough.           */
+    self.Interleaved = resizeBuffer(
+        self.Interleaved, InterleavedStride * self.NumberOfPoints,
+    )
+
+    /*  Loop through the active vertices of the mesh.                         */
+    for index = 0; index < self.NumberOfPoints; index++ {
+
+        /*  The start of the vertex in the input and output buffers.          */
+        var source int = 3 * index
+        var target int = InterleavedStride * index
+
+        /*  Variable for indexing over the x, y, and z components.            */
+        var component int
+
+        for component = 0; component < 3; component++ {
+            self.Interleaved[target + component] = self.Mesh[source + component]
+
+            if hasNormals {
+                self.Interleaved[target + 3 + component] =
+                    self.Normals[source + component]
+            } else {
+                self.Interleaved[target + 3 + component] = 0.0
+            }
+
+            if hasColors {
+                self.Interleaved[target + 6 + component] =
+                    self.Colors[4 * index + component]
+            } else {
+                self.Interleaved[target + 6 + component] = 0.0
+            }
+        }
+    }
+}
+/*  End of BuildInterleaved.                                                  */
@@ -0,0 +1,104 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for packing the positions, normals, and colors of the mesh into *
+ *      one interleaved buffer.                                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Returns the three floats of the interleaved buffer starting at the given  *
+ *  offset within the vertex.                                                 */
+func interleavedTriple(canvas *Canvas, index, offset int) [3]float32 {
+    var start int = InterleavedStride * index + offset
+
+    return [3]float32{
+        canvas.Interleaved[start],
+        canvas.Interleaved[start + 1],
+        canvas.Interleaved[start + 2],
+    }
+}
+/*  End of interleavedTriple.                                                 */
+
+/*  Each vertex takes InterleavedStride floats, the position, then the        *
+ *  normal, then the color without its opacity.                               */
+func TestBuildInterleavedLayout(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    canvas.ComputeNormals()
+    canvas.Colors = resizeBuffer(canvas.Colors, 4 * canvas.NumberOfPoints)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var shade float32 = float32(index) / float32(canvas.NumberOfPoints)
+        setVertexColor(canvas.Colors, index, [3]float32{shade, 0.5, 1.0})
+    }
+
+    canvas.BuildInterleaved()
+
+    if InterleavedStride != 9 {
+        t.Errorf("InterleavedStride = %d, want 9", InterleavedStride)
+    }
+
+    var length int = InterleavedStride * canvas.NumberOfPoints
+
+    if len(canvas.Interleaved) != length {
+        t.Fatalf("len(Interleaved) = %d, want %d",
+                 len(canvas.Interleaved), length)
+    }
+
+    for _, index := range []int{0, 4, canvas.NumberOfPoints - 1} {
+        var color [3]float32 = [3]float32{
+            canvas.Colors[4 * index],
+            canvas.Colors[4 * index + 1],
+            canvas.Colors[4 * index + 2],
+        }
+        var normal [3]float32 = [3]float32{
+            canvas.Normals[3 * index],
+            canvas.Normals[3 * index + 1],
+            canvas.Normals[3 * index + 2],
+        }
+
+        expectVector(t, "position", interleavedTriple(canvas, index, 0),
+                     canvas.point(index), 0.0)
+        expectVector(t, "normal", interleavedTriple(canvas, index, 3),
+                     normal, 0.0)
+        expectVector(t, "color", interleavedTriple(canvas, index, 6),
+                     color, 0.0)
+    }
+}
+/*  End of TestBuildInterleavedLayout.                                        */
+
+/*  Without normals or colors the corresponding entries are zero.             */
+func TestBuildInterleavedMissingBuffers(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    canvas.BuildInterleaved()
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        expectVector(t, "position", interleavedTriple(canvas, index, 0),
+                     canvas.point(index), 0.0)
+        expectVector(t, "normal", interleavedTriple(canvas, index, 3),
+                     [3]float32{}, 0.0)
+        expectVector(t, "color", interleavedTriple(canvas, index, 6),
+                     [3]float32{}, 0.0)
+    }
+}
+/*  End of TestBuildInterleavedMissingBuffers.                                */
@@ -0,0 +1,77 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Checks whether a grid fits in the mesh and index buffers.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CanMesh                                                               *
+ *  Purpose:                                                                  *
+ *      Determines if a grid of the given size and mesh type fits in the      *
+ *      global buffers, and the number of bytes its mesh and index buffers    *
+ *      need. Every grid line is assumed to be drawn. The byte lengths are    *
+ *      computed even if the grid does not fit, so a front-end may show them. *
+ *  Arguments:                                                                *
+ *      nxPts (uint32):                                                       *
+ *          The number of points along the horizontal axis.                   *
+ *      nyPts (uint32):                                                       *
+ *          The number of points along the vertical axis.                     *
+ *      meshType (uint):                                                      *
+ *          The type of wireframe.                                            *
+ *  Output:                                                                   *
+ *      fits (bool):                                                          *
+ *          True if the grid fits in the buffers.                             *
+ *      meshBytes (int):                                                      *
+ *          The number of bytes the mesh buffer needs.                        *
+ *      indexBytes (int):                                                     *
+ *          The number of bytes the index buffer needs.                       *
+ ******************************************************************************/
+func CanMesh(nxPts, nyPts uint32, meshType uint) (bool, int, int) {
+
+    /*  A canvas that is only used for computing the sizes, it has no         *
+     *  buffers.                                                              */
+    var sizes Canvas = Canvas{
+        NxPts: nxPts,
+        NyPts: nyPts,
+        MeshType: meshType,
+        WireframeStride: 1,
+    }
+
+    /*  The sizes are computed in 64 bits, see ComputeIndexSize.              */
+    var indexSize uint64 = sizes.indexCount()
+    var meshBytes int = 12 * int(nxPts) * int(nyPts)
+    var indexBytes int = 4 * int(indexSize)
+
+    if checkGrid(nxPts, nyPts, meshType) != nil {
+        return false, meshBytes, indexBytes
+    }
+
+    /*  The product is bounded by MaxLength since both factors are bounded,   *
+     *  the index buffer is checked in case a mesh type needs more room.      */
+    var fits bool = (uint64(nxPts) * uint64(nyPts) <= uint64(MaxLength)) &&
+                    (indexSize <= uint64(MaxIndexBufferSize))
+
+    return fits, meshBytes, indexBytes
+}
+/*  End of CanMesh.                                                           */
@@ -0,0 +1,104 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Functional options for configuring a canvas.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sets the number of points along the horizontal and vertical axes.         */
+func WithGrid(nxPts, nyPts uint32) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.NxPts = nxPts
+        config.NyPts = nyPts
+    }
+}
+
+/*  Sets the width and height of the domain and its starting corner. A        *
+ *  negative width or height sweeps that axis in reverse, the normals and     *
+ *  faces still point the same way, see domainOrientation.                    */
+func WithDomain(width, height, xStart, yStart float32) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.Width = width
+        config.Height = height
+        config.HorizontalStart = xStart
+        config.VerticalStart = yStart
+    }
+}
+
+/*  Sets the type of wireframe, one of the SquareWireframe constants.         */
+func WithMeshType(meshType uint) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.MeshType = meshType
+    }
+}
+
+/*  Only every stride-th grid line is drawn by the wireframe generators.      */
+func WithWireframeStride(stride uint32) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.WireframeStride = stride
+    }
+}
+
+/*  Sets which grid lines are drawn, one of the BothDirections constants.     */
+func WithWireframeDirection(direction uint) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.WireframeDirection = direction
+    }
+}
+
+/*  Sets which seams of the grid are closed, see the WrapU and WrapV fields.  */
+func WithWrap(wrapU, wrapV bool) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.WrapU = wrapU
+        config.WrapV = wrapV
+    }
+}
+
+/*  Sets whether the wireframe is written as line strips, see LineStrips.     */
+func WithLineStrips(enabled bool) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.LineStrips = enabled
+    }
+}
+
+/*  Sets whether the grid is stored by columns, see LayoutColumnMajor.        */
+func WithColumnMajor(enabled bool) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.LayoutColumnMajor = enabled
+    }
+}
+
+/*  Sets which axes the domain is walked backwards along, see FlipU.          */
+func WithFlip(flipU, flipV bool) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.FlipU = flipU
+        config.FlipV = flipV
+    }
+}
+/*  Sets whether the number of vertical points is chosen to give square       *
+ *  cells, replacing the one from WithGrid, see SquareCellPoints.             */
+func WithSquareCells(enabled bool) CanvasOption {
+    return func(config *CanvasConfig) {
+        config.SquareCells = enabled
+    }
+}
+/*  End of canvas options.                                                    */
@@ -0,0 +1,122 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the functional options used to set up a canvas.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Without options the configuration holds the documented defaults.          */
+func TestCanvasConfigDefaults(t *testing.T) {
+    var got CanvasConfig = NewCanvasConfig()
+    var want CanvasConfig = CanvasConfig{
+        NxPts: 64,
+        NyPts: 64,
+        Width: 2.0,
+        Height: 2.0,
+        HorizontalStart: -1.0,
+        VerticalStart: -1.0,
+        MeshType: SquareWireframe,
+        WireframeStride: 1,
+        WireframeDirection: BothDirections,
+    }
+
+    if got != want {
+        t.Errorf("NewCanvasConfig() = %+v, want %+v", got, want)
+    }
+}
+/*  End of TestCanvasConfigDefaults.                                          */
+
+/*  A canvas built from options has each of the requested fields, and the     *
+ *  buffer sizes follow from the grid.                                        */
+func TestCanvasOptionsSetFields(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(6, 4), WithDomain(3.0, 5.0, -2.0, 1.0),
+        WithMeshType(TriangleWireframe), WithWireframeStride(2),
+        WithWireframeDirection(HorizontalDirection), WithWrap(true, false),
+        WithLineStrips(true), WithColumnMajor(true), WithFlip(false, true),
+    )
+
+    var got CanvasConfig = CanvasConfig{
+        NxPts: canvas.NxPts,
+        NyPts: canvas.NyPts,
+        Width: canvas.Width,
+        Height: canvas.Height,
+        HorizontalStart: canvas.HorizontalStart,
+        VerticalStart: canvas.VerticalStart,
+        MeshType: canvas.MeshType,
+        WireframeStride: canvas.WireframeStride,
+        WireframeDirection: canvas.WireframeDirection,
+        WrapU: canvas.WrapU,
+        WrapV: canvas.WrapV,
+        LineStrips: canvas.LineStrips,
+        LayoutColumnMajor: canvas.LayoutColumnMajor,
+        FlipU: canvas.FlipU,
+        FlipV: canvas.FlipV,
+    }
+
+    var want CanvasConfig = CanvasConfig{
+        NxPts: 6,
+        NyPts: 4,
+        Width: 3.0,
+        Height: 5.0,
+        HorizontalStart: -2.0,
+        VerticalStart: 1.0,
+        MeshType: TriangleWireframe,
+        WireframeStride: 2,
+        WireframeDirection: HorizontalDirection,
+        WrapU: true,
+        LineStrips: true,
+        LayoutColumnMajor: true,
+        FlipV: true,
+    }
+
+    if got != want {
+        t.Errorf("canvas fields %+v, want %+v", got, want)
+    }
+
+    if (canvas.NumberOfPoints != 24) || (canvas.MeshSize != 72) {
+        t.Errorf("NumberOfPoints = %d, MeshSize = %d, want 24 and 72",
+                 canvas.NumberOfPoints, canvas.MeshSize)
+    }
+}
+/*  End of TestCanvasOptionsSetFields.                                        */
+
+/*  Options are applied in order, so a later option overrides an earlier one, *
+ *  while square cells are computed from the final grid and domain.           */
+func TestCanvasOptionsOrder(t *testing.T) {
+    var config CanvasConfig = NewCanvasConfig(
+        WithSquareCells(true), WithGrid(5, 5), WithGrid(9, 2),
+        WithDomain(4.0, 2.0, 0.0, 0.0),
+    )
+
+    if config.NxPts != 9 {
+        t.Errorf("NxPts = %d, want the last WithGrid value 9", config.NxPts)
+    }
+
+    if config.NyPts != SquareCellPoints(9, 4.0, 2.0) {
+        t.Errorf("NyPts = %d, want SquareCellPoints(9, 4, 2) = %d",
+                 config.NyPts, SquareCellPoints(9, 4.0, 2.0))
+    }
+}
+/*  End of TestCanvasOptionsOrder.                                            */
@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Lists the features compiled into the package.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Names of the mesh types, indexed by the SquareWireframe constants.        */
+var meshTypeNames = [...]string{
+    "square",
+    "triangle",
+    "cylindricalSquare",
+    "cylindricalTriangle",
+    "mobiusSquare",
+    "mobiusTriangle",
+    "torodialSquare",
+    "torodialTriangle",
+    "kleinSquare",
+    "kleinTriangle",
+    "projectiveSquare",
+    "projectiveTriangle",
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Capabilities                                                          *
+ *  Purpose:                                                                  *
+ *      Lists what this build supports, so that a front end can offer only    *
+ *      the options that exist. The mesh types, export formats, and colormaps *
+ *      are those compiled in, and the surfaces are the registered presets,   *
+ *      sorted by name, including any added by RegisterSurface. The features  *
+ *      are flags for optional functionality, like double buffering.          *
+ *  Arguments:                                                                *
+ *      None.                                                                 *
+ *  Output:                                                                   *
+ *      report (CapabilityReport):                                            *
+ *          The version and the supported features.                           *
+ ******************************************************************************/
+func Capabilities() CapabilityReport {
+
+    /*  Variable for indexing over the mesh types.                            */
+    var meshType uint
+
+    var report CapabilityReport = CapabilityReport{
+        Version: Version,
+        ExportFormats: []string{"obj", "ply", "glb", "svg", "csv", "binary"},
+        Colormaps: []string{"gray", "hue"},
+        Features: []string{
+            "multipleCanvases",
+            "doubleBuffering",
+            "growableBuffers",
+            "wrap",
+            "normals",
+            "tangents",
+            "uvs",
+            "faces",
+            "quadFaces",
+            "interleaved",
+        },
+    }
+
+    for meshType = 0; isValidMeshType(meshType); meshType++ {
+        report.MeshTypes = append(report.MeshTypes, meshTypeNames[meshType])
+    }
+
+    report.Surfaces = ListSurfaces()
+    return report
+}
+/*  End of Capabilities.                                                      */
@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Records the current mesh as the base for interactive rotations.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CaptureBaseOrientation                                                *
+ *  Purpose:                                                                  *
+ *      Copies the current mesh, and the normals if they have been computed,  *
+ *      into the base buffers and resets the accumulated drag angles.         *
+ *      DragRotate always rotates from this copy, so the rounding errors of   *
+ *      many small rotations do not build up. The generators, and the         *
+ *      functions that edit the mesh in place, keep the base up to date, so   *
+ *      this is only needed to make the current view the unrotated one.       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose orientation is being recorded.                   *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) CaptureBaseOrientation() {
+    self.BaseMesh = resizeBuffer(self.BaseMesh, self.MeshSize)
+    copy(self.BaseMesh, self.Mesh[0:self.MeshSize])
+
+    if len(self.Normals) >= self.MeshSize {
+        self.BaseNormals = resizeBuffer(self.BaseNormals, self.MeshSize)
+        copy(self.BaseNormals, self.Normals[0:self.MeshSize])
+    } else {
+        self.BaseNormals = self.BaseNormals[:0]
+    }
+
+    self.Yaw = 0.0
+    self.Pitch = 0.0
+    self.Roll = 0.0
+}
+/*  End of CaptureBaseOrientation.                                            */
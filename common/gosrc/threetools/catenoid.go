@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Parametrization of the catenoid, a minimal surface.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Cosh and Sincos are provided here.                                        */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Catenoid                                                              *
+ *  Purpose:                                                                  *
+ *      Parametrization (cosh(v) cos(u), cosh(v) sin(u), v) of the catenoid,  *
+ *      the surface of revolution of the catenary. Its waist, at v = 0, is    *
+ *      the unit circle. The catenoid can be bent isometrically into the      *
+ *      helicoid, making the two a natural pair for morphing.                 *
+ *  Arguments:                                                                *
+ *      u (float32):                                                          *
+ *          The angle about the z axis.                                       *
+ *      v (float32):                                                          *
+ *          The height.                                                       *
+ *  Output:                                                                   *
+ *      point ([3]float32):                                                   *
+ *          The point on the catenoid.                                        *
+ ******************************************************************************/
+func Catenoid(u, v float32) [3]float32 {
+    var radius float32 = float32(math.Cosh(float64(v)))
+    sinU, cosU := math.Sincos(float64(u))
+    return [3]float32{radius * float32(cosU), radius * float32(sinU), v}
+}
+/*  End of Catenoid.                                                          */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateCatenoid                                                      *
+ *  Purpose:                                                                  *
+ *      Computes the mesh and wireframe of a catenoid with u over the full    *
+ *      circle [0, 2 pi] and v over [bottom, top]. The first and last columns *
+ *      of the grid coincide, closing the surface. The domain of the canvas   *
+ *      is set to match, the number of points must already be set. The        *
+ *      catenoid is not a graph, so the stored surface is cleared.            *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid size already set.     *
+ *      bottom (float32):                                                     *
+ *          The start of the v range.                                         *
+ *      top (float32):                                                        *
+ *          The end of the v range.                                           *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from the wireframe, if any.                             *
+ ******************************************************************************/
+func GenerateCatenoid(canvas *Canvas, bottom, top float32) error {
+    canvas.HorizontalStart = 0.0
+    canvas.Width = 2.0 * math.Pi
+    canvas.VerticalStart = bottom
+    canvas.Height = top - bottom
+    canvas.Surface = nil
+    canvas.GenerateMeshFromParametric(Catenoid)
+    return canvas.GenerateRectangularWireframe()
+}
+/*  End of GenerateCatenoid.                                                  */
@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the catenoid.                                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Cosh is provided by math, the radius of the catenoid.                     */
+import (
+    "math"
+    "testing"
+)
+
+/*  The catenoid replaces a stored graph, its vertices are at distance        *
+ *  cosh(z) from the axis, and the wireframe is rebuilt.                      */
+func TestGenerateCatenoid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 5))
+
+    /*  The segments of the rows and columns of the grid.                     */
+    var wantIndexSize int = 2 * (8 * 5 + 4 * 9)
+
+    if err := GenerateCatenoid(canvas, -1.0, 1.0); err != nil {
+        t.Fatalf("GenerateCatenoid: %v", err)
+    }
+
+    if canvas.Surface != nil {
+        t.Fatalf("the paraboloid is still stored after GenerateCatenoid")
+    }
+
+    /*  Regenerating must not draw the old graph over the catenoid.           */
+    if err := canvas.Regenerate(); err != nil {
+        t.Fatalf("Regenerate: %v", err)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var radius float64 = math.Hypot(float64(p[0]), float64(p[1]))
+        var want float64 = math.Cosh(float64(p[2]))
+
+        if !closeTo(float32(radius), float32(want), 1.0E-5) {
+            t.Fatalf("vertex %v is not on the catenoid", p)
+        }
+    }
+
+    if canvas.IndexSize != wantIndexSize {
+        t.Errorf("IndexSize = %d, want %d", canvas.IndexSize, wantIndexSize)
+    }
+}
+/*  End of TestGenerateCatenoid.                                              */
@@ -0,0 +1,107 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Refinement indicators for the cells of a grid.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library with Acos, used for the angle between normals.           */
+import "math"
+
+/*  Returns the four corners of the cell with bottom left corner (x, y), as   *
+ *  vertex indices, in counterclockwise order for increasing x and y.         */
+func cellCorners(canvas *Canvas, xIndex, yIndex uint32) [4]uint32 {
+    return [4]uint32{
+        canvas.gridIndex(xIndex, yIndex),
+        canvas.gridIndex(xIndex + 1, yIndex),
+        canvas.gridIndex(xIndex + 1, yIndex + 1),
+        canvas.gridIndex(xIndex, yIndex + 1),
+    }
+}
+
+/*  Estimates how much a surface rises across a cell, the largest height of   *
+ *  its four corners minus the smallest. For a graph this is large where the  *
+ *  surface is steep, like the rim of a paraboloid.                           */
+func CellHeightChange(canvas *Canvas, xIndex, yIndex uint32) float32 {
+    var corners [4]uint32 = cellCorners(canvas, xIndex, yIndex)
+    var low float32 = canvas.point(int(corners[0]))[2]
+    var high float32 = low
+
+    for _, corner := range corners[1:] {
+        var z float32 = canvas.point(int(corner))[2]
+
+        if z < low {
+            low = z
+        }
+
+        if z > high {
+            high = z
+        }
+    }
+
+    return high - low
+}
+/*  End of CellHeightChange.                                                  */
+
+/*  Estimates the curvature of a surface across a cell, the largest angle, in *
+ *  radians, between the vertex normals of two of its corners. The normals    *
+ *  are computed if they are not already. This is large where the surface     *
+ *  bends sharply, and zero where it is flat, even if it is steep.            */
+func CellNormalTurning(canvas *Canvas, xIndex, yIndex uint32) float32 {
+    var corners [4]uint32 = cellCorners(canvas, xIndex, yIndex)
+    var smallest float32 = 1.0
+
+    if len(canvas.Normals) < canvas.MeshSize {
+        canvas.ComputeNormals()
+    }
+
+    for first := 0; first < 4; first++ {
+        for second := first + 1; second < 4; second++ {
+            var p int = 3 * int(corners[first])
+            var q int = 3 * int(corners[second])
+            var cosine float32 = dotProduct(
+                [3]float32{
+                    canvas.Normals[p],
+                    canvas.Normals[p + 1],
+                    canvas.Normals[p + 2],
+                },
+                [3]float32{
+                    canvas.Normals[q],
+                    canvas.Normals[q + 1],
+                    canvas.Normals[q + 2],
+                },
+            )
+
+            if cosine < smallest {
+                smallest = cosine
+            }
+        }
+    }
+
+    /*  Rounding may push the cosine slightly below -1.                       */
+    if smallest < -1.0 {
+        smallest = -1.0
+    }
+
+    return float32(math.Acos(float64(smallest)))
+}
+/*  End of CellNormalTurning.                                                 */
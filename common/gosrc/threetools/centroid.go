@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the centroid of the vertices of a mesh.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Centroid                                                              *
+ *  Purpose:                                                                  *
+ *      Computes the mean of the active vertices of the mesh. Only the first  *
+ *      NumberOfPoints vertices are used, the rest of the buffer is ignored.  *
+ *      This is not the center of the bounding box, the two differ for        *
+ *      surfaces that are not symmetric.                                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose centroid is being computed.                      *
+ *  Output:                                                                   *
+ *      center ([3]float32):                                                  *
+ *          The mean of the vertices, the origin for an empty mesh.           *
+ ******************************************************************************/
+func (self *Canvas) Centroid() [3]float32 {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The sum is accumulated in double precision to limit round-off error   *
+     *  for large meshes.                                                     */
+    var sum [3]float64
+
+    /*  The output, the mean of the vertices.                                 */
+    var center [3]float32
+
+    if self.NumberOfPoints == 0 {
+        return center
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        sum[0] += float64(self.Mesh[index])
+        sum[1] += float64(self.Mesh[index + 1])
+        sum[2] += float64(self.Mesh[index + 2])
+    }
+
+    center[0] = float32(sum[0] / float64(self.NumberOfPoints))
+    center[1] = float32(sum[1] / float64(self.NumberOfPoints))
+    center[2] = float32(sum[2] / float64(self.NumberOfPoints))
+    return center
+}
+/*  End of Centroid.                                                          */
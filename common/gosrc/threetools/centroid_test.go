@@ -0,0 +1,40 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the centroid of the mesh.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The paraboloid over a square centered at the origin is symmetric about    *
+ *  the z axis, so its centroid is on that axis. On a 5x5 grid the mean of    *
+ *  x^2 is 1/2, and so is that of y^2, giving a height of 1.                  */
+func TestCentroidParaboloid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 5), WithDomain(2.0, 2.0, -1.0, -1.0),
+    )
+
+    expectVector(t, "centroid", canvas.Centroid(),
+                 [3]float32{0.0, 0.0, 1.0}, 1.0E-6)
+}
+/*  End of TestCentroidParaboloid.                                            */
@@ -0,0 +1,54 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Checks that a canvas can be drawn as a graph.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Returns ErrIncompatibleGenerator if the canvas closes any seams, with a   *
+ *  wrapped mesh type or the WrapU and WrapV flags. A graph z = f(x, y) over  *
+ *  a rectangle has no seams, joining its edges draws segments across the     *
+ *  whole domain. Such surfaces are drawn with GenerateMeshFromParametric.    */
+func (self *Canvas) checkGraphTopology() error {
+    wrapU, wrapV := self.gridWraps()
+
+    if (self.MeshType != SquareWireframe) &&
+       (self.MeshType != TriangleWireframe) {
+        return fmt.Errorf(
+            "%w: mesh type %d has closed seams",
+            ErrIncompatibleGenerator, self.MeshType,
+        )
+    }
+
+    if wrapU || wrapV {
+        return fmt.Errorf(
+            "%w: the grid wraps, WrapU %t and WrapV %t",
+            ErrIncompatibleGenerator, wrapU, wrapV,
+        )
+    }
+
+    return nil
+}
+/*  End of checkGraphTopology.                                                */
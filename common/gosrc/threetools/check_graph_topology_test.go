@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that the graph generators reject topologies with closed seams.  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped, and fmt     *
+ *  names the mesh types.                                                     */
+import (
+    "errors"
+    "fmt"
+    "testing"
+)
+
+/*  Runs both graph generators on a canvas with the given options and checks  *
+ *  that each fails with ErrIncompatibleGenerator, or succeeds, as wanted.    */
+func expectGraphTopology(t *testing.T, name string,
+                         incompatible bool, opts ...CanvasOption) {
+    t.Helper()
+
+    opts = append([]CanvasOption{WithGrid(5, 4)}, opts...)
+
+    var canvas *Canvas = newTestCanvas(t, opts...)
+    var heights []float32 = make([]float32, canvas.NumberOfPoints)
+
+    var fromFunction error = canvas.GenerateMeshFromParametrization(paraboloid)
+    var fromData error = canvas.GenerateMeshFromData(heights)
+
+    for _, err := range []error{fromFunction, fromData} {
+        if incompatible && !errors.Is(err, ErrIncompatibleGenerator) {
+            t.Errorf("%s: %v, want ErrIncompatibleGenerator", name, err)
+        }
+
+        if !incompatible && (err != nil) {
+            t.Errorf("%s: unexpected error %v", name, err)
+        }
+    }
+}
+/*  End of expectGraphTopology.                                               */
+
+/*  Only the plain square and triangle grids can be drawn as a graph. Every   *
+ *  wrapped mesh type, and a plain grid with WrapU or WrapV set, is rejected. */
+func TestGraphGeneratorsRejectWrappedTopology(t *testing.T) {
+    for meshType := uint(0); isValidMeshType(meshType); meshType++ {
+        var plain bool = (meshType == SquareWireframe) ||
+                         (meshType == TriangleWireframe)
+
+        var name string = fmt.Sprintf("mesh type %d", meshType)
+
+        expectGraphTopology(t, name, !plain, WithMeshType(meshType))
+    }
+
+    expectGraphTopology(t, "WrapU", true, WithWrap(true, false))
+    expectGraphTopology(t, "WrapV", true, WithWrap(false, true))
+}
+/*  End of TestGraphGeneratorsRejectWrappedTopology.                          */
@@ -0,0 +1,55 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Validates the grid dimensions and mesh type of a canvas.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      checkGrid                                                             *
+ *  Purpose:                                                                  *
+ *      Checks that a grid fits in the global buffers and that the mesh type  *
+ *      is supported, before anything is written using these values.          *
+ *  Arguments:                                                                *
+ *      nxPts (uint32):                                                       *
+ *          The number of points along the horizontal axis.                   *
+ *      nyPts (uint32):                                                       *
+ *          The number of points along the vertical axis.                     *
+ *      meshType (uint):                                                      *
+ *          The type of wireframe.                                            *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge or ErrInvalidMeshType, nil if valid.              *
+ ******************************************************************************/
+func checkGrid(nxPts, nyPts uint32, meshType uint) error {
+    if (nxPts > MaxWidth) || (nyPts > MaxHeight) {
+        return ErrGridTooLarge
+    }
+
+    if !isValidMeshType(meshType) {
+        return ErrInvalidMeshType
+    }
+
+    return nil
+}
+/*  End of checkGrid.                                                         */
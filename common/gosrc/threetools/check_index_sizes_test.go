@@ -0,0 +1,121 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that the wireframe generators write exactly as many indices as  *
+ *      ComputeIndexSize predicts.                                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Marks the index storage that has not been written to. This is neither a   *
+ *  vertex of the small grids below nor StripRestartIndex.                    */
+const unwrittenIndex uint32 = StripRestartIndex - 1
+
+/*  The grid sizes to try along each axis, including the degenerate ones.     */
+var indexSizeGrids []uint32 = []uint32{1, 2, 3, 4, 7}
+
+/*  The ways a wireframe may be drawn, the direction, the stride, whether the *
+ *  plain grids close their seams, and whether line strips are used.          */
+type indexSizeStyle struct {
+    direction uint
+    stride uint32
+    wrap bool
+    strips bool
+}
+
+/*  The styles that are tried for every mesh type and grid size.              */
+var indexSizeStyles []indexSizeStyle = []indexSizeStyle{
+    {BothDirections, 1, false, false},
+    {BothDirections, 1, true, false},
+    {BothDirections, 3, false, false},
+    {HorizontalDirection, 1, true, false},
+    {VerticalDirection, 2, false, false},
+    {DiagonalDirection, 1, true, false},
+    {BothDirections, 1, false, true},
+    {BothDirections, 2, true, true},
+    {DiagonalDirection, 1, false, true},
+}
+
+/*  Generates the wireframe of a canvas with the given mesh type, grid, and   *
+ *  style, and checks that exactly the predicted number of indices is         *
+ *  written.                                                                  */
+func checkIndexCount(t *testing.T, meshType uint,
+                     nxPts, nyPts uint32, style indexSizeStyle) {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(nxPts, nyPts), WithMeshType(meshType),
+        WithWireframeDirection(style.direction),
+        WithWireframeStride(style.stride),
+        WithWrap(style.wrap, style.wrap), WithLineStrips(style.strips),
+    )
+
+    /*  The number of storage entries the generator changed.                  */
+    var written int = 0
+
+    if err := canvas.ComputeIndexSize(); err != nil {
+        t.Fatalf("ComputeIndexSize: %v", err)
+    }
+
+    var predicted int = canvas.IndexSize
+
+    for index := range canvas.IndexStorage {
+        canvas.IndexStorage[index] = unwrittenIndex
+    }
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    for _, value := range canvas.IndexStorage {
+        if value != unwrittenIndex {
+            written++
+        }
+    }
+
+    if (written != predicted) || (canvas.IndexSize != predicted) {
+        t.Errorf(
+            "mesh type %d, %dx%d grid, style %+v: wrote %d indices, " +
+            "IndexSize %d, predicted %d", meshType, nxPts, nyPts, style,
+            written, canvas.IndexSize, predicted,
+        )
+    }
+}
+/*  End of checkIndexCount.                                                   */
+
+/*  For every mesh type, grid size, and style, GenerateRectangularWireframe   *
+ *  writes exactly IndexSize entries, and IndexSize is what ComputeIndexSize  *
+ *  predicted. The mesh types are enumerated with isValidMeshType, so new     *
+ *  mesh types are checked automatically.                                     */
+func TestIndexCountMatchesComputeIndexSize(t *testing.T) {
+    for meshType := uint(0); isValidMeshType(meshType); meshType++ {
+        for _, nxPts := range indexSizeGrids {
+            for _, nyPts := range indexSizeGrids {
+                for _, style := range indexSizeStyles {
+                    checkIndexCount(t, meshType, nxPts, nyPts, style)
+                }
+            }
+        }
+    }
+}
+/*  End of TestIndexCountMatchesComputeIndexSize.                             */
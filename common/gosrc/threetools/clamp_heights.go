@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Clamps the heights of a mesh to a range.                              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ClampHeights                                                          *
+ *  Purpose:                                                                  *
+ *      Clamps the z coordinate of every active vertex into the range [zMin,  *
+ *      zMax], in place. Surfaces that spike far outside the view, like 1 / r *
+ *      near the origin, are cut off flat and the figure stays on screen.     *
+ *      Infinite heights are clamped too, NaNs are left as they are. The      *
+ *      bounds are swapped if given in the wrong order. Normals computed      *
+ *      before are not updated, recompute them afterwards.                    *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being clamped.                           *
+ *      zMin (float32):                                                       *
+ *          The smallest allowed height.                                      *
+ *      zMax (float32):                                                       *
+ *          The largest allowed height.                                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ClampHeights(zMin, zMax float32) {
+
+    /*  Variable for indexing over the z coordinates.                         */
+    var index int
+
+    if zMin > zMax {
+        zMin, zMax = zMax, zMin
+    }
+
+    for index = 2; index < self.MeshSize; index += 3 {
+        if self.Mesh[index] < zMin {
+            self.Mesh[index] = zMin
+        } else if self.Mesh[index] > zMax {
+            self.Mesh[index] = zMax
+        }
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of ClampHeights.                                                      */
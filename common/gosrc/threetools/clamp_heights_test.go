@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for clamping the heights of a surface to a range.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The paraboloid on [-1, 1] x [-1, 1] rises from 0 to 2. Clamped to [0.25,  *
+ *  1.5] every z is within the range, the heights inside it are kept, and x   *
+ *  and y are untouched. The ends of the range may be given in either order.  */
+func TestClampHeightsRange(t *testing.T) {
+    for _, bounds := range [][2]float32{{0.25, 1.5}, {1.5, 0.25}} {
+        var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 9))
+        var original *Canvas = canvas.Clone()
+
+        canvas.ClampHeights(bounds[0], bounds[1])
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            var p [3]float32 = canvas.point(index)
+            var q [3]float32 = original.point(index)
+            var want float32 = q[2]
+
+            if want < 0.25 {
+                want = 0.25
+            } else if want > 1.5 {
+                want = 1.5
+            }
+
+            if (p[0] != q[0]) || (p[1] != q[1]) || (p[2] != want) {
+                t.Errorf("bounds %v: vertex %d is %v, want (%g, %g, %g)",
+                         bounds, index, p, q[0], q[1], want)
+            }
+        }
+    }
+}
+/*  End of TestClampHeightsRange.                                             */
@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Empties the buffers computed from the mesh of a canvas.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Empties the buffers that are computed from the mesh, like the normals and *
+ *  faces, and forgets the stored surface. This is used when the mesh is      *
+ *  replaced by something other than a grid over the domain, so that stale    *
+ *  data from the previous mesh is never used. The memory is kept so it may   *
+ *  be reused when the buffers are computed again.                            */
+func (self *Canvas) clearDerivedBuffers() {
+    self.Normals = self.Normals[:0]
+    self.Colors = self.Colors[:0]
+    self.UVs = self.UVs[:0]
+    self.ScalarField = self.ScalarField[:0]
+    self.Gradients = self.Gradients[:0]
+    self.Tangents = self.Tangents[:0]
+    self.Faces = self.Faces[:0]
+    self.FaceNormals = self.FaceNormals[:0]
+    self.FaceColors = self.FaceColors[:0]
+    self.QuadFaces = self.QuadFaces[:0]
+    self.LineQuadMesh = self.LineQuadMesh[:0]
+    self.LineQuadFaces = self.LineQuadFaces[:0]
+    self.Interleaved = self.Interleaved[:0]
+    self.BaseMesh = self.BaseMesh[:0]
+    self.BaseNormals = self.BaseNormals[:0]
+    self.LODStart = self.LODStart[:0]
+    self.LODEnd = self.LODEnd[:0]
+    self.Surface = nil
+    self.HasSpinCenter = false
+}
+/*  End of clearDerivedBuffers.                                               */
@@ -0,0 +1,133 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Trims the parts of a mesh that lie below a plane.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ClipBelow                                                             *
+ *  Purpose:                                                                  *
+ *      Removes the line segments, and triangle faces, that touch a vertex on *
+ *      the negative side of the plane ax + by + cz + d = 0. This cuts the    *
+ *      surface open to reveal its interior. The vertices themselves are      *
+ *      kept, so the remaining indices stay valid, and the remaining segments *
+ *      and faces are moved to the front of their buffers, keeping their      *
+ *      order. With LineStrips the clipped vertices split their strips, and   *
+ *      the removed count is still that of the segments.                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being clipped.                   *
+ *      plane ([4]float32):                                                   *
+ *          The coefficients a, b, c, and d of the plane.                     *
+ *  Output:                                                                   *
+ *      removed (int):                                                        *
+ *          The number of line segments that were removed.                    *
+ ******************************************************************************/
+func (self *Canvas) ClipBelow(plane [4]float32) int {
+
+    /*  Variables for indexing over the vertices, segments, and faces.        */
+    var index, kept int
+
+    /*  Flags for the vertices that are cut away.                             */
+    var clipped []bool = make([]bool, self.NumberOfPoints)
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var p [3]float32 = self.point(index)
+        var height float32 = plane[0] * p[0] + plane[1] * p[1] +
+                             plane[2] * p[2] + plane[3]
+
+        clipped[index] = height < 0.0
+    }
+
+    /*  The segments before clipping, for counting how many were removed.     */
+    var removed int = self.SegmentCount()
+
+    /*  A clipped vertex of a line strip becomes a restart, which removes the *
+     *  segments on either side of it.                                        */
+    if self.LineStrips {
+        for index = 0; index < self.IndexSize; index++ {
+            var vertex uint32 = self.Indices[index]
+
+            if (vertex != StripRestartIndex) && clipped[vertex] {
+                self.Indices[index] = StripRestartIndex
+            }
+        }
+
+        self.compactStrips()
+    } else {
+
+        /*  Keep the segments with both endpoints on or above the plane.      */
+        for index = 0; index + 1 < self.IndexSize; index += 2 {
+            var start uint32 = self.Indices[index]
+            var end uint32 = self.Indices[index + 1]
+
+            if clipped[start] || clipped[end] {
+                continue
+            }
+
+            self.Indices[kept] = start
+            self.Indices[kept + 1] = end
+            kept += 2
+        }
+
+        self.IndexSize = kept
+        self.Indices = self.Indices[0:kept]
+    }
+
+    removed -= self.SegmentCount()
+
+    /*  The faces are trimmed the same way.                                   */
+    kept = 0
+
+    for index = 0; index + 2 < len(self.Faces); index += 3 {
+        var a uint32 = self.Faces[index]
+        var b uint32 = self.Faces[index + 1]
+        var c uint32 = self.Faces[index + 2]
+
+        if clipped[a] || clipped[b] || clipped[c] {
+            continue
+        }
+
+        /*  The face normals, if computed, move along with their faces.       */
+        if len(self.FaceNormals) == len(self.Faces) {
+            copy(
+                self.FaceNormals[kept:kept + 3],
+                self.FaceNormals[index:index + 3],
+            )
+        }
+
+        self.Faces[kept] = a
+        self.Faces[kept + 1] = b
+        self.Faces[kept + 2] = c
+        kept += 3
+    }
+
+    if len(self.FaceNormals) == len(self.Faces) {
+        self.FaceNormals = self.FaceNormals[0:kept]
+    }
+
+    self.Faces = self.Faces[0:kept]
+    return removed
+}
+/*  End of ClipBelow.                                                         */
@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for trimming the mesh with a clipping plane.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Clipping the paraboloid z = x^2 + y^2 - 1/2 below z = 0 keeps exactly the *
+ *  segments with both ends on or above the plane, in their original order,   *
+ *  and reports how many were removed.                                        */
+func TestClipBelowParaboloid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, func(x, y float32) float32 {
+        return x*x + y*y - 0.5
+    }, WithGrid(5, 5), WithDomain(2.0, 2.0, -1.0, -1.0))
+
+    var above []uint32
+
+    for index := 0; index + 1 < canvas.IndexSize; index += 2 {
+        var a, b uint32 = canvas.Indices[index], canvas.Indices[index + 1]
+        var za, zb float32 = canvas.point(int(a))[2], canvas.point(int(b))[2]
+
+        if (za >= 0.0) && (zb >= 0.0) {
+            above = append(above, a, b)
+        }
+    }
+
+    var before int = canvas.IndexSize
+    var removed int = canvas.ClipBelow([4]float32{0.0, 0.0, 1.0, 0.0})
+
+    if (len(above) == 0) || (len(above) == before) {
+        t.Fatalf("%d of %d indices above the plane, want some but not all",
+                 len(above), before)
+    }
+
+    if (canvas.IndexSize != len(above)) ||
+       (2 * removed != before - len(above)) {
+        t.Fatalf("%d indices kept and %d segments removed, want %d and %d",
+                 canvas.IndexSize, removed, len(above),
+                 (before - len(above)) / 2)
+    }
+
+    for index, want := range above {
+        if canvas.Indices[index] != want {
+            t.Fatalf("index %d is %d, want %d",
+                     index, canvas.Indices[index], want)
+        }
+    }
+}
+/*  End of TestClipBelowParaboloid.                                           */
@@ -0,0 +1,105 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a deep copy of a canvas.                                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns a copy of a slice that does not share memory with the input.      */
+func cloneBuffer[T any](buffer []T) []T {
+    if buffer == nil {
+        return nil
+    }
+
+    var copied []T = make([]T, len(buffer))
+    copy(copied, buffer)
+    return copied
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Clone                                                                 *
+ *  Purpose:                                                                  *
+ *      Creates a deep copy of a canvas. The copy has its own mesh, index,    *
+ *      and auxiliary buffers, and does not alias the global buffers, so      *
+ *      either canvas may be modified without changing the other. Only the    *
+ *      active portions of the mesh and index buffers are copied, and the     *
+ *      storage of the copy is exactly this size. To hold a larger mesh, give *
+ *      the copy new storage.                                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being copied.                                          *
+ *  Output:                                                                   *
+ *      clone (*Canvas):                                                      *
+ *          A deep copy of the canvas.                                        *
+ ******************************************************************************/
+func (self *Canvas) Clone() *Canvas {
+
+    /*  Copy all of the sizes and geometry. The slices still refer to the     *
+     *  memory of the original canvas, these are replaced below.              */
+    var clone Canvas = *self
+
+    /*  The active parts of the mesh and index buffers are copied and used as *
+     *  the storage for the new canvas.                                       */
+    clone.MeshStorage = cloneBuffer(self.Mesh)
+    clone.IndexStorage = cloneBuffer(self.Indices)
+    clone.Mesh = clone.MeshStorage
+    clone.Indices = clone.IndexStorage
+
+    /*  The copy starts out single buffered, it gets a front buffer the first *
+     *  time its buffers are swapped.                                         */
+    clone.FrontMesh = nil
+    clone.FrontStorage = nil
+
+    /*  The auxiliary buffers are copied in full.                             */
+    clone.Normals = cloneBuffer(self.Normals)
+    clone.Colors = cloneBuffer(self.Colors)
+    clone.UVs = cloneBuffer(self.UVs)
+    clone.ScalarField = cloneBuffer(self.ScalarField)
+    clone.Gradients = cloneBuffer(self.Gradients)
+    clone.Tangents = cloneBuffer(self.Tangents)
+    clone.Faces = cloneBuffer(self.Faces)
+    clone.FaceNormals = cloneBuffer(self.FaceNormals)
+    clone.FaceColors = cloneBuffer(self.FaceColors)
+    clone.QuadFaces = cloneBuffer(self.QuadFaces)
+    clone.LineQuadMesh = cloneBuffer(self.LineQuadMesh)
+    clone.LineQuadFaces = cloneBuffer(self.LineQuadFaces)
+    clone.ReferenceMesh = cloneBuffer(self.ReferenceMesh)
+    clone.ReferenceIndices = cloneBuffer(self.ReferenceIndices)
+    clone.Interleaved = cloneBuffer(self.Interleaved)
+    clone.BaseMesh = cloneBuffer(self.BaseMesh)
+    clone.BaseNormals = cloneBuffer(self.BaseNormals)
+    clone.LODStart = cloneBuffer(self.LODStart)
+    clone.LODEnd = cloneBuffer(self.LODEnd)
+
+    /*  The coefficients are a map, which would also be shared.               */
+    if self.Coefficients != nil {
+        clone.Coefficients = make(map[string]float32, len(self.Coefficients))
+
+        for name, value := range self.Coefficients {
+            clone.Coefficients[name] = value
+        }
+    }
+
+    return &clone
+}
+/*  End of Clone.                                                             */
@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for snapshotting a canvas with Clone.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Changing the mesh, indices, normals, faces, and coefficients of a clone   *
+ *  leaves the original canvas as it was, and the clone starts out equal to   *
+ *  the original.                                                             */
+func TestCloneIsDeep(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(5, 5))
+
+    if err := canvas.GenerateSurfacePreset("bessel_ripple"); err != nil {
+        t.Fatalf("GenerateSurfacePreset: %v", err)
+    }
+
+    /*  Give the original its own coefficients for the clone to copy.         */
+    canvas.SetCoefficient("k", 3.0)
+    canvas.ComputeNormals()
+    canvas.GenerateTriangleFaces()
+
+    var dump string = DumpMesh(canvas)
+    var normals []float32 = append([]float32(nil), canvas.Normals...)
+    var clone *Canvas = canvas.Clone()
+
+    if DumpMesh(clone) != dump {
+        t.Fatal("the clone differs from the original")
+    }
+
+    clone.RotateMesh(UnitVectorFromAngle(1.0))
+    clone.Indices[0] = clone.Indices[1]
+    clone.Normals[0] = 5.0
+    clone.Faces[0] = clone.Faces[1]
+
+    if !clone.SetCoefficient("k", 7.0) {
+        t.Fatal("SetCoefficient rejected k")
+    }
+
+    if DumpMesh(canvas) != dump {
+        t.Error("changing the clone changed the mesh, indices, or faces")
+    }
+
+    for index := range normals {
+        if canvas.Normals[index] != normals[index] {
+            t.Fatalf("changing the clone changed normal entry %d", index)
+        }
+    }
+
+    if canvas.Coefficients["k"] == 7.0 {
+        t.Error("changing the clone changed the coefficients")
+    }
+}
+/*  End of TestCloneIsDeep.                                                   */
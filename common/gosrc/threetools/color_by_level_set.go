@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Colors the vertices of a mesh near a chosen height.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ColorByLevelSet                                                       *
+ *  Purpose:                                                                  *
+ *      Colors the vertices with |z - z0| <= tolerance with LevelSetColor,    *
+ *      and the rest with NeutralColor, writing into the color buffer. This   *
+ *      draws a colored band along the contour z = z0 without changing the    *
+ *      mesh. The width of the band is set by the tolerance.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose vertices are being colored.                      *
+ *      z0 (float32):                                                         *
+ *          The height of the level set.                                      *
+ *      tolerance (float32):                                                  *
+ *          The largest distance from z0 that is highlighted.                 *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ColorByLevelSet(z0, tolerance float32) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    self.Colors = resizeBuffer(self.Colors, 4 * self.NumberOfPoints)
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var distance float32 = self.Mesh[3 * index + 2] - z0
+        var color [3]float32 = NeutralColor
+
+        if (distance <= tolerance) && (distance >= -tolerance) {
+            color = LevelSetColor
+        }
+
+        setVertexColor(self.Colors, index, color)
+    }
+}
+/*  End of ColorByLevelSet.                                                   */
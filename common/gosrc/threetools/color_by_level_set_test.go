@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for highlighting a level set of the surface.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On the paraboloid over [-1, 1] x [-1, 1] with a 5x5 grid the heights are  *
+ *  sums of 0, 1/4, and 1. The band 1/2 +- 0.3 holds the vertices at heights  *
+ *  1/4 and 1/2 only, which get LevelSetColor, and every other vertex gets    *
+ *  NeutralColor, both fully opaque.                                          */
+func TestColorByLevelSetBand(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 5), WithDomain(2.0, 2.0, -1.0, -1.0),
+    )
+
+    var inside int = 0
+    canvas.ColorByLevelSet(0.5, 0.3)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var z float32 = canvas.point(index)[2]
+        var want [3]float32 = NeutralColor
+        var color [3]float32 = [3]float32{
+            canvas.Colors[4 * index],
+            canvas.Colors[4 * index + 1],
+            canvas.Colors[4 * index + 2],
+        }
+
+        if (z >= 0.2) && (z <= 0.8) {
+            want = LevelSetColor
+            inside++
+        }
+
+        if (color != want) || (canvas.Colors[4 * index + 3] != 1.0) {
+            t.Errorf("vertex at height %g has color %v, want %v",
+                     z, color, want)
+        }
+    }
+
+    /*  The four neighbors of the center are at 1/4, the four diagonal ones   *
+     *  at 1/2.                                                               */
+    if inside != 8 {
+        t.Errorf("%d vertices in the band, want 8", inside)
+    }
+}
+/*  End of TestColorByLevelSetBand.                                           */
@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Colors the vertices of a mesh by their distance from the z axis.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt is provided here.                                                    */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ColorByRadius                                                         *
+ *  Purpose:                                                                  *
+ *      Colors each vertex by its distance from the z axis, the radius        *
+ *      sqrt(x^2 + y^2), writing into the color buffer. The radii are divided *
+ *      by the largest radius in the mesh, so the axis gets the low end of    *
+ *      the colormap and the vertices farthest out the high end. This brings  *
+ *      out the radial structure of surfaces like the Gaussian bump. If every *
+ *      vertex is on the axis they all get the low end.                       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose vertices are being colored.                      *
+ *      cmap (Colormap):                                                      *
+ *          The colormap, for example HueColormap.                            *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ColorByRadius(cmap Colormap) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The largest radius, used for normalizing.                             */
+    var maxRadius float32 = 0.0
+
+    /*  The radius of each vertex, computed once.                             */
+    var radii []float32 = make([]float32, self.NumberOfPoints)
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var x float64 = float64(self.Mesh[3 * index])
+        var y float64 = float64(self.Mesh[3 * index + 1])
+
+        radii[index] = float32(math.Sqrt(x * x + y * y))
+
+        if radii[index] > maxRadius {
+            maxRadius = radii[index]
+        }
+    }
+
+    self.Colors = resizeBuffer(self.Colors, 4 * self.NumberOfPoints)
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var t float32 = 0.0
+
+        if maxRadius > 0.0 {
+            t = radii[index] / maxRadius
+        }
+
+        var color [3]float32 = cmap(t)
+        setVertexColor(self.Colors, index, color)
+    }
+}
+/*  End of ColorByRadius.                                                     */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for coloring the vertices by their distance from the axis.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Hypot and Sqrt2 are provided by math.                                     */
+import (
+    "math"
+    "testing"
+)
+
+/*  With the gray colormap the center of a 5x5 grid on [-1, 1] x [-1, 1] is   *
+ *  black, the low end, the corners on the rim are white, the high end, and   *
+ *  every other vertex is the gray of its radius over sqrt(2).                */
+func TestColorByRadiusCenterAndRim(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    canvas.ColorByRadius(GrayColormap)
+
+    var center int = int(canvas.gridIndex(2, 2))
+    var corner int = int(canvas.gridIndex(4, 4))
+
+    if canvas.Colors[4 * center] != 0.0 {
+        t.Errorf("center has color %v, want black",
+                 canvas.Colors[4 * center : 4 * center + 4])
+    }
+
+    if canvas.Colors[4 * corner] != 1.0 {
+        t.Errorf("corner has color %v, want white",
+                 canvas.Colors[4 * corner : 4 * corner + 4])
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var r float64 = math.Hypot(float64(p[0]), float64(p[1]))
+        var gray float32 = float32(r / math.Sqrt2)
+        var want [3]float32 = [3]float32{gray, gray, gray}
+        var got [3]float32
+
+        copy(got[:], canvas.Colors[4 * index : 4 * index + 3])
+        expectVector(t, "color", got, want, 1.0E-6)
+
+        if canvas.Colors[4 * index + 3] != 1.0 {
+            t.Errorf("vertex %d has alpha %g, want 1",
+                     index, canvas.Colors[4 * index + 3])
+        }
+    }
+}
+/*  End of TestColorByRadiusCenterAndRim.                                     */
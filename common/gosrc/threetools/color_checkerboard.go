@@ -0,0 +1,106 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Colors the cells of the grid in a checkerboard pattern.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the color of the cell with bottom left corner (x, y).             */
+func checkerboardColor(x, y uint32, colorA, colorB [3]float32) [3]float32 {
+    if (x + y) % 2 == 0 {
+        return colorA
+    }
+
+    return colorB
+}
+/*  End of checkerboardColor.                                                 */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ColorCheckerboard                                                     *
+ *  Purpose:                                                                  *
+ *      Colors the cells of the grid in two alternating colors, the cell with *
+ *      bottom left corner (x, y) getting colorA if x + y is even and colorB  *
+ *      if it is odd. Neighboring cells share their vertices, so a color per  *
+ *      vertex cannot give every cell a single color. Each vertex gets the    *
+ *      color of the cell it is the bottom left corner of, written into the   *
+ *      color buffer, and with these the colors blend across the cells. The   *
+ *      crisp pattern is written per face: if the face buffer holds the       *
+ *      triangles of GenerateTriangleFaces, two per cell, each triangle gets  *
+ *      the color of its cell in FaceColors. Draw these with non-indexed      *
+ *      geometry built from the faces, so that no vertex is shared between    *
+ *      triangles.                                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose cells are being colored.                         *
+ *      colorA ([3]float32):                                                  *
+ *          The color of the even cells.                                      *
+ *      colorB ([3]float32):                                                  *
+ *          The color of the odd cells.                                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ColorCheckerboard(colorA, colorB [3]float32) {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  The number of cells in each row, and in the grid.                     */
+    cellsPerRow, cellsPerColumn := self.gridCells()
+    var numberOfCells int = int(cellsPerRow * cellsPerColumn)
+
+    self.Colors = resizeBuffer(self.Colors, 4 * self.NumberOfPoints)
+
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            var index int = int(self.gridIndex(xIndex, yIndex))
+            var color [3]float32 =
+                checkerboardColor(xIndex, yIndex, colorA, colorB)
+
+            if index >= self.NumberOfPoints {
+                continue
+            }
+
+            setVertexColor(self.Colors, index, color)
+        }
+    }
+
+    /*  Faces that do not come from the grid have no cell to be colored by.   */
+    if len(self.Faces) != 6 * numberOfCells {
+        self.FaceColors = self.FaceColors[:0]
+        return
+    }
+
+    self.FaceColors = resizeBuffer(self.FaceColors, 6 * numberOfCells)
+
+    /*  The two triangles of each cell are stored one after the other, with   *
+     *  the cells ordered row by row.                                         */
+    for cell := 0; cell < numberOfCells; cell++ {
+        var xCell uint32 = uint32(cell) % cellsPerRow
+        var yCell uint32 = uint32(cell) / cellsPerRow
+        var color [3]float32 = checkerboardColor(xCell, yCell, colorA, colorB)
+
+        copy(self.FaceColors[6 * cell:6 * cell + 3], color[:])
+        copy(self.FaceColors[6 * cell + 3:6 * cell + 6], color[:])
+    }
+}
+/*  End of ColorCheckerboard.                                                 */
@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the checkerboard coloring of the grid.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On a 4x3 grid each vertex has the color given by the parity of its grid   *
+ *  indices, with full opacity, and both triangles of each cell have the      *
+ *  color given by the parity of the cell.                                    */
+func TestColorCheckerboardParity(t *testing.T) {
+    var red [3]float32 = [3]float32{1.0, 0.0, 0.0}
+    var blue [3]float32 = [3]float32{0.0, 0.0, 1.0}
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    canvas.GenerateTriangleFaces()
+    canvas.ColorCheckerboard(red, blue)
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+            var index uint32 = canvas.gridIndex(xIndex, yIndex)
+            var want [3]float32 = red
+            var got [4]float32
+
+            if (xIndex + yIndex) % 2 == 1 {
+                want = blue
+            }
+
+            copy(got[:], canvas.Colors[4 * index : 4 * index + 4])
+
+            if got != [4]float32{want[0], want[1], want[2], 1.0} {
+                t.Errorf("vertex (%d, %d) has color %v, want %v",
+                         xIndex, yIndex, got, want)
+            }
+        }
+    }
+
+    /*  Three cells per row and two rows, two triangles per cell.             */
+    if len(canvas.FaceColors) != 6 * 6 {
+        t.Fatalf("%d face color entries, want 36", len(canvas.FaceColors))
+    }
+
+    for cell := 0; cell < 6; cell++ {
+        var want [3]float32 = red
+
+        if (cell % 3 + cell / 3) % 2 == 1 {
+            want = blue
+        }
+
+        for triangle := 0; triangle < 2; triangle++ {
+            var start int = 6 * cell + 3 * triangle
+            var got [3]float32
+
+            copy(got[:], canvas.FaceColors[start : start + 3])
+
+            if got != want {
+                t.Errorf("triangle %d of cell %d has color %v, want %v",
+                         triangle, cell, got, want)
+            }
+        }
+    }
+}
+/*  End of TestColorCheckerboardParity.                                       */
@@ -0,0 +1,49 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides colormaps for coloring meshes by a value.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Clamps a colormap input to [0, 1].                                        */
+func clampUnit(t float32) float32 {
+    if t < 0.0 {
+        return 0.0
+    } else if t > 1.0 {
+        return 1.0
+    }
+
+    return t
+}
+
+/*  Colormap from black at 0 to white at 1.                                   */
+func GrayColormap(t float32) [3]float32 {
+    t = clampUnit(t)
+    return [3]float32{t, t, t}
+}
+
+/*  Colormap around the color wheel from blue at 0, through cyan, green, and  *
+ *  yellow, to red at 1.                                                      */
+func HueColormap(t float32) [3]float32 {
+    return hueToRGB((2.0 / 3.0) * (1.0 - clampUnit(t)))
+}
+/*  End of colormaps.                                                         */
@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Finishes a frame of the animation in a single call.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for comparing errors that may be wrapped.        */
+import "errors"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CommitFrame                                                           *
+ *  Purpose:                                                                  *
+ *      Finalizes the mesh for the current frame, the one point per frame     *
+ *      where the front end reads a consistent state. Pending changes are     *
+ *      regenerated if the canvas is dirty, see Flush. The normals of a new   *
+ *      mesh are recomputed if they were computed before. If the canvas is    *
+ *      rotated from a base orientation, like with StepByTime or DragRotate,  *
+ *      a new mesh becomes the base and the accumulated angles are applied to *
+ *      it, so the figure keeps its orientation. Finally the buffers are      *
+ *      swapped, see SwapBuffers, and the front buffer has all of the         *
+ *      changes. A mesh with a few non-finite points is still committed. The  *
+ *      caller should hold CanvasLock.                                        *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose frame is being committed.                        *
+ *  Output:                                                                   *
+ *      regenerated (bool):                                                   *
+ *          True if the mesh was regenerated.                                 *
+ *      err (error):                                                          *
+ *          The error from Regenerate. The buffers are not swapped if it      *
+ *          failed.                                                           *
+ ******************************************************************************/
+func (self *Canvas) CommitFrame() (bool, error) {
+
+    /*  Whether the mesh is rotated from a base copy, checked before the      *
+     *  mesh is regenerated.                                                  */
+    var oriented bool = (self.MeshSize > 0) &&
+                        (len(self.BaseMesh) == self.MeshSize)
+
+    var regenerated bool = self.Dirty
+    var err error
+
+    if regenerated {
+        err = self.Regenerate()
+
+        if (err != nil) && !errors.Is(err, ErrNonFinite) {
+            return true, err
+        }
+
+        if len(self.Normals) >= self.MeshSize {
+            self.ComputeNormals()
+        }
+
+        /*  The new mesh becomes the base, keeping the angles.                */
+        if oriented {
+            self.ensureBaseOrientation()
+        }
+    }
+
+    if oriented {
+        self.applyBaseOrientation()
+    }
+
+    self.SwapBuffers()
+    return regenerated, err
+}
+/*  End of CommitFrame.                                                       */
@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for committing a frame with its pending changes.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A canvas turned by StepByTime has its surface replaced and is marked      *
+ *  dirty. After CommitFrame the canvas is clean and the front buffer holds   *
+ *  the new surface, turned by the angle the old one had reached. Another     *
+ *  commit without changes does not regenerate.                               */
+func TestCommitFrameFrontHasPendingChanges(t *testing.T) {
+    withAngularSpeed(t, 1.0)
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+    var reference *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+
+    canvas.StepByTime(0.5)
+    canvas.Surface = saddle
+    canvas.Dirty = true
+
+    regenerated, err := canvas.CommitFrame()
+
+    if !regenerated || (err != nil) {
+        t.Fatalf("CommitFrame = (%v, %v), want (true, nil)", regenerated, err)
+    }
+
+    if canvas.Dirty {
+        t.Errorf("canvas is still dirty after CommitFrame")
+    }
+
+    reference.RotateMesh(UnitVectorFromAngle(0.5))
+
+    if len(canvas.FrontMesh) != canvas.MeshSize {
+        t.Fatalf("front mesh has %d floats, want %d",
+                 len(canvas.FrontMesh), canvas.MeshSize)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var start int = 3 * index
+        var got [3]float32 = [3]float32{
+            canvas.FrontMesh[start],
+            canvas.FrontMesh[start + 1],
+            canvas.FrontMesh[start + 2],
+        }
+
+        expectVector(t, "front vertex", got, reference.point(index), 1.0E-5)
+    }
+
+    if regenerated, _ = canvas.CommitFrame(); regenerated {
+        t.Errorf("CommitFrame regenerated a clean canvas")
+    }
+}
+/*  End of TestCommitFrameFrontHasPendingChanges.                             */
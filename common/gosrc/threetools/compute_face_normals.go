@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes a normal vector for each triangle face of a mesh.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ComputeFaceNormals                                                    *
+ *  Purpose:                                                                  *
+ *      Computes one unit normal per triangle of the face buffer, used for    *
+ *      flat shading. The normal of the triangle (a, b, c) is the normalized  *
+ *      cross product (b - a) x (c - a), which points outwards for            *
+ *      counterclockwise triangles. The faces are generated first if the face *
+ *      buffer is empty.                                                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose face normals are being computed.                 *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ComputeFaceNormals() {
+
+    /*  Variable for indexing over the triangles.                             */
+    var index int
+
+    /*  The triangles are needed to compute their normals.                    */
+    if len(self.Faces) == 0 {
+        self.GenerateTriangleFaces()
+    }
+
+    /*  Each triangle is three indices and gets one normal of three floats.   */
+    var numberOfFaces int = len(self.Faces) / 3
+    self.FaceNormals = resizeBuffer(self.FaceNormals, 3 * numberOfFaces)
+
+    for index = 0; index < numberOfFaces; index++ {
+
+        /*  The three corners of the triangle.                                */
+        var a [3]float32 = self.point(int(self.Faces[3 * index]))
+        var b [3]float32 = self.point(int(self.Faces[3 * index + 1]))
+        var c [3]float32 = self.point(int(self.Faces[3 * index + 2]))
+
+        /*  The edges from the first corner span the triangle.                */
+        var normal [3]float32 = normalizeVector(
+            crossProduct(vectorDifference(b, a), vectorDifference(c, a)),
+        )
+
+        self.FaceNormals[3 * index] = normal[0]
+        self.FaceNormals[3 * index + 1] = normal[1]
+        self.FaceNormals[3 * index + 2] = normal[2]
+    }
+}
+/*  End of ComputeFaceNormals.                                                */
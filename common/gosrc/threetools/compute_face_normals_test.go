@@ -0,0 +1,51 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the face normals used for flat shading.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Every triangle of a flat plane gets the same upward normal.               */
+func TestComputeFaceNormalsPlane(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, Plane, WithGrid(5, 4))
+    canvas.ComputeFaceNormals()
+
+    /*  A 5x4 grid has 4 * 3 cells, each split into two triangles.            */
+    var numberOfFaces int = len(canvas.FaceNormals) / 3
+
+    if numberOfFaces != 24 {
+        t.Fatalf("%d face normals, want 24", numberOfFaces)
+    }
+
+    for index := 0; index < numberOfFaces; index++ {
+        var normal [3]float32 = [3]float32{
+            canvas.FaceNormals[3 * index],
+            canvas.FaceNormals[3 * index + 1],
+            canvas.FaceNormals[3 * index + 2],
+        }
+
+        expectVector(t, "face normal", normal, [3]float32{0.0, 0.0, 1.0}, 0.0)
+    }
+}
+/*  End of TestComputeFaceNormalsPlane.                                       */
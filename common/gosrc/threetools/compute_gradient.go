@@ -0,0 +1,64 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the gradient of the heights at each vertex of a graph.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ComputeGradient                                                       *
+ *  Purpose:                                                                  *
+ *      Stores the gradient (z_x, z_y) of a graph mesh at every vertex in the *
+ *      gradient buffer, two floats per vertex. The partial derivatives are   *
+ *      estimated with central differences in the interior of the grid and    *
+ *      one-sided differences on the boundary. The front-end can draw these   *
+ *      as arrows for a quiver plot. Nothing is done if the mesh is not a     *
+ *      full grid.                                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose gradient is being computed.                      *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ComputeGradient() {
+
+    /*  Variables for indexing over the grid.                                 */
+    var xIndex, yIndex int
+
+    if int(self.NxPts * self.NyPts) != self.NumberOfPoints {
+        return
+    }
+
+    self.Gradients = resizeBuffer(self.Gradients, 2 * self.NumberOfPoints)
+
+    for yIndex = 0; yIndex < int(self.NyPts); yIndex++ {
+        for xIndex = 0; xIndex < int(self.NxPts); xIndex++ {
+            var vertex uint32 = self.gridIndex(uint32(xIndex), uint32(yIndex))
+            var index uint32 = 2 * vertex
+            dzdx, dzdy := self.heightDerivatives(xIndex, yIndex)
+            self.Gradients[index] = dzdx
+            self.Gradients[index + 1] = dzdy
+        }
+    }
+}
+/*  End of ComputeGradient.                                                   */
@@ -0,0 +1,64 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the gradient of the heights.                                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The gradient of z = x^2 + y^2 is (2x, 2y). Central differences are exact  *
+ *  for a quadratic, so the interior agrees to rounding, while the one-sided  *
+ *  differences on the boundary are off by at most the grid spacing.          */
+func TestComputeGradientParaboloid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(9, 7), WithDomain(2.0, 3.0, -1.0, -1.0),
+    )
+
+    var spacing float32 = canvas.Height / float32(canvas.NyPts - 1)
+    canvas.ComputeGradient()
+
+    if len(canvas.Gradients) != 2 * canvas.NumberOfPoints {
+        t.Fatalf("len(Gradients) = %d, want %d",
+                 len(canvas.Gradients), 2 * canvas.NumberOfPoints)
+    }
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+            var index uint32 = canvas.gridIndex(xIndex, yIndex)
+            var p [3]float32 = canvas.point(int(index))
+            var got [3]float32 = [3]float32{
+                canvas.Gradients[2 * index], canvas.Gradients[2 * index + 1],
+            }
+            var want [3]float32 = [3]float32{2.0 * p[0], 2.0 * p[1]}
+            var tolerance float32 = 1.0E-5
+
+            if (xIndex == 0) || (xIndex + 1 == canvas.NxPts) ||
+               (yIndex == 0) || (yIndex + 1 == canvas.NyPts) {
+                tolerance = spacing + 1.0E-5
+            }
+
+            expectVector(t, "gradient", got, want, tolerance)
+        }
+    }
+}
+/*  End of TestComputeGradientParaboloid.                                     */
@@ -24,71 +24,115 @@
  ******************************************************************************/
 package threetools
 
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
 /******************************************************************************
  *  Function:                                                                 *
  *      ComputeIndexSize                                                      *
  *  Purpose:                                                                  *
- *      Computes the number of elements needed for the index buffer.          *
+ *      Computes the number of elements needed for the index buffer. The      *
+ *      arithmetic is done in 64 bits so that it can not wrap around, and the *
+ *      result is checked against the largest index storage a canvas may      *
+ *      have, six indices for each of MaxBufferLength points. If it does not  *
+ *      fit, IndexSize is set to zero and an error is returned. No segments   *
+ *      are counted across the seams of the cylinder, Mobius, torus, Klein,   *
+ *      and projective mesh types. Their last row or column of the grid lies  *
+ *      on the first, so the seam is drawn already, and it is closed by       *
+ *      welding the coincident vertices, see WeldSeams. Only WrapU and WrapV  *
+ *      add the segments that join the last column or row to the first.       *
  *  Arguments:                                                                *
  *      self (*Canvas):                                                       *
  *          The input canvas, the size of its index buffer is computed.       *
  *  Output:                                                                   *
- *      None.                                                                 *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge if the size exceeds the storage limit.            *
  ******************************************************************************/
-func (self *Canvas) ComputeIndexSize() {
-
-    /*  The total number of points in the mesh is the product of the width    *
-     *  and height. Points along the boundary usually have a different number *
-     *  of line segments associated to them then those in the interior. The   *
-     *  number of points along the boundary is proportional to the sum of the *
-     *  width and height, compute both the sum and the product.               */
-    var product uint32 = self.NxPts * self.NyPts
-    var sum uint32 = self.NxPts + self.NyPts
-
-    /*  The number of line segments is given by the type of mesh being used.  */
-    switch self.MeshType {
-
-        /*  Square wireframe, internal points have two line segments tied to  *
-         *  them, the top and right boundary points have only one.            */
-        case SquareWireframe:
-            self.IndexSize = int(2 * (2 * product - sum))
-
-        /*  Triangle wireframe, internal points have three line segments tied *
-         *  to them, the top and right boundary points have only one.         */
-        case TriangleWireframe:
-            self.IndexSize = int(2 * (3 * product - 2 * sum))
-
-        /*  Similar to the square wireframe, but we add a line segment from   *
-         *  the right edge to the left edge.                                  */
-        case CylindricalSquareWireframe:
-            self.IndexSize = int(2 * (2 * product - self.NxPts))
-
-        /*  Similar to the triangle wireframe, but we add edges and diagonals *
-         *  from the right edge to the left one.                              */
-        case CylindricalTriangleWireframe:
-            self.IndexSize = int(2 * (3 * product - 2 * self.NxPts))
-
-        /*  Similar to the square wireframe, but the bottom edge is connected *
-         *  to the top edge, and the left edge to the right edge.             */
-        case TorodialSquareWireframe:
-            fallthrough
-        case KleinSquareWireframe:
-            fallthrough
-        case ProjectiveSquareWireframe:
-            self.IndexSize = int(4 * product)
-
-        /*  Similar to triangle wireframes, but the bottom edge is connected  *
-         *  to the top edge, and the left edge to the right edge.             */
-        case TorodialTriangleWireframe:
-            fallthrough
-        case KleinTriangleWireframe:
-            fallthrough
-        case ProjectiveTriangleWireframe:
-            self.IndexSize = int(6 * product)
-
-        /*  Illegal input, set the size to zero.                              */
-        default:
-            self.IndexSize = 0
+func (self *Canvas) ComputeIndexSize() error {
+
+    /*  The largest index storage allowed, see ReserveStorage.                */
+    var limit uint64 = 6 * uint64(MaxBufferLength)
+    var size uint64 = self.indexCount()
+
+    if size > limit {
+        self.IndexSize = 0
+
+        return fmt.Errorf(
+            "%w: %dx%d grid needs %d indices, at most %d allowed",
+            ErrGridTooLarge, self.NxPts, self.NyPts, size, limit,
+        )
     }
+
+    self.IndexSize = int(size)
+    return nil
 }
 /*  End of ComputeIndexSize.                                                  */
+
+/*  Returns the number of indices the wireframe of the canvas needs, see      *
+ *  ComputeIndexSize, computed in 64 bits and without checking that it fits.  */
+func (self *Canvas) indexCount() uint64 {
+
+    /*  The number of cells along each axis, counting those across a closed   *
+     *  seam, see gridCells, and the lines the wireframe direction draws.     */
+    cellsX, cellsY := self.gridCells()
+    drawRows, drawColumns, drawDiagonals := self.wireframeLines()
+
+    /*  Only every stride-th row and column of the grid is drawn.             */
+    var stride uint32 = self.wireframeStride()
+
+    /*  The drawn rows and columns, and the number of diagonal lines.         */
+    var rows, columns, diagonals uint64 = 0, 0, 0
+
+    /*  The number of indices for the mesh type.                              */
+    var size uint64 = 0
+
+    /*  Illegal mesh types have no wireframe.                                 */
+    if !isValidMeshType(self.MeshType) {
+        return 0
+    }
+
+    /*  A row is drawn if it falls on the stride and has a segment in it.     */
+    if drawRows && (cellsX > 0) {
+        rows = uint64(countWireframeLines(self.NyPts, stride))
+    }
+
+    if drawColumns && (cellsY > 0) {
+        columns = uint64(countWireframeLines(self.NxPts, stride))
+    }
+
+    /*  There is one diagonal segment per cell of the grid. As strips, the    *
+     *  diagonals start along the bottom row and the left column.             */
+    if drawDiagonals && (cellsX > 0) && (cellsY > 0) {
+        diagonals = uint64(cellsX) + uint64(cellsY) - 1
+    }
+
+    /*  Disjoint segments take two indices each. Each row has cellsX segments *
+     *  and each column has cellsY, the seams of the mesh types other than    *
+     *  the plain grids are joined by welding, see WeldSeams, not by extra    *
+     *  segments, so these follow the same count.                             */
+    if !self.LineStrips {
+        size = uint64(cellsX) * rows + uint64(cellsY) * columns
+
+        if diagonals > 0 {
+            size += uint64(cellsX) * uint64(cellsY)
+        }
+
+        return 2 * size
+    }
+
+    /*  A strip lists the vertices of its line, one more than its segments,   *
+     *  and consecutive strips are separated by StripRestartIndex, see        *
+     *  GenerateWireframeStrips.                                              */
+    size = (uint64(cellsX) + 1) * rows + (uint64(cellsY) + 1) * columns
+
+    if diagonals > 0 {
+        size += uint64(cellsX) * uint64(cellsY) + diagonals
+    }
+
+    if rows + columns + diagonals > 0 {
+        size += rows + columns + diagonals - 1
+    }
+
+    return size
+}
+/*  End of indexCount.                                                        */
@@ -0,0 +1,136 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the size of the index buffer at the largest grids.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  At the largest grid the sizes are exact and fit in the index storage. The *
+ *  open square grid has 2 x 511 x 512 segments, and the wrapped triangular   *
+ *  grid, the largest wireframe, fills MaxIndexBufferSize exactly.            */
+func TestComputeIndexSizeAtMaximum(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshType uint
+        wrap bool
+        want uint64
+    }{
+        {"square", SquareWireframe, false, 2 * 2 * 511 * 512},
+        {"wrapped triangle", TriangleWireframe, true,
+         uint64(MaxIndexBufferSize)},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(MaxWidth, MaxHeight), WithMeshType(c.meshType),
+            WithWrap(c.wrap, c.wrap),
+        )
+
+        if err := canvas.ComputeIndexSize(); err != nil {
+            t.Fatalf("%s: ComputeIndexSize: %v", c.name, err)
+        }
+
+        if uint64(canvas.IndexSize) != c.want {
+            t.Errorf("%s: IndexSize %d, want %d",
+                     c.name, canvas.IndexSize, c.want)
+        }
+    }
+
+    for meshType := uint(0); isValidMeshType(meshType); meshType++ {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(MaxWidth, MaxHeight), WithMeshType(meshType),
+        )
+
+        var err error = canvas.ComputeIndexSize()
+
+        if (err != nil) || (canvas.IndexSize > int(MaxIndexBufferSize)) {
+            t.Errorf("mesh type %d: IndexSize %d, error %v",
+                     meshType, canvas.IndexSize, err)
+        }
+    }
+}
+/*  End of TestComputeIndexSizeAtMaximum.                                     */
+
+/*  A grid whose index count does not fit in 32 bits is rejected rather than  *
+ *  wrapping around to a small size.                                          */
+func TestComputeIndexSizeOverflow(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(4, 4))
+    canvas.NxPts, canvas.NyPts = 1 << 20, 1 << 20
+
+    if err := canvas.ComputeIndexSize(); !errors.Is(err, ErrGridTooLarge) {
+        t.Errorf("got %v, want ErrGridTooLarge", err)
+    }
+
+    if canvas.IndexSize != 0 {
+        t.Errorf("IndexSize %d, want 0", canvas.IndexSize)
+    }
+}
+/*  End of TestComputeIndexSizeOverflow.                                      */
+
+/*  The torus wireframe has no segments across its seams, but once the seams  *
+ *  are welded every vertex is joined to four others, two along its row and   *
+ *  two along its column, so no edge of the grid is left open. The first and  *
+ *  last rows and columns both draw the seams, so these segments are listed   *
+ *  twice, only the distinct neighbors are counted.                           */
+func TestComputeIndexSizeWeldedTorusIsClosed(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(7, 5), WithMeshType(TorodialSquareWireframe),
+        WithDomain(1.0, 1.0, 0.0, 0.0),
+    )
+
+    canvas.GenerateMeshFromParametric(torus)
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    if removed := canvas.WeldSeams(); removed != 7 + 5 - 1 {
+        t.Fatalf("welded away %d vertices, want %d", removed, 7 + 5 - 1)
+    }
+
+    var neighbors []map[uint32]bool = make([]map[uint32]bool,
+                                           canvas.NumberOfPoints)
+
+    for vertex := range neighbors {
+        neighbors[vertex] = make(map[uint32]bool)
+    }
+
+    for index := 0; index < canvas.IndexSize; index += 2 {
+        var start, end uint32 = canvas.Indices[index], canvas.Indices[index + 1]
+        neighbors[start][end] = true
+        neighbors[end][start] = true
+    }
+
+    for vertex, joined := range neighbors {
+        if len(joined) != 4 {
+            t.Errorf("vertex %d is joined to %d others, want 4",
+                     vertex, len(joined))
+        }
+    }
+}
+/*  End of TestComputeIndexSizeWeldedTorusIsClosed.                           */
@@ -0,0 +1,89 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the vertex normals of a mesh using finite differences.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ComputeNormals                                                        *
+ *  Purpose:                                                                  *
+ *      Computes a unit normal vector for each vertex in the grid. The        *
+ *      tangent vectors along the horizontal and vertical axes of the grid    *
+ *      are estimated with central differences (one-sided on the boundary),   *
+ *      and the normal is their normalized cross product. For a graph z =     *
+ *      f(x, y) this is the upward normal (-f_x, -f_y, 1), normalized. A      *
+ *      domain with a negative width or height is traversed in reverse, the   *
+ *      normal is flipped to account for this. Since the grid is used, and    *
+ *      not the function, this also works after the mesh has been rotated or  *
+ *      for parametric surfaces, and the closed seams of the wrapped mesh     *
+ *      types are crossed, see NeighborIndex. Nothing is done if the mesh is  *
+ *      not a full grid.                                                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose normals are being computed.                      *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ComputeNormals() {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex int
+
+    /*  The grid dimensions, as integers for the neighbor computations.       */
+    var width int = int(self.NxPts)
+    var height int = int(self.NyPts)
+
+    if width * height != self.NumberOfPoints {
+        return
+    }
+
+    /*  Reversed domains flip the cross product, undo this.                   */
+    var orientation float32 = self.domainOrientation()
+
+    /*  Each vertex has a normal with three components.                       */
+    self.Normals = resizeBuffer(self.Normals, self.MeshSize)
+
+    /*  Loop over the grid, the neighbors follow the layout, see gridIndex.   */
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+
+            /*  Differences of the neighboring points give the tangents. The  *
+             *  scale factors do not matter since we normalize at the end.    */
+            uTangent, vTangent := self.gridTangents(xIndex, yIndex)
+
+            /*  The normal is perpendicular to both tangents.                 */
+            var normal [3]float32 = normalizeVector(
+                scaleVector(crossProduct(uTangent, vTangent), orientation),
+            )
+
+            /*  Store the result in the same location as the vertex.          */
+            var vertex uint32 = self.gridIndex(uint32(xIndex), uint32(yIndex))
+            var index int = 3 * int(vertex)
+            self.Normals[index] = normal[0]
+            self.Normals[index + 1] = normal[1]
+            self.Normals[index + 2] = normal[2]
+        }
+    }
+}
+/*  End of ComputeNormals.                                                    */
@@ -0,0 +1,147 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the vertex normals of a mesh.                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the torus.                   */
+import (
+    "math"
+    "testing"
+)
+
+/*  The normals of a graph point upwards, for either layout of the grid.      */
+func TestComputeNormalsLayouts(t *testing.T) {
+    for _, columnMajor := range []bool{false, true} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(7, 5), WithColumnMajor(columnMajor),
+        )
+
+        canvas.ComputeNormals()
+
+        for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+            for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+                var index uint32 = 3 * canvas.gridIndex(xIndex, yIndex)
+                var p [3]float32 = canvas.point(int(index / 3))
+                var want [3]float32 = normalizeVector(
+                    [3]float32{-2.0 * p[0], -2.0 * p[1], 1.0},
+                )
+                var got [3]float32 = [3]float32{
+                    canvas.Normals[index],
+                    canvas.Normals[index + 1],
+                    canvas.Normals[index + 2],
+                }
+
+                /*  One-sided differences on the boundary are less accurate.  */
+                if (xIndex == 0) || (xIndex + 1 == canvas.NxPts) ||
+                   (yIndex == 0) || (yIndex + 1 == canvas.NyPts) {
+                    if got[2] <= 0.0 {
+                        t.Errorf("column major %v: normal %v points down",
+                                 columnMajor, got)
+                    }
+
+                    continue
+                }
+
+                expectVector(t, "normal", got, want, 1.0E-5)
+            }
+        }
+    }
+}
+/*  End of TestComputeNormalsLayouts.                                         */
+
+/*  A torus has no boundary, the normals at the seam use the points across it *
+ *  and agree with the normals away from the seam.                            */
+func TestComputeNormalsAcrossSeam(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(32, 16), WithDomain(1.0, 1.0, 0.0, 0.0),
+        WithWrap(true, true),
+    )
+
+    canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+        sinV, cosV := math.Sincos(2.0 * math.Pi * float64(v))
+        var r float64 = 2.0 + cosV
+        return [3]float32{
+            float32(r * cosU), float32(r * sinU), float32(sinV),
+        }
+    })
+
+    canvas.ComputeNormals()
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var center [3]float32 = scaleVector(
+            normalizeVector([3]float32{p[0], p[1], 0.0}), 2.0,
+        )
+        var want [3]float32 = normalizeVector(vectorDifference(p, center))
+        var got [3]float32 = [3]float32{
+            canvas.Normals[3 * index],
+            canvas.Normals[3 * index + 1],
+            canvas.Normals[3 * index + 2],
+        }
+
+        if dotProduct(got, want) < 0.99 {
+            t.Fatalf("normal %v at vertex %d, want about %v", got, index, want)
+        }
+    }
+}
+/*  End of TestComputeNormalsAcrossSeam.                                      */
+
+/*  A negative width or height sweeps the domain backwards, the normals of    *
+ *  the paraboloid and of its triangles still point up.                       */
+func TestComputeNormalsReversedDomain(t *testing.T) {
+    var domains = [][4]float32{
+        {-2.0, 2.0, 1.0, -1.0},
+        {2.0, -2.0, -1.0, 1.0},
+        {-2.0, -2.0, 1.0, 1.0},
+    }
+
+    for _, d := range domains {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(6, 5), WithDomain(d[0], d[1], d[2], d[3]),
+        )
+
+        canvas.ComputeNormals()
+        canvas.GenerateTriangleFaces()
+        canvas.ComputeFaceNormals()
+
+        if len(canvas.FaceNormals) != len(canvas.Faces) {
+            t.Fatalf("domain %v: %d face normal entries for %d face indices",
+                     d, len(canvas.FaceNormals), len(canvas.Faces))
+        }
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            if canvas.Normals[3 * index + 2] <= 0.0 {
+                t.Errorf("domain %v: normal at vertex %d points down", d, index)
+            }
+        }
+
+        for face := 0; 3 * face + 2 < len(canvas.FaceNormals); face++ {
+            if canvas.FaceNormals[3 * face + 2] <= 0.0 {
+                t.Errorf("domain %v: face %d points down", d, face)
+            }
+        }
+    }
+}
+/*  End of TestComputeNormalsReversedDomain.                                  */
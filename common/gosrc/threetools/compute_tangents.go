@@ -0,0 +1,100 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes tangent vectors for normal mapping.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ComputeTangents                                                       *
+ *  Purpose:                                                                  *
+ *      Computes a unit tangent for each vertex, pointing in the direction of *
+ *      increasing u of the texture coordinates, which is along the           *
+ *      horizontal axis of the grid. The grid tangent is made perpendicular   *
+ *      to the normal with a Gram-Schmidt step. Four floats are stored per    *
+ *      vertex, the tangent and a sign for the handedness, as glTF expects.   *
+ *      The bitangent is the sign times the cross product of the normal and   *
+ *      the tangent. The normals are computed first if they are not           *
+ *      populated. Nothing is done if the mesh is not a full grid.            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose tangents are being computed.                     *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ComputeTangents() {
+
+    /*  Variables for indexing over the grid and the vertices.                */
+    var xIndex, yIndex int
+
+    /*  The grid dimensions, as integers for the index computations.          */
+    var width int = int(self.NxPts)
+    var height int = int(self.NyPts)
+
+    if width * height != self.NumberOfPoints {
+        return
+    }
+
+    if len(self.Normals) < self.MeshSize {
+        self.ComputeNormals()
+    }
+
+    self.Tangents = resizeBuffer(self.Tangents, 4 * self.NumberOfPoints)
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var vertex uint32 = self.gridIndex(uint32(xIndex), uint32(yIndex))
+            var index int = int(vertex)
+            var normal [3]float32 = [3]float32{
+                self.Normals[3 * index],
+                self.Normals[3 * index + 1],
+                self.Normals[3 * index + 2],
+            }
+
+            uTangent, vTangent := self.gridTangents(xIndex, yIndex)
+
+            /*  Remove the normal component, t - (t . n) n.                   */
+            var along float32 = dotProduct(uTangent, normal)
+            var tangent [3]float32 = normalizeVector(
+                [3]float32{
+                    uTangent[0] - along * normal[0],
+                    uTangent[1] - along * normal[1],
+                    uTangent[2] - along * normal[2],
+                },
+            )
+
+            /*  The handedness compares n x t with the v direction.           */
+            var sign float32 = 1.0
+
+            if dotProduct(crossProduct(normal, tangent), vTangent) < 0.0 {
+                sign = -1.0
+            }
+
+            self.Tangents[4 * index] = tangent[0]
+            self.Tangents[4 * index + 1] = tangent[1]
+            self.Tangents[4 * index + 2] = tangent[2]
+            self.Tangents[4 * index + 3] = sign
+        }
+    }
+}
+/*  End of ComputeTangents.                                                   */
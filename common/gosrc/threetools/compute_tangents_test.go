@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the tangent vectors used for normal mapping.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On the saddle every tangent is a unit vector perpendicular to the normal  *
+ *  at its vertex, pointing along increasing x, with a handedness of +-1.     */
+func TestComputeTangentsUnitAndPerpendicular(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(7, 6))
+    canvas.ComputeTangents()
+
+    if len(canvas.Tangents) != 4 * canvas.NumberOfPoints {
+        t.Fatalf("len(Tangents) = %d, want %d",
+                 len(canvas.Tangents), 4 * canvas.NumberOfPoints)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var tangent [3]float32 = [3]float32{
+            canvas.Tangents[4 * index],
+            canvas.Tangents[4 * index + 1],
+            canvas.Tangents[4 * index + 2],
+        }
+        var normal [3]float32 = [3]float32{
+            canvas.Normals[3 * index],
+            canvas.Normals[3 * index + 1],
+            canvas.Normals[3 * index + 2],
+        }
+        var sign float32 = canvas.Tangents[4 * index + 3]
+
+        if !closeTo(vectorNorm(tangent), 1.0, 1.0E-5) {
+            t.Errorf("tangent %v at vertex %d is not a unit vector",
+                     tangent, index)
+        }
+
+        if !closeTo(dotProduct(tangent, normal), 0.0, 1.0E-5) {
+            t.Errorf("tangent %v and normal %v at vertex %d are not " +
+                     "perpendicular", tangent, normal, index)
+        }
+
+        if (tangent[0] <= 0.0) || ((sign != 1.0) && (sign != -1.0)) {
+            t.Errorf("tangent %v with sign %g at vertex %d",
+                     tangent, sign, index)
+        }
+    }
+}
+/*  End of TestComputeTangentsUnitAndPerpendicular.                           */
@@ -0,0 +1,79 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Applies a configuration to a canvas.                                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Configure                                                             *
+ *  Purpose:                                                                  *
+ *      Copies the geometry of a configuration into a canvas and resets the   *
+ *      mesh and index buffers to the new sizes, using the storage of the     *
+ *      canvas. The mesh itself is not generated. If the configuration is     *
+ *      invalid the canvas is left unchanged.                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being configured.                                      *
+ *      config (CanvasConfig):                                                *
+ *          The geometry, see NewCanvasConfig.                                *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge or ErrInvalidMeshType if the canvas is unchanged. *
+ ******************************************************************************/
+func (self *Canvas) Configure(config CanvasConfig) error {
+
+    /*  Avoid slicing beyond the bounds of the storage.                       */
+    err := checkGrid(config.NxPts, config.NyPts, config.MeshType)
+
+    if err != nil {
+        return err
+    }
+
+    /*  Grow the storage before anything is changed.                          */
+    err = self.reserveGrid(config.NxPts, config.NyPts)
+
+    if err != nil {
+        return err
+    }
+
+    self.NxPts = config.NxPts
+    self.NyPts = config.NyPts
+    self.Width = config.Width
+    self.Height = config.Height
+    self.HorizontalStart = config.HorizontalStart
+    self.VerticalStart = config.VerticalStart
+    self.MeshType = config.MeshType
+    self.WireframeStride = config.WireframeStride
+    self.WireframeDirection = config.WireframeDirection
+    self.WrapU = config.WrapU
+    self.WrapV = config.WrapV
+    self.LineStrips = config.LineStrips
+    self.LayoutColumnMajor = config.LayoutColumnMajor
+    self.FlipU = config.FlipU
+    self.FlipV = config.FlipV
+
+    /*  The canvas variables are set, we can compute the rest from this.      */
+    return self.Recompute(self.MeshStorage, self.IndexStorage)
+}
+/*  End of Configure.                                                         */
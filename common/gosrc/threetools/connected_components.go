@@ -0,0 +1,82 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Counts the connected pieces of a wireframe.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ConnectedComponents                                                   *
+ *  Purpose:                                                                  *
+ *      Counts the connected components of the graph whose vertices are the   *
+ *      active vertices of the mesh and whose edges are the segments of the   *
+ *      index buffer. A generated surface like a torus or a sphere is a       *
+ *      single piece, so a count larger than one points to a seam or          *
+ *      generator that split the wireframe. Vertices are joined only by       *
+ *      segments, not by position, and a vertex on no segment is a component  *
+ *      by itself. Segments with an index past the active vertices are        *
+ *      ignored.                                                              *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the wireframe being examined.                     *
+ *  Output:                                                                   *
+ *      components (int):                                                     *
+ *          The number of connected components.                               *
+ ******************************************************************************/
+func ConnectedComponents(canvas *Canvas) int {
+
+    /*  Variable for indexing over the vertices and segments.                 */
+    var index int
+
+    /*  Each vertex starts out in a component of its own.                     */
+    var components int = canvas.NumberOfPoints
+    var parent []int = make([]int, canvas.NumberOfPoints)
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        parent[index] = index
+    }
+
+    /*  The segments of the wireframe, with the line strips split up.         */
+    var pairs []uint32 = canvas.segmentPairs()
+
+    /*  Every segment joining two components merges them into one.            */
+    for index = 0; index + 1 < len(pairs); index += 2 {
+        var a int = int(pairs[index])
+        var b int = int(pairs[index + 1])
+
+        if (a >= canvas.NumberOfPoints) || (b >= canvas.NumberOfPoints) {
+            continue
+        }
+
+        var first int = findRoot(parent, a)
+        var second int = findRoot(parent, b)
+
+        if first != second {
+            parent[second] = first
+            components--
+        }
+    }
+
+    return components
+}
+/*  End of ConnectedComponents.                                               */
@@ -0,0 +1,66 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for counting the connected pieces of a wireframe.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  strings wraps the OBJ text in a reader.                                   */
+import (
+    "strings"
+    "testing"
+)
+
+/*  Two squares, each outlined by a closed polyline, that share no segment.   */
+const twoPatchOBJ string = `v 0 0 0
+v 1 0 0
+v 1 1 0
+v 0 1 0
+v 3 0 0
+v 4 0 0
+v 4 1 0
+v 3 1 0
+l 1 2 3 4 1
+l 5 6 7 8 5
+`
+
+/*  A generated wireframe is a single piece, and a mesh made of two patches   *
+ *  that share no segment has two components.                                 */
+func TestConnectedComponentsTwoPatches(t *testing.T) {
+    var grid *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+
+    if components := ConnectedComponents(grid); components != 1 {
+        t.Errorf("the wireframe of a grid has %d components, want 1",
+                 components)
+    }
+
+    var canvas *Canvas = newTestCanvas(t)
+
+    if err := ImportOBJ(strings.NewReader(twoPatchOBJ), canvas); err != nil {
+        t.Fatalf("ImportOBJ: %v", err)
+    }
+
+    if components := ConnectedComponents(canvas); components != 2 {
+        t.Errorf("two patches have %d components, want 2", components)
+    }
+}
+/*  End of TestConnectedComponentsTwoPatches.                                 */
@@ -0,0 +1,132 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Finds the extrema and saddle points of a surface.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  The six neighbors a vertex shares a triangle with, see                    *
+ *  GenerateTriangleFaces, in counterclockwise order as the number of columns *
+ *  and rows to move. Going around the ring the surface rises and falls, and  *
+ *  the number of changes classifies the vertex.                              */
+var criticalPointRing [6][2]int = [6][2]int{
+    {1, 0}, {1, 1}, {0, 1}, {-1, 0}, {-1, -1}, {0, -1},
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CriticalPoints                                                        *
+ *  Purpose:                                                                  *
+ *      Finds the local minima, local maxima, and saddle points of a graph by *
+ *      comparing the height of each vertex with its six neighbors in the     *
+ *      triangulated grid, found with NeighborIndex. A vertex lower than all  *
+ *      of them is a minimum and one higher than all of them a maximum.       *
+ *      Otherwise the neighbors are walked around in order, and if they       *
+ *      switch between above and below the vertex four or more times it is a  *
+ *      saddle, six times for a monkey saddle. Equal heights are ordered by   *
+ *      the index of the vertex, so the vertices of a flat plateau are        *
+ *      neither extrema nor saddles. Vertices on the edge of the grid, with   *
+ *      neighbors missing, are skipped, closed seams are crossed.             *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the graph.                                        *
+ *  Output:                                                                   *
+ *      points ([]CriticalPoint):                                             *
+ *          The critical points, row by row.                                  *
+ ******************************************************************************/
+func CriticalPoints(canvas *Canvas) []CriticalPoint {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex int
+
+    /*  Variable for indexing over the neighbors.                             */
+    var step int
+
+    var points []CriticalPoint = []CriticalPoint{}
+
+    if int(canvas.NxPts) * int(canvas.NyPts) != canvas.NumberOfPoints {
+        return points
+    }
+
+    for yIndex = 0; yIndex < int(canvas.NyPts); yIndex++ {
+        for xIndex = 0; xIndex < int(canvas.NxPts); xIndex++ {
+            var center int = int(canvas.gridIndex(uint32(xIndex),
+                                                  uint32(yIndex)))
+            var height float32 = canvas.Mesh[3 * center + 2]
+
+            /*  Whether each neighbor is above the vertex.                    */
+            var above [6]bool
+            var interior bool = true
+            var aboveCount, changes int = 0, 0
+
+            for step = 0; step < 6; step++ {
+                neighbor, inBounds := canvas.NeighborIndex(
+                    xIndex, yIndex,
+                    criticalPointRing[step][0], criticalPointRing[step][1],
+                )
+
+                if !inBounds {
+                    interior = false
+                    break
+                }
+
+                var z float32 = canvas.Mesh[3 * neighbor + 2]
+                above[step] = (z > height) ||
+                              ((z == height) && (neighbor > center))
+
+                if above[step] {
+                    aboveCount++
+                }
+            }
+
+            if !interior {
+                continue
+            }
+
+            for step = 0; step < 6; step++ {
+                if above[step] != above[(step + 1) % 6] {
+                    changes++
+                }
+            }
+
+            var point CriticalPoint = CriticalPoint{
+                XIndex: uint32(xIndex),
+                YIndex: uint32(yIndex),
+            }
+
+            if aboveCount == 6 {
+                point.Kind = LocalMinimum
+            } else if aboveCount == 0 {
+                point.Kind = LocalMaximum
+            } else if changes >= 4 {
+                point.Kind = SaddlePoint
+            } else {
+                continue
+            }
+
+            points = append(points, point)
+        }
+    }
+
+    return points
+}
+/*  End of CriticalPoints.                                                    */
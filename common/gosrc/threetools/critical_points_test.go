@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for finding the extrema and saddles of a graph.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The monkey saddle z = x^3 - 3 x y^2, with three valleys and three ridges. */
+func monkeySaddle(x, y float32) float32 {
+    return x*x*x - 3.0*x*y*y
+}
+/*  End of monkeySaddle.                                                      */
+
+/*  Checks that the only critical point of the graph of f on a 7x7 grid over  *
+ *  [-1, 1] x [-1, 1] is the vertex at the origin, of the given kind.         */
+func expectOnlyCriticalPoint(t *testing.T, name string,
+                             f SurfaceParametrization, kind uint) {
+    t.Helper()
+
+    var canvas *Canvas = newGraphCanvas(t, f, WithGrid(7, 7))
+    var want CriticalPoint = CriticalPoint{XIndex: 3, YIndex: 3, Kind: kind}
+    var points []CriticalPoint = CriticalPoints(canvas)
+
+    if (len(points) != 1) || (points[0] != want) {
+        t.Errorf("%s: critical points %+v, want only %+v", name, points, want)
+    }
+}
+/*  End of expectOnlyCriticalPoint.                                           */
+
+/*  The paraboloid has a minimum at the origin, the saddle and the monkey     *
+ *  saddle have saddle points there, and no other vertex is critical.         */
+func TestCriticalPointsAtOrigin(t *testing.T) {
+    expectOnlyCriticalPoint(t, "paraboloid", paraboloid, LocalMinimum)
+    expectOnlyCriticalPoint(t, "saddle", saddle, SaddlePoint)
+    expectOnlyCriticalPoint(t, "monkey saddle", monkeySaddle, SaddlePoint)
+}
+/*  End of TestCriticalPointsAtOrigin.                                        */
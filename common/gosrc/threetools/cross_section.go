@@ -0,0 +1,103 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Slices the surface with an arbitrary plane.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CrossSection                                                          *
+ *  Purpose:                                                                  *
+ *      Intersects the triangles of the face buffer with the plane ax + by +  *
+ *      cz + d = 0 and returns the resulting polyline as line segments. Each  *
+ *      triangle crossing the plane contributes one segment, joining the      *
+ *      points where two of its edges cross, found by linear interpolation of *
+ *      the signed distances of the corners. Corners on the plane are counted *
+ *      as lying on its positive side, so an edge lying in the plane is       *
+ *      output once, by the triangle on the negative side, and a triangle     *
+ *      touching the plane at a corner adds nothing. The face buffer must     *
+ *      have been generated, see GenerateTriangleFaces, otherwise the result  *
+ *      is empty.                                                             *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh and faces being sliced.                  *
+ *      plane ([4]float32):                                                   *
+ *          The coefficients (a, b, c, d) of the plane.                       *
+ *  Output:                                                                   *
+ *      segments ([]float32):                                                 *
+ *          Six floats per segment, the two endpoints, in the order of the    *
+ *          faces. Empty if the plane misses the mesh.                        *
+ ******************************************************************************/
+func CrossSection(canvas *Canvas, plane [4]float32) []float32 {
+
+    /*  Variable for indexing over the faces.                                 */
+    var index int
+
+    /*  The normal of the plane, the signed distance uses it and the offset.  */
+    var normal [3]float32 = [3]float32{plane[0], plane[1], plane[2]}
+
+    /*  The output, built up one segment at a time.                           */
+    var segments []float32
+
+    for index = 0; index + 2 < len(canvas.Faces); index += 3 {
+        var corners [3][3]float32
+        var distances [3]float32
+
+        /*  The points where the edges of the triangle cross the plane.       */
+        var crossings [][3]float32
+
+        for corner := 0; corner < 3; corner++ {
+            corners[corner] = canvas.point(int(canvas.Faces[index + corner]))
+            distances[corner] = dotProduct(normal, corners[corner]) + plane[3]
+        }
+
+        for corner := 0; corner < 3; corner++ {
+            var next int = (corner + 1) % 3
+            var first float32 = distances[corner]
+            var second float32 = distances[next]
+
+            /*  The edge crosses if its ends are on opposite sides.           */
+            if (first >= 0.0) == (second >= 0.0) {
+                continue
+            }
+
+            var t float32 = first / (first - second)
+            var edge [3]float32 =
+                vectorDifference(corners[next], corners[corner])
+
+            crossings = append(crossings,
+                               vectorSum(corners[corner], scaleVector(edge, t)))
+        }
+
+        /*  A plane crossing a triangle crosses exactly two of its edges.     */
+        if len(crossings) != 2 {
+            continue
+        }
+
+        segments = append(segments, crossings[0][:]...)
+        segments = append(segments, crossings[1][:]...)
+    }
+
+    return segments
+}
+/*  End of CrossSection.                                                      */
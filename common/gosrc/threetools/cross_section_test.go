@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for slicing the faces of a mesh with a plane.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The plane y = 0 cuts the paraboloid in the parabola z = x^2. The grid has *
+ *  no row at y = 0, so every cell along the middle is crossed, each of its   *
+ *  two triangles giving one segment. The endpoints lie on the plane, close   *
+ *  to the parabola up to the error of the linear interpolation, and the      *
+ *  polyline runs across the whole domain.                                    */
+func TestCrossSectionParabola(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 6))
+    canvas.GenerateTriangleFaces()
+
+    var segments []float32 = CrossSection(canvas, [4]float32{0, 1, 0, 0})
+    var want int = 2 * int(canvas.NxPts - 1)
+
+    if len(segments) != 6 * want {
+        t.Fatalf("%d floats, want %d segments", len(segments), want)
+    }
+
+    /*  The interval of x the polyline covers.                                */
+    var xMin, xMax float32 = 1.0, -1.0
+
+    for index := 0; index < len(segments); index += 3 {
+        var x, y, z float32 = segments[index], segments[index + 1],
+                              segments[index + 2]
+
+        if !closeTo(y, 0.0, 1.0E-6) {
+            t.Errorf("point (%g, %g, %g) is off the plane y = 0", x, y, z)
+        }
+
+        /*  The chords of a convex surface lie above it, by at most about     *
+         *  the squares of the half steps, 0.125^2 + 0.2^2.                   */
+        if (z < x*x - 1.0E-6) || (z > x*x + 0.06) {
+            t.Errorf("point (%g, %g, %g) is not on z = x^2", x, y, z)
+        }
+
+        if x < xMin {
+            xMin = x
+        }
+
+        if x > xMax {
+            xMax = x
+        }
+    }
+
+    if !closeTo(xMin, -1.0, 1.0E-6) || !closeTo(xMax, 1.0, 1.0E-6) {
+        t.Errorf("the polyline covers [%g, %g], want [-1, 1]", xMin, xMax)
+    }
+}
+/*  End of TestCrossSectionParabola.                                          */
@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a damped radial wave, a time dependent surface.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp, Cos, and Hypot are provided here.                                    */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DampedWave                                                            *
+ *  Purpose:                                                                  *
+ *      Returns the surface z = exp(-gamma r - beta t) cos(omega t - k r),    *
+ *      where r is the distance from the z axis. This is a radial wave whose  *
+ *      crests move outwards and shrink away from the origin, like the        *
+ *      ripples of a splash. The factor exp(-beta t) makes the wave die out,  *
+ *      so the surface settles towards the plane z = 0 as t increases. With   *
+ *      beta zero the wave does not decay in time.                            *
+ *  Arguments:                                                                *
+ *      gamma (float32):                                                      *
+ *          The rate of decay away from the origin.                           *
+ *      beta (float32):                                                       *
+ *          The rate of decay in time.                                        *
+ *      omega (float32):                                                      *
+ *          The angular frequency of the wave.                                *
+ *      k (float32):                                                          *
+ *          The wavenumber of the wave.                                       *
+ *  Output:                                                                   *
+ *      f (TimeSurface):                                                      *
+ *          The surface.                                                      *
+ ******************************************************************************/
+func DampedWave(gamma, beta, omega, k float32) TimeSurface {
+    return func(x, y, t float32) float32 {
+        var r float64 = math.Hypot(float64(x), float64(y))
+        var decay float64 = math.Exp(-float64(gamma) * r - float64(beta * t))
+        var phase float64 = float64(omega * t) - float64(k) * r
+        return float32(decay * math.Cos(phase))
+    }
+}
+/*  End of DampedWave.                                                        */
@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the damped radial wave preset.                              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Returns the largest absolute height of the mesh.                          */
+func largestHeight(canvas *Canvas) float32 {
+    var largest float32 = 0.0
+
+    for index := 2; index < canvas.MeshSize; index += 3 {
+        var z float32 = canvas.Mesh[index]
+
+        if z < 0.0 {
+            z = -z
+        }
+
+        if z > largest {
+            largest = z
+        }
+    }
+
+    return largest
+}
+/*  End of largestHeight.                                                     */
+
+/*  The wave starts with crests of order one, and by t = 20 the factor exp(-t *
+ *  / 2) has damped it below 1.0E-4 everywhere on the grid.                   */
+func TestDampedWaveDecays(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(21, 21))
+
+    if err := canvas.GenerateSurfacePreset("damped_wave"); err != nil {
+        t.Fatalf("GenerateSurfacePreset: %v", err)
+    }
+
+    if err := canvas.AnimateSurface(0.0); err != nil {
+        t.Fatalf("AnimateSurface: %v", err)
+    }
+
+    if largest := largestHeight(canvas); largest < 0.5 {
+        t.Errorf("largest height %g at t = 0, want at least 0.5", largest)
+    }
+
+    if err := canvas.AnimateSurface(20.0); err != nil {
+        t.Fatalf("AnimateSurface: %v", err)
+    }
+
+    if largest := largestHeight(canvas); largest > 1.0E-4 {
+        t.Errorf("largest height %g at t = 20, want below 1.0E-4", largest)
+    }
+}
+/*  End of TestDampedWaveDecays.                                              */
@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Restores vertices stored as integers.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DequantizePositions                                                   *
+ *  Purpose:                                                                  *
+ *      Converts the integers from QuantizePositions back to coordinates in   *
+ *      the stored box, three floats per vertex, which may be loaded into a   *
+ *      canvas like any other mesh.                                           *
+ *  Arguments:                                                                *
+ *      quantized (QuantizedMesh):                                            *
+ *          The integers and the box.                                         *
+ *  Output:                                                                   *
+ *      positions ([]float32):                                                *
+ *          The coordinates of the vertices.                                  *
+ ******************************************************************************/
+func DequantizePositions(quantized QuantizedMesh) []float32 {
+
+    /*  Variables for indexing over the coordinates.                          */
+    var index, axis int
+
+    /*  The factors converting from the integer levels to the box.            */
+    var step [3]float32
+
+    var levels float32 = float32(uint32(1) << uint(quantized.Bits) - 1)
+    var positions []float32 = make([]float32, len(quantized.Positions))
+
+    for axis = 0; axis < 3; axis++ {
+        step[axis] = (quantized.Upper[axis] - quantized.Lower[axis]) / levels
+    }
+
+    for index = 0; index < len(positions); index++ {
+        axis = index % 3
+
+        var level float32 = float32(quantized.Positions[index])
+        positions[index] = quantized.Lower[axis] + level * step[axis]
+    }
+
+    return positions
+}
+/*  End of DequantizePositions.                                               */
@@ -0,0 +1,134 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Restores a canvas from the binary data written by Serialize.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "encoding/binary"
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DeserializeCanvas                                                     *
+ *  Purpose:                                                                  *
+ *      Decodes data written by Serialize into a new canvas. Like a clone,    *
+ *      the canvas has its own storage, exactly the size of the stored mesh   *
+ *      and indices. The data is validated before anything is allocated: the  *
+ *      version must be supported, the sizes must be consistent with the      *
+ *      grid, and every index must refer to a vertex of the mesh, or be       *
+ *      StripRestartIndex if the indices are line strips.                     *
+ *  Arguments:                                                                *
+ *      data ([]byte):                                                        *
+ *          The encoded canvas.                                               *
+ *  Output:                                                                   *
+ *      canvas (*Canvas):                                                     *
+ *          The restored canvas, nil on failure.                              *
+ *      err (error):                                                          *
+ *          ErrUnsupportedVersion, ErrSizeMismatch, or ErrGridTooLarge.       *
+ ******************************************************************************/
+func DeserializeCanvas(data []byte) (*Canvas, error) {
+
+    /*  Variables for indexing over the header and the buffers.               */
+    var index, offset int
+
+    /*  The twelve header fields, see Serialize for the layout.               */
+    var header [12]uint32
+
+    if len(data) < serializedHeaderSize {
+        return nil, fmt.Errorf("%w: data is truncated", ErrSizeMismatch)
+    }
+
+    if data[0] != SerializationVersion {
+        return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+    }
+
+    offset = 1
+
+    for index = 0; index < len(header); index++ {
+        header[index] = binary.LittleEndian.Uint32(data[offset:])
+        offset += 4
+    }
+
+    var nxPts, nyPts uint32 = header[0], header[1]
+    var meshSize, indexSize int = int(header[10]), int(header[11])
+    var lineStrips bool = (header[9] & serializedLineStrips) != 0
+
+    if (nxPts > MaxWidth) || (nyPts > MaxHeight) {
+        return nil, ErrGridTooLarge
+    }
+
+    /*  The mesh must match the grid, and the data must hold both buffers.    */
+    if meshSize != 3 * int(nxPts) * int(nyPts) {
+        return nil, fmt.Errorf("%w: mesh is not the grid size", ErrSizeMismatch)
+    }
+
+    if len(data) != serializedHeaderSize + 4 * (meshSize + indexSize) {
+        return nil, fmt.Errorf("%w: data is the wrong length", ErrSizeMismatch)
+    }
+
+    var canvas *Canvas = &Canvas{
+        NxPts: nxPts,
+        NyPts: nyPts,
+        Width: math.Float32frombits(header[2]),
+        Height: math.Float32frombits(header[3]),
+        HorizontalStart: math.Float32frombits(header[4]),
+        VerticalStart: math.Float32frombits(header[5]),
+        MeshType: uint(header[6]),
+        WireframeStride: header[7],
+        WireframeDirection: uint(header[8]),
+        LineStrips: lineStrips,
+        NumberOfPoints: meshSize / 3,
+        MeshSize: meshSize,
+        IndexSize: indexSize,
+        MeshStorage: make([]float32, meshSize),
+        IndexStorage: make([]uint32, indexSize),
+    }
+
+    for index = 0; index < meshSize; index++ {
+        var bits uint32 = binary.LittleEndian.Uint32(data[offset:])
+        canvas.MeshStorage[index] = math.Float32frombits(bits)
+        offset += 4
+    }
+
+    for index = 0; index < indexSize; index++ {
+        var value uint32 = binary.LittleEndian.Uint32(data[offset:])
+
+        /*  The restarts between line strips do not refer to a vertex.        */
+        var restart bool = lineStrips && (value == StripRestartIndex)
+
+        if (int(value) >= canvas.NumberOfPoints) && !restart {
+            return nil, fmt.Errorf("%w: index out of range", ErrSizeMismatch)
+        }
+
+        canvas.IndexStorage[index] = value
+        offset += 4
+    }
+
+    canvas.Mesh = canvas.MeshStorage
+    canvas.Indices = canvas.IndexStorage
+    return canvas, nil
+}
+/*  End of DeserializeCanvas.                                                 */
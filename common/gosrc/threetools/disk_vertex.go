@@ -0,0 +1,38 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the index of a vertex of a disk mesh.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the index of a vertex of a disk mesh, see GenerateDiskMesh. Ring  *
+ *  zero is the center, a single vertex, and ring k > 0 has sectors vertices  *
+ *  stored one after the other. The sector is taken mod sectors, so the last  *
+ *  sector wraps around to the first.                                         */
+func diskVertex(ring, sector, sectors uint32) uint32 {
+    if ring == 0 {
+        return 0
+    }
+
+    return 1 + (ring - 1) * sectors + (sector % sectors)
+}
+/*  End of diskVertex.                                                        */
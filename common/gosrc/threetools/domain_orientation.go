@@ -0,0 +1,39 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Determines if the domain of a canvas is traversed in reverse.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns -1 if exactly one of the width and height is negative, and +1     *
+ *  otherwise. A negative width sweeps the domain from right to left, so the  *
+ *  grid runs against the x axis and the winding of the grid is reversed. The *
+ *  normals and faces are multiplied by this so that they are oriented the    *
+ *  same way for either direction, for a graph they point up.                 */
+func (self *Canvas) domainOrientation() float32 {
+    if (self.Width < 0.0) != (self.Height < 0.0) {
+        return -1.0
+    }
+
+    return 1.0
+}
+/*  End of domainOrientation.                                                 */
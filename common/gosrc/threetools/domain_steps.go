@@ -0,0 +1,42 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Maps the grid to the steps taken across the domain.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the number of steps from the start of the domain along each axis  *
+ *  for the vertex in column xIndex and row yIndex. These are the indices     *
+ *  themselves, or counted from the far edge along the axes that are flipped, *
+ *  see FlipU and FlipV. The generators use this to walk the domain.          */
+func (self *Canvas) domainSteps(xIndex, yIndex uint32) (uint32, uint32) {
+    if self.FlipU {
+        xIndex = self.NxPts - 1 - xIndex
+    }
+
+    if self.FlipV {
+        yIndex = self.NyPts - 1 - yIndex
+    }
+
+    return xIndex, yIndex
+}
+/*  End of domainSteps.                                                       */
@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for walking the domain backwards with FlipU and FlipV.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Generates the triangle faces of the paraboloid with the given flips and   *
+ *  returns the z components of the face normals.                             */
+func faceNormalHeights(t *testing.T, flipU, flipV bool) []float32 {
+    t.Helper()
+
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 4), WithFlip(flipU, flipV),
+    )
+
+    var heights []float32
+
+    canvas.GenerateTriangleFaces()
+    canvas.ComputeFaceNormals()
+
+    for index := 2; index < len(canvas.FaceNormals); index += 3 {
+        heights = append(heights, canvas.FaceNormals[index])
+    }
+
+    return heights
+}
+/*  End of faceNormalHeights.                                                 */
+
+/*  The faces of a graph all point up, or all point down. Flipping one axis   *
+ *  reverses the winding so every face normal turns over, and flipping both   *
+ *  axes is a half turn of the domain which keeps the winding.                */
+func TestFlipReversesWinding(t *testing.T) {
+    var plain []float32 = faceNormalHeights(t, false, false)
+
+    var cases = []struct {
+        flipU, flipV bool
+        sign float32
+    }{
+        {true, false, -1.0},
+        {false, true, -1.0},
+        {true, true, 1.0},
+    }
+
+    for _, c := range cases {
+        var flipped []float32 = faceNormalHeights(t, c.flipU, c.flipV)
+
+        if len(flipped) != len(plain) || len(plain) == 0 {
+            t.Fatalf("flip %v, %v: %d faces, want %d",
+                     c.flipU, c.flipV, len(flipped), len(plain))
+        }
+
+        for index := range plain {
+            if plain[index] * flipped[index] * c.sign <= 0.0 {
+                t.Fatalf("flip %v, %v: face %d has normal z %g, default %g",
+                         c.flipU, c.flipV, index, flipped[index],
+                         plain[index])
+            }
+        }
+    }
+}
+/*  End of TestFlipReversesWinding.                                           */
@@ -0,0 +1,116 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reduces the resolution of the grid of a canvas.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the number of points along an axis after downsampling by the      *
+ *  factor, and the index of the original point used for the new point index. *
+ *  The first and last points are always kept, so the corners of the grid do  *
+ *  not move, and the points in between are the nearest to being evenly       *
+ *  spaced, about every factor-th point.                                      */
+func downsampleAxis(count, factor, index uint32) (uint32, uint32) {
+    var reduced uint32 = (count + factor - 1) / factor
+
+    if reduced < 2 {
+        reduced = 2
+    }
+
+    if (count < 2) || (reduced >= count) {
+        return count, index
+    }
+
+    var numerator uint64 = uint64(index) * uint64(count - 1)
+    var denominator uint64 = uint64(reduced - 1)
+
+    /*  Rounded to the nearest integer.                                       */
+    return reduced, uint32((2 * numerator + denominator) / (2 * denominator))
+}
+/*  End of downsampleAxis.                                                    */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Downsample                                                            *
+ *  Purpose:                                                                  *
+ *      Creates a coarser mesh, for figures far from the camera, by keeping   *
+ *      about every factor-th vertex along each axis of the grid and          *
+ *      rebuilding the wireframe at the lower resolution. A 64x64 grid        *
+ *      reduced by a factor of 2 becomes a 32x32 grid. The corners of the     *
+ *      grid are kept, so the domain is unchanged. The vertices are moved     *
+ *      within the existing mesh buffer, and the per-vertex buffers computed  *
+ *      from the old mesh, like the normals, are cleared. Factors of 0 and 1  *
+ *      leave the canvas unchanged.                                           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being downsampled.                                     *
+ *      factor (uint32):                                                      *
+ *          The reduction in the number of points along each axis.            *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          An error from rebuilding the wireframe.                           *
+ ******************************************************************************/
+func (self *Canvas) Downsample(factor uint32) error {
+
+    /*  Variable for indexing over the new vertices.                          */
+    var index uint32
+
+    if factor < 2 {
+        return nil
+    }
+
+    width, _ := downsampleAxis(self.NxPts, factor, 0)
+    height, _ := downsampleAxis(self.NyPts, factor, 0)
+
+    /*  The old index of a new vertex is never smaller than its new index, so *
+     *  the vertices may be moved in place, in increasing order. Both grids   *
+     *  use the layout of the canvas, see gridIndex.                          */
+    for index = 0; index < width * height; index++ {
+        var xIndex, yIndex uint32 = index % width, index / width
+
+        if self.LayoutColumnMajor {
+            xIndex, yIndex = index / height, index % height
+        }
+
+        _, xOld := downsampleAxis(self.NxPts, factor, xIndex)
+        _, yOld := downsampleAxis(self.NyPts, factor, yIndex)
+        var old uint32 = self.gridIndex(xOld, yOld)
+
+        copy(self.MeshStorage[3 * index:3 * index + 3],
+             self.MeshStorage[3 * old:3 * old + 3])
+    }
+
+    /*  The surface is still valid, only the resolution has changed.          */
+    var surface SurfaceParametrization = self.Surface
+    self.clearDerivedBuffers()
+    self.Surface = surface
+
+    self.NxPts = width
+    self.NyPts = height
+
+    if err := self.Recompute(self.MeshStorage, self.IndexStorage); err != nil {
+        return err
+    }
+
+    return self.GenerateRectangularWireframe()
+}
+/*  End of Downsample.                                                        */
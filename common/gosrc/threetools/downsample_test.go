@@ -0,0 +1,82 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the level-of-detail downsampling.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Downsampling a 64x64 paraboloid by 2 gives a 32x32 grid whose corners are *
+ *  the corners of the original, every vertex is still on the surface, and    *
+ *  the wireframe is rebuilt for the coarser grid.                            */
+func TestDownsample64By2(t *testing.T) {
+    for _, columnMajor := range []bool{false, true} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(64, 64), WithColumnMajor(columnMajor),
+        )
+        var original *Canvas = canvas.Clone()
+
+        if err := canvas.Downsample(2); err != nil {
+            t.Fatalf("Downsample: %v", err)
+        }
+
+        if (canvas.NxPts != 32) || (canvas.NyPts != 32) ||
+           (canvas.NumberOfPoints != 32 * 32) {
+            t.Fatalf("column major %v: %dx%d grid with %d points, " +
+                     "want 32x32", columnMajor, canvas.NxPts,
+                     canvas.NyPts, canvas.NumberOfPoints)
+        }
+
+        var corners = [][2]uint32{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+
+        for _, corner := range corners {
+            var got [3]float32 = canvas.point(int(canvas.gridIndex(
+                corner[0] * 31, corner[1] * 31,
+            )))
+            var want [3]float32 = original.point(int(original.gridIndex(
+                corner[0] * 63, corner[1] * 63,
+            )))
+
+            if got != want {
+                t.Errorf("column major %v: corner %v is %v, want %v",
+                         columnMajor, corner, got, want)
+            }
+        }
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            var p [3]float32 = canvas.point(index)
+
+            if !closeTo(p[2], paraboloid(p[0], p[1]), 1.0E-6) {
+                t.Fatalf("vertex %d at %v is not on the surface", index, p)
+            }
+        }
+
+        var wireframe *Canvas = newGraphCanvas(t, paraboloid, WithGrid(32, 32))
+
+        if canvas.IndexSize != wireframe.IndexSize {
+            t.Errorf("column major %v: IndexSize %d, want %d", columnMajor,
+                     canvas.IndexSize, wireframe.IndexSize)
+        }
+    }
+}
+/*  End of TestDownsample64By2.                                               */
@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates the mesh in response to a mouse or touch drag.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DragRotate                                                            *
+ *  Purpose:                                                                  *
+ *      Maps a drag on the screen to a rotation of the mesh, giving an orbit  *
+ *      style control. Horizontal motion turns the mesh about the vertical    *
+ *      screen axis, y, and vertical motion tilts it about the horizontal     *
+ *      screen axis, x. The angles are accumulated and applied to the base    *
+ *      mesh recorded by CaptureBaseOrientation, so there is no drift. The    *
+ *      base is captured automatically after a new mesh has been generated,   *
+ *      and is updated when the mesh is edited in place, like by Bend.        *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being rotated.                   *
+ *      dx (float32):                                                         *
+ *          The horizontal drag distance, in pixels.                          *
+ *      dy (float32):                                                         *
+ *          The vertical drag distance, in pixels.                            *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) DragRotate(dx, dy float32) {
+    self.ensureBaseOrientation()
+
+    self.Yaw += dx * DragRadiansPerPixel
+    self.Pitch += dy * DragRadiansPerPixel
+
+    self.applyBaseOrientation()
+}
+/*  End of DragRotate.                                                        */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the vibrational modes of a rectangular drum.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sin, Cos, Sqrt, and Pi are provided here.                                 */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DrumMode                                                              *
+ *  Purpose:                                                                  *
+ *      Returns the standing wave z = sin(m pi x / Lx) sin(n pi y / Ly)       *
+ *      cos(omega t) of a rectangular membrane, measuring x and y from the    *
+ *      corner of the membrane, which is centered at the origin. The edges    *
+ *      are held fixed, and the surface is zero for all time along the nodal  *
+ *      lines, m - 1 lines across x at the fractions 1 / m, 2 / m, and so on  *
+ *      of the width, and n - 1 across y. The frequency is that of the wave   *
+ *      equation with the given wave speed, omega = c pi sqrt((m / Lx)^2 + (n *
+ *      / Ly)^2), so higher modes vibrate faster.                             *
+ *  Arguments:                                                                *
+ *      m (int):                                                              *
+ *          The number of half waves along x.                                 *
+ *      n (int):                                                              *
+ *          The number of half waves along y.                                 *
+ *      width (float32):                                                      *
+ *          The width Lx of the membrane.                                     *
+ *      height (float32):                                                     *
+ *          The height Ly of the membrane.                                    *
+ *      speed (float32):                                                      *
+ *          The wave speed c.                                                 *
+ *  Output:                                                                   *
+ *      f (TimeSurface):                                                      *
+ *          The surface.                                                      *
+ ******************************************************************************/
+func DrumMode(m, n int, width, height, speed float32) TimeSurface {
+    var kx float64 = float64(m) * math.Pi / float64(width)
+    var ky float64 = float64(n) * math.Pi / float64(height)
+    var omega float64 = float64(speed) * math.Sqrt(kx * kx + ky * ky)
+
+    return func(x, y, t float32) float32 {
+        var u float64 = float64(x) + 0.5 * float64(width)
+        var v float64 = float64(y) + 0.5 * float64(height)
+        var phase float64 = omega * float64(t)
+        return float32(math.Sin(kx * u) * math.Sin(ky * v) * math.Cos(phase))
+    }
+}
+/*  End of DrumMode.                                                          */
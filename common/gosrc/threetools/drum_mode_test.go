@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the standing waves of a rectangular membrane.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The (3, 2) mode of a 4 by 2 membrane on [-2, 2] x [-1, 1] is zero at all  *
+ *  times along the nodal lines, a third and two thirds of the way across x   *
+ *  and halfway across y, and on the edges. Between the lines it moves.       */
+func TestDrumModeNodalLines(t *testing.T) {
+    var f TimeSurface = DrumMode(3, 2, 4.0, 2.0, 1.5)
+
+    /*  The lines x = constant and y = constant where the membrane is still.  */
+    var nodalX []float32 = []float32{
+        -2.0, -2.0 + 4.0 / 3.0, -2.0 + 8.0 / 3.0, 2.0,
+    }
+    var nodalY []float32 = []float32{-1.0, 0.0, 1.0}
+
+    for _, time := range []float32{0.0, 0.3, 1.1, 2.5} {
+        for step := 0; step <= 8; step++ {
+            var x float32 = -2.0 + 0.5 * float32(step)
+            var y float32 = -1.0 + 0.25 * float32(step)
+
+            for _, x0 := range nodalX {
+                if z := f(x0, y, time); !closeTo(z, 0.0, 1.0E-6) {
+                    t.Errorf("z(%g, %g, %g) = %g, want 0", x0, y, time, z)
+                }
+            }
+
+            for _, y0 := range nodalY {
+                if z := f(x, y0, time); !closeTo(z, 0.0, 1.0E-6) {
+                    t.Errorf("z(%g, %g, %g) = %g, want 0", x, y0, time, z)
+                }
+            }
+        }
+    }
+
+    /*  The middle of the first cell between the lines is an antinode.        */
+    if z := f(-2.0 + 2.0 / 3.0, -0.5, 0.0); !closeTo(z, 1.0, 1.0E-6) {
+        t.Errorf("antinode has z = %g at t = 0, want 1", z)
+    }
+}
+/*  End of TestDrumModeNodalLines.                                            */
+
+/*  The drum_mode preset, the (2, 3) mode on [-1, 1] x [-1, 1], is zero on    *
+ *  the line x = 0 and on the lines y = -1 / 3 and y = 1 / 3.                 */
+func TestDrumModePresetNodalLines(t *testing.T) {
+    f, found := GetSurface("drum_mode")
+
+    if !found {
+        t.Fatalf("the drum_mode preset is missing")
+    }
+
+    for step := 0; step <= 8; step++ {
+        var s float32 = -1.0 + 0.25 * float32(step)
+
+        for _, point := range [][2]float32{{0.0, s}, {s, -1.0 / 3.0},
+                                           {s, 1.0 / 3.0}} {
+            if z := f(point[0], point[1]); !closeTo(z, 0.0, 1.0E-6) {
+                t.Errorf("z(%g, %g) = %g, want 0", point[0], point[1], z)
+            }
+        }
+    }
+}
+/*  End of TestDrumModePresetNodalLines.                                      */
@@ -0,0 +1,133 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes a mesh as plain text for comparing outputs.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+)
+
+/*  Formats a coordinate with six digits after the decimal point. Values that *
+ *  round to zero are written as 0.000000, never with a minus sign, so that   *
+ *  an output does not change because of the sign of a tiny rounding error.   */
+func dumpFloat(value float32) string {
+    var text string = strconv.FormatFloat(float64(value), 'f', 6, 32)
+
+    if text == "-0.000000" {
+        return "0.000000"
+    }
+
+    return text
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      DumpMesh                                                              *
+ *  Purpose:                                                                  *
+ *      Writes the active vertices, indices, and faces of a canvas as text in *
+ *      a fixed format, for comparing the output of the generators against    *
+ *      saved copies when the code is changed. The first line has the grid    *
+ *      size, the mesh type, and the counts. Then there is one "v x y z" line *
+ *      per vertex, with six digits after the decimal point, one "l a b" line *
+ *      per segment, or one "s a b c ..." line per strip if LineStrips is     *
+ *      set, and one "f a b c" line per triangle face. The same canvas always *
+ *      gives the same text, and a small change to the mesh changes only the  *
+ *      lines that differ, so outputs are easy to compare.                    *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *  Output:                                                                   *
+ *      dump (string):                                                        *
+ *          The text.                                                         *
+ ******************************************************************************/
+func DumpMesh(canvas *Canvas) string {
+
+    /*  Variable for indexing over the buffers.                               */
+    var index int
+
+    /*  The text is built up one line at a time.                              */
+    var builder strings.Builder
+
+    fmt.Fprintf(
+        &builder, "grid %d %d type %d vertices %d indices %d faces %d\n",
+        canvas.NxPts, canvas.NyPts, canvas.MeshType,
+        canvas.NumberOfPoints, canvas.IndexSize, len(canvas.Faces) / 3,
+    )
+
+    for index = 0; index < canvas.MeshSize; index += 3 {
+        fmt.Fprintf(
+            &builder, "v %s %s %s\n",
+            dumpFloat(canvas.Mesh[index]),
+            dumpFloat(canvas.Mesh[index + 1]),
+            dumpFloat(canvas.Mesh[index + 2]),
+        )
+    }
+
+    if canvas.LineStrips {
+
+        /*  Each strip is one line, the restarts end the lines.               */
+        var started bool = false
+
+        for index = 0; index < canvas.IndexSize; index++ {
+            var vertex uint32 = canvas.Indices[index]
+
+            if vertex == StripRestartIndex {
+                builder.WriteString("\n")
+                started = false
+                continue
+            }
+
+            if !started {
+                builder.WriteString("s")
+                started = true
+            }
+
+            fmt.Fprintf(&builder, " %d", vertex)
+        }
+
+        if started {
+            builder.WriteString("\n")
+        }
+    } else {
+        for index = 0; index + 1 < canvas.IndexSize; index += 2 {
+            fmt.Fprintf(
+                &builder, "l %d %d\n",
+                canvas.Indices[index], canvas.Indices[index + 1],
+            )
+        }
+    }
+
+    for index = 0; index + 2 < len(canvas.Faces); index += 3 {
+        fmt.Fprintf(
+            &builder, "f %d %d %d\n",
+            canvas.Faces[index], canvas.Faces[index + 1],
+            canvas.Faces[index + 2],
+        )
+    }
+
+    return builder.String()
+}
+/*  End of DumpMesh.                                                          */
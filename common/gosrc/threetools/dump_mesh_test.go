@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Golden tests for DumpMesh, comparing the output of the generators     *
+ *      against the saved copies in testdata.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The dumps of small meshes from the main generators match the saved        *
+ *  copies. The first is the 3x3 square wireframe of the plane z = x + y.     */
+func TestDumpMeshGolden(t *testing.T) {
+    var plane = func(x, y float32) float32 {
+        return x + y
+    }
+
+    var square *Canvas = newGraphCanvas(t, plane, WithGrid(3, 3))
+    expectGolden(t, "square_3x3", DumpMesh(square))
+
+    var triangle *Canvas = newGraphCanvas(
+        t, plane, WithGrid(3, 3), WithMeshType(TriangleWireframe),
+    )
+
+    if err := triangle.GenerateWireframeAndFaces(true); err != nil {
+        t.Fatalf("GenerateWireframeAndFaces: %v", err)
+    }
+
+    expectGolden(t, "triangle_faces_3x3", DumpMesh(triangle))
+
+    var strips *Canvas = newGraphCanvas(
+        t, plane, WithGrid(3, 3), WithLineStrips(true),
+    )
+    expectGolden(t, "strips_3x3", DumpMesh(strips))
+
+    var columns *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(3, 2), WithColumnMajor(true),
+    )
+    expectGolden(t, "column_major_3x2", DumpMesh(columns))
+}
+/*  End of TestDumpMeshGolden.                                                */
+
+/*  The same canvas gives the same text, and negative zero is written as      *
+ *  zero.                                                                     */
+func TestDumpMeshDeterministic(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+
+    canvas.Mesh[2] = float32(-1.0E-9)
+
+    var first string = DumpMesh(canvas)
+
+    if second := DumpMesh(canvas); first != second {
+        t.Fatalf("two dumps of the same canvas differ")
+    }
+
+    if dumpFloat(float32(-1.0E-9)) != "0.000000" {
+        t.Errorf("dumpFloat(-1e-9) = %s, want 0.000000",
+                 dumpFloat(float32(-1.0E-9)))
+    }
+}
+/*  End of TestDumpMeshDeterministic.                                         */
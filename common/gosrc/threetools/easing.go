@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Easing curves for smooth transitions.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Linear easing, constant speed from start to finish.                       */
+func EaseLinear(t float32) float32 {
+    return t
+}
+
+/*  Quadratic ease in, starting slowly and speeding up.                       */
+func EaseInQuad(t float32) float32 {
+    return t * t
+}
+
+/*  Quadratic ease out, starting quickly and slowing down.                    */
+func EaseOutQuad(t float32) float32 {
+    return t * (2.0 - t)
+}
+
+/*  Quadratic ease in and out, slow at both ends and fastest in the middle.   */
+func EaseInOutQuad(t float32) float32 {
+    if t < 0.5 {
+        return 2.0 * t * t
+    }
+
+    return 1.0 - 2.0 * (1.0 - t) * (1.0 - t)
+}
+
+/*  Cubic ease in and out, a gentler start and finish than the quadratic.     */
+func EaseInOutCubic(t float32) float32 {
+    if t < 0.5 {
+        return 4.0 * t * t * t
+    }
+
+    var s float32 = 1.0 - t
+    return 1.0 - 4.0 * s * s * s
+}
+
+/*  Smoothstep, 3t^2 - 2t^3, slow at both ends with zero speed at the ends.   */
+func EaseSmoothstep(t float32) float32 {
+    return t * t * (3.0 - 2.0 * t)
+}
+/*  End of easing functions.                                                  */
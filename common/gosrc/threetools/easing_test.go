@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the easing functions.                                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Every easing function starts at 0, ends at 1, and increases in between.   *
+ *  At t = 1/2 the symmetric ones are at 1/2, ease in is at 1/4, and ease out *
+ *  at 3/4.                                                                   */
+func TestEasingEndpointsAndMidpoints(t *testing.T) {
+    var cases = []struct {
+        name string
+        ease func(float32) float32
+        midpoint float32
+    }{
+        {"EaseLinear", EaseLinear, 0.5},
+        {"EaseInQuad", EaseInQuad, 0.25},
+        {"EaseOutQuad", EaseOutQuad, 0.75},
+        {"EaseInOutQuad", EaseInOutQuad, 0.5},
+        {"EaseInOutCubic", EaseInOutCubic, 0.5},
+        {"EaseSmoothstep", EaseSmoothstep, 0.5},
+    }
+
+    for _, c := range cases {
+        if (c.ease(0.0) != 0.0) || (c.ease(1.0) != 1.0) {
+            t.Errorf("%s(0) = %g and %s(1) = %g, want 0 and 1",
+                     c.name, c.ease(0.0), c.name, c.ease(1.0))
+        }
+
+        if c.ease(0.5) != c.midpoint {
+            t.Errorf("%s(1/2) = %g, want %g",
+                     c.name, c.ease(0.5), c.midpoint)
+        }
+
+        var previous float32 = 0.0
+
+        for step := 1; step <= 100; step++ {
+            var time float32 = float32(step) / 100.0
+            var value float32 = c.ease(time)
+
+            if value < previous {
+                t.Errorf("%s decreases at t = %g", c.name, time)
+                break
+            }
+
+            previous = value
+        }
+    }
+}
+/*  End of TestEasingEndpointsAndMidpoints.                                   */
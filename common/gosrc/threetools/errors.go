@@ -0,0 +1,84 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Error values returned by the generation and validation routines.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for creating error values.                       */
+import "errors"
+
+/*  Callers may compare against these with errors.Is, the functions that      *
+ *  return them may wrap them with more detail about the failure.             */
+var (
+    /*  The grid has more points than MaxWidth or MaxHeight allow.            */
+    ErrGridTooLarge = errors.New("threetools: grid is too large")
+
+    /*  The grid has too few points for the requested mesh.                   */
+    ErrGridTooSmall = errors.New("threetools: grid is too small")
+
+    /*  The mesh type is not one of the SquareWireframe constants.            */
+    ErrInvalidMeshType = errors.New("threetools: invalid mesh type")
+
+    /*  The grid does not have the shape a generator requires.                */
+    ErrUnsupportedGrid = errors.New("threetools: unsupported grid size")
+
+    /*  The direction is not valid for the routine it was passed to.          */
+    ErrInvalidDirection = errors.New("threetools: invalid direction")
+
+    /*  A row, column, or vertex index is outside of the grid.                */
+    ErrIndexOutOfRange = errors.New("threetools: index out of range")
+
+    /*  A buffer does not have room for the data written to it.               */
+    ErrBufferTooSmall = errors.New("threetools: buffer is too small")
+
+    /*  The data has a different size than the canvas expects.                */
+    ErrSizeMismatch = errors.New("threetools: size does not match the canvas")
+
+    /*  Serialized data was written by an unknown version of the format.      */
+    ErrUnsupportedVersion = errors.New("threetools: unsupported data version")
+
+    /*  A surface produced a NaN or an infinity.                              */
+    ErrNonFinite = errors.New("threetools: surface value is not finite")
+
+    /*  A count, like a number of bins or samples, is zero or negative.       */
+    ErrInvalidCount = errors.New("threetools: count must be positive")
+
+    /*  A number of bits is outside of the range a format supports.           */
+    ErrInvalidPrecision = errors.New("threetools: invalid number of bits")
+
+    /*  The degree or order of a spherical harmonic is out of range.          */
+    ErrInvalidDegree = errors.New("threetools: invalid degree or order")
+
+    /*  No surface has been registered with the requested name.               */
+    ErrUnknownSurface = errors.New("threetools: no surface with this name")
+
+    /*  The mesh type has seams that the chosen generator can not close.      */
+    ErrIncompatibleGenerator = errors.New(
+        "threetools: mesh type does not suit the generator",
+    )
+
+    /*  Index buffers of line segments and of line strips can not be mixed.   */
+    ErrIncompatibleFormat = errors.New(
+        "threetools: index buffers use different formats",
+    )
+)
@@ -0,0 +1,122 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that each failure condition reports its sentinel error.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped. Inf and NaN *
+ *  come from math, strings wraps the OBJ text in a reader.                   */
+import (
+    "errors"
+    "math"
+    "strings"
+    "testing"
+)
+
+/*  The infinite surface z = 1 / 0, whose heights are never finite.           */
+func infiniteSurface(x, y float32) float32 {
+    return float32(math.Inf(1))
+}
+/*  End of infiniteSurface.                                                   */
+
+/*  Each failure condition returns its own sentinel, possibly wrapped.        */
+func TestSentinelErrors(t *testing.T) {
+    var cases = []struct {
+        name string
+        want error
+        fail func(canvas *Canvas) error
+    }{
+        {
+            "configure a grid wider than MaxWidth", ErrGridTooLarge,
+            func(canvas *Canvas) error {
+                var config CanvasConfig = NewCanvasConfig(
+                    WithGrid(MaxWidth + 1, 4),
+                )
+                return canvas.Configure(config)
+            },
+        },
+        {
+            "configure an unknown mesh type", ErrInvalidMeshType,
+            func(canvas *Canvas) error {
+                var config CanvasConfig = NewCanvasConfig(
+                    WithMeshType(ProjectiveTriangleWireframe + 1),
+                )
+                return canvas.Configure(config)
+            },
+        },
+        {
+            "generate a mesh taller than MaxHeight", ErrGridTooLarge,
+            func(canvas *Canvas) error {
+                canvas.NyPts = MaxHeight + 1
+                return canvas.GenerateMeshFromParametrization(paraboloid)
+            },
+        },
+        {
+            "generate a mesh into a short buffer", ErrBufferTooSmall,
+            func(canvas *Canvas) error {
+                canvas.Mesh = canvas.Mesh[:3]
+                return canvas.GenerateMeshFromParametrization(paraboloid)
+            },
+        },
+        {
+            "generate an infinite surface", ErrNonFinite,
+            func(canvas *Canvas) error {
+                return canvas.GenerateMeshFromParametrization(
+                    infiniteSurface,
+                )
+            },
+        },
+        {
+            "generate the wireframe of an unknown type", ErrInvalidMeshType,
+            func(canvas *Canvas) error {
+                canvas.MeshType = ProjectiveTriangleWireframe + 1
+                return canvas.GenerateRectangularWireframe()
+            },
+        },
+        {
+            "load a mesh of the wrong size", ErrSizeMismatch,
+            func(canvas *Canvas) error {
+                return canvas.LoadMeshBase64("AAAAAA==")
+            },
+        },
+        {
+            "import an OBJ file with too many vertices", ErrGridTooLarge,
+            func(canvas *Canvas) error {
+                var obj string = strings.Repeat(
+                    "v 0 0 0\n", int(MaxLength) + 1,
+                )
+                return ImportOBJ(strings.NewReader(obj), canvas)
+            },
+        },
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(t, WithGrid(4, 3))
+        var err error = c.fail(canvas)
+
+        if !errors.Is(err, c.want) {
+            t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+        }
+    }
+}
+/*  End of TestSentinelErrors.                                                */
@@ -0,0 +1,167 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the Euler characteristic of a mesh.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sorting is used to find coincident vertices quickly.                      */
+import "sort"
+
+/*  Returns the representative of every vertex of the mesh, where vertices    *
+ *  within WeldEpsilon of each other share a representative. The vertices are *
+ *  sorted by x so only those with nearby x values need to be compared.       */
+func coincidentVertexRoots(canvas *Canvas) []int {
+
+    /*  Variables for indexing over the vertices.                             */
+    var index, other int
+
+    var parent []int = make([]int, canvas.NumberOfPoints)
+    var order []int = make([]int, canvas.NumberOfPoints)
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        parent[index] = index
+        order[index] = index
+    }
+
+    sort.Slice(order, func(a, b int) bool {
+        return canvas.Mesh[3 * order[a]] < canvas.Mesh[3 * order[b]]
+    })
+
+    for index = 0; index < len(order); index++ {
+        var p [3]float32 = canvas.point(order[index])
+
+        for other = index + 1; other < len(order); other++ {
+            var q [3]float32 = canvas.point(order[other])
+
+            /*  The rest of the vertices are too far away along x.            */
+            if q[0] - p[0] > WeldEpsilon {
+                break
+            }
+
+            if vectorNorm(vectorDifference(p, q)) > WeldEpsilon {
+                continue
+            }
+
+            var first int = findRoot(parent, order[index])
+            var second int = findRoot(parent, order[other])
+
+            if first < second {
+                parent[second] = first
+            } else if second < first {
+                parent[first] = second
+            }
+        }
+    }
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        parent[index] = findRoot(parent, index)
+    }
+
+    return parent
+}
+/*  End of coincidentVertexRoots.                                             */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      EulerCharacteristic                                                   *
+ *  Purpose:                                                                  *
+ *      Computes V - E + F for the mesh, a topological invariant. This is 2   *
+ *      for a sphere, 0 for a torus or Klein bottle, and 1 for the projective *
+ *      plane. Vertices within WeldEpsilon of each other, like the two copies *
+ *      of a seam or the collapsed row at a pole, are counted once. The edges *
+ *      are the distinct segments of the index buffer together with the sides *
+ *      of the triangle faces, and the faces are the distinct triangles of    *
+ *      the face buffer. Edges and faces that collapse to a point or a        *
+ *      segment are not counted. For a meaningful result the face buffer      *
+ *      should cover the surface.                                             *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being examined.                          *
+ *  Output:                                                                   *
+ *      chi (int):                                                            *
+ *          The Euler characteristic.                                         *
+ ******************************************************************************/
+func EulerCharacteristic(canvas *Canvas) int {
+
+    /*  Variable for indexing over the vertices, segments, and faces.         */
+    var index int
+
+    /*  The distinct edges and faces, in terms of the representatives.        */
+    var edges map[[2]int]bool = map[[2]int]bool{}
+    var faces map[[3]int]bool = map[[3]int]bool{}
+
+    /*  The number of distinct vertices.                                      */
+    var vertices int = 0
+
+    var root []int = coincidentVertexRoots(canvas)
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        if root[index] == index {
+            vertices++
+        }
+    }
+
+    /*  Edges are unordered, store them with the smaller index first.         */
+    var addEdge = func(a, b uint32) {
+        var first int = root[a]
+        var second int = root[b]
+
+        if first == second {
+            return
+        } else if first > second {
+            first, second = second, first
+        }
+
+        edges[[2]int{first, second}] = true
+    }
+
+    /*  The segments of the wireframe, with the line strips split up.         */
+    var pairs []uint32 = canvas.segmentPairs()
+
+    for index = 0; index + 1 < len(pairs); index += 2 {
+        addEdge(pairs[index], pairs[index + 1])
+    }
+
+    for index = 0; index + 2 < len(canvas.Faces); index += 3 {
+        var a uint32 = canvas.Faces[index]
+        var b uint32 = canvas.Faces[index + 1]
+        var c uint32 = canvas.Faces[index + 2]
+        var face [3]int = [3]int{root[a], root[b], root[c]}
+
+        addEdge(a, b)
+        addEdge(b, c)
+        addEdge(c, a)
+
+        /*  Sort the three corners so each triangle is stored only once.      */
+        sort.Ints(face[:])
+
+        if (face[0] == face[1]) || (face[1] == face[2]) {
+            continue
+        }
+
+        faces[face] = true
+    }
+
+    return vertices - len(edges) + len(faces)
+}
+/*  End of EulerCharacteristic.                                               */
@@ -0,0 +1,165 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the Euler characteristic of the closed topologies.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos, Hypot, and Max are provided by math, used for the surfaces.       */
+import (
+    "math"
+    "testing"
+)
+
+/*  The unit sphere, with the poles at v = 0 and 1 and the seam at u = 0, 1.  */
+func unitSphere(u, v float32) [3]float32 {
+    sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+    sinV, cosV := math.Sincos(math.Pi * float64(v))
+    return [3]float32{
+        float32(sinV * cosU), float32(sinV * sinU), float32(-cosV),
+    }
+}
+/*  End of unitSphere.                                                        */
+
+/*  The torus with radii 2 and 1, periodic in both u and v.                   */
+func torus(u, v float32) [3]float32 {
+    sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+    sinV, cosV := math.Sincos(2.0 * math.Pi * float64(v))
+    var r float64 = 2.0 + cosV
+    return [3]float32{float32(r * cosU), float32(r * sinU), float32(sinV)}
+}
+/*  End of torus.                                                             */
+
+/*  The figure-8 immersion of the Klein bottle. It is periodic in u, and the  *
+ *  point at v = 1 is the one at v = 0 with u replaced by 1 - u, the twist of *
+ *  KleinSquareWireframe. The figure-8 crosses itself where u is 0 or 1/2, so *
+ *  grids with an even number of columns avoid merging those points.          */
+func kleinBottle(u, v float32) [3]float32 {
+    var phi float64 = 2.0 * math.Pi * float64(u)
+    var theta float64 = 2.0 * math.Pi * float64(v)
+    sinHalf, cosHalf := math.Sincos(0.5 * theta)
+    sinTheta, cosTheta := math.Sincos(theta)
+    var sinPhi, sinTwoPhi float64 = math.Sin(phi), math.Sin(2.0 * phi)
+    var r float64 = 2.0 + cosHalf * sinPhi - sinHalf * sinTwoPhi
+    return [3]float32{
+        float32(r * cosTheta),
+        float32(r * sinTheta),
+        float32(sinHalf * sinPhi + cosHalf * sinTwoPhi),
+    }
+}
+/*  End of kleinBottle.                                                       */
+
+/*  A map of the projective plane. The square, with opposite points of its    *
+ *  boundary identified as in ProjectiveSquareWireframe, is wrapped onto the  *
+ *  upper hemisphere, with the boundary going to the equator. The map (x^2 -  *
+ *  y^2, xy, xz, yz) of the sphere into four dimensions is then the same at   *
+ *  opposite points, and is projected to three dimensions. Distinct points of *
+ *  the grid do not land on the same point.                                   */
+func projectivePlane(u, v float32) [3]float32 {
+    var qx float64 = float64(u) - 0.5
+    var qy float64 = float64(v) - 0.5
+    var radius float64 = math.Hypot(qx, qy)
+    var x, y, z float64 = 0.0, 0.0, 1.0
+
+    if radius > 0.0 {
+        var s float64 = math.Max(math.Abs(qx), math.Abs(qy))
+        sinS, cosS := math.Sincos(math.Pi * s)
+        x, y, z = sinS * qx / radius, sinS * qy / radius, cosS
+    }
+
+    var e float64 = y * z
+    return [3]float32{
+        float32(x*x - y*y + 0.3 * e),
+        float32(x * y + 0.7 * e),
+        float32(x * z - 0.5 * e),
+    }
+}
+/*  End of projectivePlane.                                                   */
+
+/*  Creates a canvas with the closed surface, its wireframe, and its faces.   */
+func newClosedSurface(t *testing.T, meshType uint, nxPts, nyPts uint32,
+                      f ParametricSurface) *Canvas {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(nxPts, nyPts), WithDomain(1.0, 1.0, 0.0, 0.0),
+        WithMeshType(meshType),
+    )
+
+    canvas.GenerateMeshFromParametric(f)
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    canvas.GenerateTriangleFaces()
+    return canvas
+}
+/*  End of newClosedSurface.                                                  */
+
+/*  The sphere has characteristic 2, the torus and the Klein bottle 0, and    *
+ *  the projective plane 1, for either kind of mesh and a few grid sizes.     */
+func TestEulerCharacteristicClosedSurfaces(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshTypes [2]uint
+        f ParametricSurface
+        chi int
+    }{
+        {
+            "sphere", [2]uint{SquareWireframe, TriangleWireframe},
+            unitSphere, 2,
+        },
+        {
+            "torus",
+            [2]uint{TorodialSquareWireframe, TorodialTriangleWireframe},
+            torus, 0,
+        },
+        {
+            "Klein bottle",
+            [2]uint{KleinSquareWireframe, KleinTriangleWireframe},
+            kleinBottle, 0,
+        },
+        {
+            "projective plane",
+            [2]uint{ProjectiveSquareWireframe, ProjectiveTriangleWireframe},
+            projectivePlane, 1,
+        },
+    }
+
+    for _, c := range cases {
+        for _, meshType := range c.meshTypes {
+            for _, grid := range [][2]uint32{{8, 6}, {12, 9}} {
+                var canvas *Canvas = newClosedSurface(
+                    t, meshType, grid[0], grid[1], c.f,
+                )
+
+                if chi := EulerCharacteristic(canvas); chi != c.chi {
+                    t.Errorf("%s, mesh type %d, %dx%d grid: chi = %d, " +
+                             "want %d", c.name, meshType, grid[0], grid[1],
+                             chi, c.chi)
+                }
+            }
+        }
+    }
+}
+/*  End of TestEulerCharacteristicClosedSurfaces.                             */
@@ -0,0 +1,119 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Turns the line segments of the wireframe into thin quads.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns a unit vector perpendicular to a segment, in the plane the quad   *
+ *  for the segment should lie in. This is perpendicular to the normal of the *
+ *  surface if one is given, and to the z axis otherwise. Segments parallel   *
+ *  to that direction use the x axis instead.                                 */
+func lineQuadSide(direction, normal [3]float32) [3]float32 {
+    var side [3]float32 = crossProduct(direction, normal)
+
+    if vectorNorm(side) == 0.0 {
+        side = crossProduct(direction, [3]float32{1.0, 0.0, 0.0})
+    }
+
+    return normalizeVector(side)
+}
+/*  End of lineQuadSide.                                                      */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExpandLinesToQuads                                                    *
+ *  Purpose:                                                                  *
+ *      Creates geometry for drawing the wireframe with thick lines, since    *
+ *      most platforms ignore the line width of LineSegments. Each segment of *
+ *      the index buffer becomes a rectangle of the given width, centered on  *
+ *      the segment, made of two triangles. The rectangles are built in       *
+ *      object space: they lie along the surface if the vertex normals have   *
+ *      been computed, with their faces pointing along the normals, and       *
+ *      parallel to the xy plane otherwise. They should be drawn double       *
+ *      sided. The four corners of each rectangle are stored in LineQuadMesh, *
+ *      and the triangles, with indices into LineQuadMesh, in LineQuadFaces.  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the wireframe.                                    *
+ *      width (float32):                                                      *
+ *          The width of the lines, in the units of the mesh.                 *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ExpandLinesToQuads(width float32) {
+
+    /*  Variable for indexing over the line segments.                         */
+    var segment int
+
+    /*  The segments, two indices per segment, with the strips split up.      */
+    var pairs []uint32 = self.segmentPairs()
+    var numberOfSegments int = len(pairs) / 2
+
+    /*  The normals are used if they match the mesh.                          */
+    var useNormals bool = len(self.Normals) >= self.MeshSize
+
+    self.LineQuadMesh = resizeBuffer(self.LineQuadMesh, 12 * numberOfSegments)
+    self.LineQuadFaces = resizeBuffer(self.LineQuadFaces, 6 * numberOfSegments)
+
+    for segment = 0; segment < numberOfSegments; segment++ {
+        var first int = int(pairs[2 * segment])
+        var second int = int(pairs[2 * segment + 1])
+        var a [3]float32 = self.point(first)
+        var b [3]float32 = self.point(second)
+        var normal [3]float32 = [3]float32{0.0, 0.0, 1.0}
+
+        if useNormals {
+            for axis := 0; axis < 3; axis++ {
+                normal[axis] = self.Normals[3 * first + axis] +
+                               self.Normals[3 * second + axis]
+            }
+        }
+
+        /*  Half of the width on either side of the segment.                  */
+        var side [3]float32 = lineQuadSide(vectorDifference(b, a), normal)
+        var offset [3]float32 = scaleVector(side, 0.5 * width)
+
+        /*  The corners, counterclockwise when viewed along the normal.       */
+        var corners [4][3]float32 = [4][3]float32{
+            vectorDifference(a, offset),
+            vectorSum(a, offset),
+            vectorSum(b, offset),
+            vectorDifference(b, offset),
+        }
+
+        var vertex uint32 = uint32(4 * segment)
+
+        for corner := 0; corner < 4; corner++ {
+            copy(self.LineQuadMesh[3 * (4 * segment + corner):],
+                 corners[corner][:])
+        }
+
+        self.LineQuadFaces[6 * segment] = vertex
+        self.LineQuadFaces[6 * segment + 1] = vertex + 1
+        self.LineQuadFaces[6 * segment + 2] = vertex + 2
+        self.LineQuadFaces[6 * segment + 3] = vertex
+        self.LineQuadFaces[6 * segment + 4] = vertex + 2
+        self.LineQuadFaces[6 * segment + 5] = vertex + 3
+    }
+}
+/*  End of ExpandLinesToQuads.                                                */
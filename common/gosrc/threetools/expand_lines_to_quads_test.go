@@ -0,0 +1,102 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the thick-line geometry of the wireframe.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Returns a corner of the thick-line geometry.                              */
+func lineQuadCorner(canvas *Canvas, vertex uint32) [3]float32 {
+    return [3]float32{
+        canvas.LineQuadMesh[3 * vertex],
+        canvas.LineQuadMesh[3 * vertex + 1],
+        canvas.LineQuadMesh[3 * vertex + 2],
+    }
+}
+/*  End of lineQuadCorner.                                                    */
+
+/*  Each segment of the wireframe yields two non-degenerate triangles that    *
+ *  only use the four corners of its own rectangle, and those corners are     *
+ *  half of the width away from the ends of the segment.                      */
+func TestExpandLinesToQuadsTwoTrianglesPerSegment(t *testing.T) {
+    const width float32 = 0.1
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+    canvas.ComputeNormals()
+    canvas.ExpandLinesToQuads(width)
+
+    var segments int = canvas.IndexSize / 2
+
+    if (len(canvas.LineQuadFaces) != 6 * segments) ||
+       (len(canvas.LineQuadMesh) != 12 * segments) {
+        t.Fatalf("%d face indices and %d floats for %d segments, " +
+                 "want %d and %d", len(canvas.LineQuadFaces),
+                 len(canvas.LineQuadMesh), segments, 6 * segments,
+                 12 * segments)
+    }
+
+    for segment := 0; segment < segments; segment++ {
+        var first uint32 = uint32(4 * segment)
+        var ends [2][3]float32 = [2][3]float32{
+            canvas.point(int(canvas.Indices[2 * segment])),
+            canvas.point(int(canvas.Indices[2 * segment + 1])),
+        }
+
+        for triangle := 0; triangle < 2; triangle++ {
+            var start int = 6 * segment + 3 * triangle
+            var face []uint32 = canvas.LineQuadFaces[start : start + 3]
+
+            for _, vertex := range face {
+                if (vertex < first) || (vertex >= first + 4) {
+                    t.Fatalf("segment %d: triangle %v uses a corner of " +
+                             "another segment", segment, face)
+                }
+            }
+
+            var p0 [3]float32 = lineQuadCorner(canvas, face[0])
+            var p1 [3]float32 = lineQuadCorner(canvas, face[1])
+            var p2 [3]float32 = lineQuadCorner(canvas, face[2])
+            var normal [3]float32 = crossProduct(
+                vectorDifference(p1, p0), vectorDifference(p2, p0),
+            )
+
+            if vectorNorm(normal) == 0.0 {
+                t.Errorf("segment %d: triangle %v is degenerate",
+                         segment, face)
+            }
+        }
+
+        /*  Corners 0 and 1 straddle the first end, 2 and 3 the second.       */
+        for corner := uint32(0); corner < 4; corner++ {
+            var end [3]float32 = ends[corner / 2]
+            var p [3]float32 = lineQuadCorner(canvas, first + corner)
+            var distance float32 = vectorNorm(vectorDifference(p, end))
+
+            if !closeTo(distance, 0.5 * width, 1.0E-5) {
+                t.Errorf("segment %d: corner %d is %g from its end, want %g",
+                         segment, corner, distance, 0.5 * width)
+            }
+        }
+    }
+}
+/*  End of TestExpandLinesToQuadsTwoTrianglesPerSegment.                      */
@@ -0,0 +1,66 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes the vertices of a mesh as comma separated values.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "fmt"
+    "strings"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportCSV                                                             *
+ *  Purpose:                                                                  *
+ *      Creates a CSV file with a header line and one "x,y,z" row per active  *
+ *      vertex, for loading the mesh into a spreadsheet or pandas. Only the   *
+ *      first NumberOfPoints vertices are written, not the unused tail of the *
+ *      buffer.                                                               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *  Output:                                                                   *
+ *      csv (string):                                                         *
+ *          The contents of the CSV file.                                     *
+ ******************************************************************************/
+func ExportCSV(canvas *Canvas) string {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The file is built up one row at a time.                               */
+    var builder strings.Builder
+
+    builder.WriteString("x,y,z\n")
+
+    for index = 0; index < canvas.MeshSize; index += 3 {
+        fmt.Fprintf(
+            &builder, "%g,%g,%g\n",
+            canvas.Mesh[index], canvas.Mesh[index + 1], canvas.Mesh[index + 2],
+        )
+    }
+
+    return builder.String()
+}
+/*  End of ExportCSV.                                                         */
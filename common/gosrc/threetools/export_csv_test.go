@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the CSV export of the vertices.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  csv reads the rows back, strconv parses the coordinates.                  */
+import (
+    "encoding/csv"
+    "strconv"
+    "strings"
+    "testing"
+)
+
+/*  After the header there is one row per active vertex, with its x, y, and z *
+ *  coordinates, and nothing from the unused tail of the buffer.              */
+func TestExportCSVRows(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var reader *csv.Reader = csv.NewReader(
+        strings.NewReader(ExportCSV(canvas)),
+    )
+
+    rows, err := reader.ReadAll()
+
+    if err != nil {
+        t.Fatalf("reading the CSV: %v", err)
+    }
+
+    if len(rows) != canvas.NumberOfPoints + 1 {
+        t.Fatalf("%d rows, want a header and %d vertices",
+                 len(rows), canvas.NumberOfPoints)
+    }
+
+    for index, row := range rows[1:] {
+        var p [3]float32
+
+        for component, field := range row {
+            value, err := strconv.ParseFloat(field, 32)
+
+            if err != nil {
+                t.Fatalf("row %d: %v", index + 1, err)
+            }
+
+            p[component] = float32(value)
+        }
+
+        expectVector(t, "row", p, canvas.point(index), 1.0E-6)
+    }
+}
+/*  End of TestExportCSVRows.                                                 */
@@ -0,0 +1,184 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes a mesh in the binary glTF 2.0 (GLB) format.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "bytes"
+    "encoding/binary"
+    "encoding/json"
+)
+
+/*  Constants from the glTF 2.0 specification.                                */
+const (
+    glbMagic uint32 = 0x46546C67
+    glbVersion uint32 = 2
+    glbChunkJSON uint32 = 0x4E4F534A
+    glbChunkBIN uint32 = 0x004E4942
+    gltfFloat int = 5126
+    gltfUnsignedInt int = 5125
+    gltfArrayBuffer int = 34962
+    gltfElementArrayBuffer int = 34963
+    gltfLines int = 1
+    gltfTriangles int = 4
+)
+
+/*  Pads a buffer with the given byte until its length is a multiple of four, *
+ *  as required for every chunk of a GLB file.                                */
+func padToFour(buffer *bytes.Buffer, padding byte) {
+    for buffer.Len() % 4 != 0 {
+        buffer.WriteByte(padding)
+    }
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportGLB                                                             *
+ *  Purpose:                                                                  *
+ *      Packages the mesh as a binary glTF 2.0 file with a single mesh        *
+ *      primitive and the default material. The positions are always written, *
+ *      and the normals if they have been computed. If the face buffer is     *
+ *      populated the primitive is an indexed triangle mesh, otherwise it is  *
+ *      the wireframe, drawn as indexed lines. The binary chunk holds the     *
+ *      positions, then the normals, then the indices, each four-byte         *
+ *      aligned.                                                              *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *  Output:                                                                   *
+ *      glb ([]byte):                                                         *
+ *          The contents of the GLB file.                                     *
+ ******************************************************************************/
+func ExportGLB(canvas *Canvas) []byte {
+
+    /*  The binary chunk and the final file.                                  */
+    var bin, glb bytes.Buffer
+
+    /*  The glTF description of the buffer, written as the JSON chunk.        */
+    var bufferViews, accessors []map[string]interface{}
+    var attributes map[string]int = map[string]int{}
+
+    /*  The triangles are preferred, the wireframe is used as a fallback. The *
+     *  glTF lines mode takes pairs, so line strips are split up.             */
+    var indices []uint32 = canvas.segmentPairs()
+    var mode int = gltfLines
+
+    if len(canvas.Faces) > 0 {
+        indices = canvas.Faces
+        mode = gltfTriangles
+    }
+
+    /*  Appends a block of data to the binary chunk with its buffer view and  *
+     *  accessor, and returns the index of the accessor.                      */
+    var addAccessor = func(data interface{}, size, count, component int,
+                           kind string, target int) int {
+        var offset int = bin.Len()
+        binary.Write(&bin, binary.LittleEndian, data)
+        padToFour(&bin, 0)
+
+        bufferViews = append(bufferViews, map[string]interface{}{
+            "buffer": 0,
+            "byteOffset": offset,
+            "byteLength": size,
+            "target": target,
+        })
+
+        accessors = append(accessors, map[string]interface{}{
+            "bufferView": len(bufferViews) - 1,
+            "componentType": component,
+            "count": count,
+            "type": kind,
+        })
+
+        return len(accessors) - 1
+    }
+
+    attributes["POSITION"] = addAccessor(
+        canvas.Mesh[0:canvas.MeshSize], 4 * canvas.MeshSize,
+        canvas.NumberOfPoints, gltfFloat, "VEC3", gltfArrayBuffer,
+    )
+
+    /*  The specification requires the bounds of the position accessor.       */
+    lower, upper := canvas.BoundingBox()
+    accessors[attributes["POSITION"]]["min"] = lower
+    accessors[attributes["POSITION"]]["max"] = upper
+
+    if len(canvas.Normals) >= canvas.MeshSize {
+        attributes["NORMAL"] = addAccessor(
+            canvas.Normals[0:canvas.MeshSize], 4 * canvas.MeshSize,
+            canvas.NumberOfPoints, gltfFloat, "VEC3", gltfArrayBuffer,
+        )
+    }
+
+    var primitive map[string]interface{} = map[string]interface{}{
+        "attributes": attributes,
+        "mode": mode,
+    }
+
+    if len(indices) > 0 {
+        primitive["indices"] = addAccessor(
+            indices, 4 * len(indices), len(indices),
+            gltfUnsignedInt, "SCALAR", gltfElementArrayBuffer,
+        )
+    }
+
+    var document map[string]interface{} = map[string]interface{}{
+        "asset": map[string]string{"version": "2.0"},
+        "scene": 0,
+        "scenes": []map[string]interface{}{{"nodes": []int{0}}},
+        "nodes": []map[string]interface{}{{"mesh": 0}},
+        "meshes": []map[string]interface{}{
+            {"primitives": []map[string]interface{}{primitive}},
+        },
+        "buffers": []map[string]int{{"byteLength": bin.Len()}},
+        "bufferViews": bufferViews,
+        "accessors": accessors,
+    }
+
+    /*  Maps, slices, and numbers always encode, the error can be ignored.    */
+    encoded, _ := json.Marshal(document)
+
+    /*  The JSON chunk is padded with spaces, which is still valid JSON.      */
+    var jsonChunk *bytes.Buffer = bytes.NewBuffer(encoded)
+    padToFour(jsonChunk, ' ')
+
+    /*  The header, then the JSON and binary chunks, each with its length and *
+     *  type. The total length counts the 12 byte header and both 8 byte      *
+     *  chunk headers.                                                        */
+    var total int = 12 + 8 + jsonChunk.Len() + 8 + bin.Len()
+    binary.Write(&glb, binary.LittleEndian, glbMagic)
+    binary.Write(&glb, binary.LittleEndian, glbVersion)
+    binary.Write(&glb, binary.LittleEndian, uint32(total))
+
+    binary.Write(&glb, binary.LittleEndian, uint32(jsonChunk.Len()))
+    binary.Write(&glb, binary.LittleEndian, glbChunkJSON)
+    glb.Write(jsonChunk.Bytes())
+
+    binary.Write(&glb, binary.LittleEndian, uint32(bin.Len()))
+    binary.Write(&glb, binary.LittleEndian, glbChunkBIN)
+    glb.Write(bin.Bytes())
+
+    return glb.Bytes()
+}
+/*  End of ExportGLB.                                                         */
@@ -0,0 +1,184 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that the GLB export is consistent with itself, following the    *
+ *      checks of the glTF validator on the lengths of the chunks, buffer     *
+ *      views, and accessors.                                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  binary and json decode the file, math reads the floats back.              */
+import (
+    "encoding/binary"
+    "encoding/json"
+    "math"
+    "testing"
+)
+
+/*  The parts of the glTF document that are checked.                          */
+type glbDocument struct {
+    Buffers []struct {
+        ByteLength int `json:"byteLength"`
+    } `json:"buffers"`
+    BufferViews []struct {
+        ByteOffset int `json:"byteOffset"`
+        ByteLength int `json:"byteLength"`
+    } `json:"bufferViews"`
+    Accessors []struct {
+        BufferView int `json:"bufferView"`
+        ComponentType int `json:"componentType"`
+        Count int `json:"count"`
+        Type string `json:"type"`
+    } `json:"accessors"`
+    Meshes []struct {
+        Primitives []struct {
+            Attributes map[string]int `json:"attributes"`
+            Indices *int `json:"indices"`
+            Mode int `json:"mode"`
+        } `json:"primitives"`
+    } `json:"meshes"`
+}
+
+/*  The number of components of each accessor type used by the export.        */
+var glbTypeSizes map[string]int = map[string]int{"SCALAR": 1, "VEC3": 3}
+
+/*  Splits a GLB file into its document and binary chunk, checking the header *
+ *  and the chunk lengths.                                                    */
+func readGLB(t *testing.T, glb []byte) (glbDocument, []byte) {
+    t.Helper()
+
+    var document glbDocument
+    var word = func(offset int) uint32 {
+        return binary.LittleEndian.Uint32(glb[offset:offset + 4])
+    }
+
+    if (len(glb) < 20) || (word(0) != glbMagic) || (word(4) != glbVersion) {
+        t.Fatal("the GLB header is missing or wrong")
+    }
+
+    if int(word(8)) != len(glb) {
+        t.Fatalf("header length %d, file length %d", word(8), len(glb))
+    }
+
+    var jsonLength int = int(word(12))
+
+    if (word(16) != glbChunkJSON) || (jsonLength % 4 != 0) {
+        t.Fatal("the first chunk is not padded JSON")
+    }
+
+    if err := json.Unmarshal(glb[20:20 + jsonLength], &document); err != nil {
+        t.Fatalf("decoding the JSON chunk: %v", err)
+    }
+
+    var start int = 20 + jsonLength
+
+    if (word(start + 4) != glbChunkBIN) ||
+       (start + 8 + int(word(start)) != len(glb)) {
+        t.Fatal("the second chunk is not the binary chunk filling the file")
+    }
+
+    return document, glb[start + 8:]
+}
+/*  End of readGLB.                                                           */
+
+/*  Checks a GLB export: the buffer covers the binary chunk, every buffer     *
+ *  view is aligned and inside the buffer, every accessor fits its view, and  *
+ *  the positions, normals, and indices agree with the canvas.                */
+func checkGLB(t *testing.T, canvas *Canvas, indices []uint32, mode int) {
+    t.Helper()
+
+    document, bin := readGLB(t, ExportGLB(canvas))
+
+    if (len(document.Buffers) != 1) ||
+       (document.Buffers[0].ByteLength != len(bin)) {
+        t.Fatalf("buffers %+v for a binary chunk of %d bytes",
+                 document.Buffers, len(bin))
+    }
+
+    for index, view := range document.BufferViews {
+        if (view.ByteOffset % 4 != 0) ||
+           (view.ByteOffset + view.ByteLength > len(bin)) {
+            t.Errorf("buffer view %d, %+v, is misaligned or too long",
+                     index, view)
+        }
+    }
+
+    for index, accessor := range document.Accessors {
+        var view = document.BufferViews[accessor.BufferView]
+        var size int = 4 * accessor.Count * glbTypeSizes[accessor.Type]
+
+        if size != view.ByteLength {
+            t.Errorf("accessor %d needs %d bytes, its view has %d",
+                     index, size, view.ByteLength)
+        }
+    }
+
+    var primitive = document.Meshes[0].Primitives[0]
+    var position = document.Accessors[primitive.Attributes["POSITION"]]
+    var offset int = document.BufferViews[position.BufferView].ByteOffset
+
+    if (primitive.Mode != mode) || (position.Count != canvas.NumberOfPoints) {
+        t.Errorf("mode %d with %d positions, want %d with %d",
+                 primitive.Mode, position.Count, mode, canvas.NumberOfPoints)
+    }
+
+    for index := 0; index < canvas.MeshSize; index++ {
+        var bits uint32 = binary.LittleEndian.Uint32(bin[offset + 4 * index:])
+
+        if math.Float32frombits(bits) != canvas.Mesh[index] {
+            t.Fatalf("position entry %d differs from the mesh", index)
+        }
+    }
+
+    var hasNormals bool = len(canvas.Normals) >= canvas.MeshSize
+
+    if _, found := primitive.Attributes["NORMAL"]; found != hasNormals {
+        t.Errorf("normals written: %v, computed: %v", found, hasNormals)
+    }
+
+    var accessor = document.Accessors[*primitive.Indices]
+    offset = document.BufferViews[accessor.BufferView].ByteOffset
+
+    if (accessor.Count != len(indices)) ||
+       (accessor.ComponentType != gltfUnsignedInt) {
+        t.Fatalf("index accessor %+v for %d indices", accessor, len(indices))
+    }
+
+    for index, want := range indices {
+        if binary.LittleEndian.Uint32(bin[offset + 4 * index:]) != want {
+            t.Fatalf("index %d differs from the canvas", index)
+        }
+    }
+}
+/*  End of checkGLB.                                                          */
+
+/*  A wireframe is written as lines, and a mesh with faces and normals as     *
+ *  triangles with a normal attribute, both consistently.                     */
+func TestExportGLBConsistent(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    checkGLB(t, canvas, canvas.Indices[0:canvas.IndexSize], gltfLines)
+
+    canvas.ComputeNormals()
+    canvas.GenerateTriangleFaces()
+    checkGLB(t, canvas, canvas.Faces, gltfTriangles)
+}
+/*  End of TestExportGLBConsistent.                                           */
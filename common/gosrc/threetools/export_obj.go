@@ -0,0 +1,52 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes a mesh in the Wavefront OBJ format.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package providing an in-memory writer.                   */
+import "bytes"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportOBJ                                                             *
+ *  Purpose:                                                                  *
+ *      Creates a Wavefront OBJ file of the mesh as a string, see WriteOBJ    *
+ *      for the format. For large meshes, stream the file with WriteOBJ       *
+ *      instead of holding all of it in memory.                               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *  Output:                                                                   *
+ *      obj (string):                                                         *
+ *          The contents of the OBJ file.                                     *
+ ******************************************************************************/
+func ExportOBJ(canvas *Canvas) string {
+
+    /*  The file is streamed into memory. Writing to a buffer never fails.    */
+    var buffer bytes.Buffer
+
+    WriteOBJ(canvas, &buffer)
+    return buffer.String()
+}
+/*  End of ExportOBJ.                                                         */
@@ -0,0 +1,144 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes a mesh in the ASCII PLY format.                                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "fmt"
+    "strings"
+)
+
+/*  Converts a color channel in [0, 1] to a byte, clamping values outside.    */
+func colorByte(channel float32) int {
+    if channel <= 0.0 {
+        return 0
+    }
+
+    if channel >= 1.0 {
+        return 255
+    }
+
+    return int(255.0 * channel + 0.5)
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportPLY                                                             *
+ *  Purpose:                                                                  *
+ *      Creates an ASCII PLY file of the mesh, readable by MeshLab and        *
+ *      Blender. The vertex positions are always written. The normals and     *
+ *      colors are added as vertex properties if those buffers are populated, *
+ *      with the colors stored as bytes, and the triangle faces are written   *
+ *      if the face buffer is not empty. The header lists only the data that  *
+ *      is present.                                                           *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *  Output:                                                                   *
+ *      ply (string):                                                         *
+ *          The contents of the PLY file.                                     *
+ ******************************************************************************/
+func ExportPLY(canvas *Canvas) string {
+
+    /*  Variable for indexing over the vertices and the faces.                */
+    var index int
+
+    /*  The file is built up one line at a time.                              */
+    var builder strings.Builder
+
+    /*  The optional buffers are only written if they cover every vertex.     */
+    var hasNormals bool = len(canvas.Normals) >= canvas.MeshSize
+    var hasColors bool = len(canvas.Colors) >= 4 * canvas.NumberOfPoints
+    var numberOfFaces int = len(canvas.Faces) / 3
+
+    builder.WriteString("ply\nformat ascii 1.0\n")
+    fmt.Fprintf(&builder, "element vertex %d\n", canvas.NumberOfPoints)
+    builder.WriteString(
+        "property float x\nproperty float y\nproperty float z\n",
+    )
+
+    if hasNormals {
+        builder.WriteString(
+            "property float nx\nproperty float ny\nproperty float nz\n",
+        )
+    }
+
+    if hasColors {
+        builder.WriteString(
+            "property uchar red\nproperty uchar green\nproperty uchar blue\n",
+        )
+        builder.WriteString("property uchar alpha\n")
+    }
+
+    if numberOfFaces > 0 {
+        fmt.Fprintf(&builder, "element face %d\n", numberOfFaces)
+        builder.WriteString("property list uchar uint vertex_indices\n")
+    }
+
+    builder.WriteString("end_header\n")
+
+    /*  One line per vertex, with the properties in the order listed above.   */
+    for index = 0; index < canvas.MeshSize; index += 3 {
+        fmt.Fprintf(
+            &builder, "%g %g %g",
+            canvas.Mesh[index], canvas.Mesh[index + 1], canvas.Mesh[index + 2],
+        )
+
+        if hasNormals {
+            fmt.Fprintf(
+                &builder, " %g %g %g",
+                canvas.Normals[index],
+                canvas.Normals[index + 1],
+                canvas.Normals[index + 2],
+            )
+        }
+
+        if hasColors {
+            var color int = 4 * (index / 3)
+
+            fmt.Fprintf(
+                &builder, " %d %d %d %d",
+                colorByte(canvas.Colors[color]),
+                colorByte(canvas.Colors[color + 1]),
+                colorByte(canvas.Colors[color + 2]),
+                colorByte(canvas.Colors[color + 3]),
+            )
+        }
+
+        builder.WriteString("\n")
+    }
+
+    /*  Each face is a list of three vertex indices.                          */
+    for index = 0; index < 3 * numberOfFaces; index += 3 {
+        fmt.Fprintf(
+            &builder, "3 %d %d %d\n",
+            canvas.Faces[index],
+            canvas.Faces[index + 1],
+            canvas.Faces[index + 2],
+        )
+    }
+
+    return builder.String()
+}
+/*  End of ExportPLY.                                                         */
@@ -0,0 +1,51 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the PLY export of the mesh.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A small mesh with normals, colors, and faces matches the saved copy, with *
+ *  every optional property in the header.                                    */
+func TestExportPLYColoredGolden(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 2))
+    canvas.ComputeNormals()
+    canvas.GenerateTriangleFaces()
+    canvas.Colors = resizeBuffer(canvas.Colors, 4 * canvas.NumberOfPoints)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var shade float32 = float32(index) / float32(canvas.NumberOfPoints - 1)
+        setVertexColor(canvas.Colors, index, [3]float32{shade, 0.5, 1.0})
+    }
+
+    expectGolden(t, "ply_colored_3x2", ExportPLY(canvas))
+}
+/*  End of TestExportPLYColoredGolden.                                        */
+
+/*  Without normals, colors, or faces only the positions are written.         */
+func TestExportPLYPlainGolden(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 2))
+    expectGolden(t, "ply_plain_3x2", ExportPLY(canvas))
+}
+/*  End of TestExportPLYPlainGolden.                                          */
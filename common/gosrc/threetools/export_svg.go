@@ -0,0 +1,46 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Exports the wireframe of a canvas as an SVG image.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportSVG                                                             *
+ *  Purpose:                                                                  *
+ *      Creates an SVG image of the wireframe of a canvas, as seen by a       *
+ *      camera looking along the given direction. The lines are drawn in      *
+ *      black with a width of one pixel.                                      *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh and index buffer being drawn.            *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, pointing from the viewer into the scene.   *
+ *  Output:                                                                   *
+ *      svg (string):                                                         *
+ *          The contents of the SVG file.                                     *
+ ******************************************************************************/
+func ExportSVG(canvas *Canvas, direction [3]float32) string {
+    return ExportSVGWithStroke(canvas, direction, "#000000", 1.0)
+}
+/*  End of ExportSVG.                                                         */
@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the SVG export of the wireframe.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  strings counts the lines of the image.                                    */
+import (
+    "strings"
+    "testing"
+)
+
+/*  The image of a small square wireframe matches the saved copy, and has one *
+ *  line element per segment.                                                 */
+func TestExportSVGGolden(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var svg string = ExportSVG(canvas, [3]float32{0.0, 1.0, -1.0})
+
+    if lines := strings.Count(svg, "<line "); lines != canvas.IndexSize / 2 {
+        t.Errorf("%d lines drawn, want %d", lines, canvas.IndexSize / 2)
+    }
+
+    expectGolden(t, "svg_square_3x3", svg)
+}
+/*  End of TestExportSVGGolden.                                               */
+
+/*  The stroke color and width are written to the group of lines.             */
+func TestExportSVGWithStroke(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var svg string = ExportSVGWithStroke(
+        canvas, [3]float32{0.0, 0.0, -1.0}, "#ff0000", 2.5,
+    )
+
+    if !strings.Contains(svg, "stroke=\"#ff0000\" stroke-width=\"2.5000\"") {
+        t.Errorf("stroke not set in:\n%s", svg)
+    }
+}
+/*  End of TestExportSVGWithStroke.                                           */
@@ -0,0 +1,153 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Exports the wireframe of a canvas as an SVG image with a given stroke *
+ *      color and width.                                                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "fmt"
+    "strings"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ExportSVGWithStroke                                                   *
+ *  Purpose:                                                                  *
+ *      Creates an SVG image of the wireframe of a canvas. Each line segment  *
+ *      in the index buffer is written as an SVG line element using the       *
+ *      orthographic projection of its endpoints. The segments are drawn from *
+ *      back to front so that nearer lines are drawn over farther ones. The   *
+ *      view box is sized to fit the projected bounding box of the mesh.      *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh and index buffer being drawn.            *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, pointing from the viewer into the scene.   *
+ *      color (string):                                                       *
+ *          The stroke color, any valid SVG color string (e.g. "#000000").    *
+ *      width (float32):                                                      *
+ *          The stroke width in pixels.                                       *
+ *  Output:                                                                   *
+ *      svg (string):                                                         *
+ *          The contents of the SVG file.                                     *
+ ******************************************************************************/
+func ExportSVGWithStroke(canvas *Canvas,
+                         direction [3]float32,
+                         color string,
+                         width float32) string {
+
+    /*  Variables for indexing over the vertices and the line segments.       */
+    var index int
+
+    /*  The projected bounding box of the mesh, used for the view box.        */
+    var xMin, xMax, yMin, yMax float32
+
+    /*  Padding added to each side of the view box so that the lines along    *
+     *  the boundary of the figure are not cut in half.                       */
+    var padding float32
+
+    /*  The image is built up one line segment at a time.                     */
+    var builder strings.Builder
+
+    /*  Compute the screen coordinates for every vertex in the mesh.          */
+    var projected []float32 = ProjectOrthographic(canvas, direction)
+
+    /*  Compute the bounding box of the projected points. If there are no     *
+     *  points then the box is left as the origin.                            */
+    if canvas.NumberOfPoints > 0 {
+        xMin, xMax = projected[0], projected[0]
+        yMin, yMax = projected[1], projected[1]
+    }
+
+    for index = 1; index < canvas.NumberOfPoints; index++ {
+        var x float32 = projected[2 * index]
+        var y float32 = projected[2 * index + 1]
+
+        if x < xMin {
+            xMin = x
+        } else if x > xMax {
+            xMax = x
+        }
+
+        if y < yMin {
+            yMin = y
+        } else if y > yMax {
+            yMax = y
+        }
+    }
+
+    /*  Pad the box by a small fraction of its largest dimension. If the box  *
+     *  is degenerate (a single point) use a padding of one unit instead.     */
+    padding = xMax - xMin
+
+    if yMax - yMin > padding {
+        padding = yMax - yMin
+    }
+
+    if padding == 0.0 {
+        padding = 1.0
+    } else {
+        padding *= 0.02
+    }
+
+    /*  SVG has the y axis pointing down the screen, and the projection has   *
+     *  it pointing up. The y coordinates are negated, meaning the top of the *
+     *  view box is at -yMax.                                                 */
+    fmt.Fprintf(
+        &builder,
+        "<svg xmlns=\"http://www.w3.org/2000/svg\" " +
+        "viewBox=\"%.4f %.4f %.4f %.4f\">\n",
+        xMin - padding, -yMax - padding,
+        xMax - xMin + 2.0 * padding, yMax - yMin + 2.0 * padding,
+    )
+
+    /*  The stroke is the same for every line, set it once for the group.     */
+    fmt.Fprintf(
+        &builder,
+        "<g stroke=\"%s\" stroke-width=\"%.4f\" stroke-linecap=\"round\">\n",
+        color, width,
+    )
+
+    /*  Each pair of indices is a line segment. Draw them from back to front. */
+    var segments []uint32 = SortSegmentsByDepth(canvas, direction)
+
+    /*  Write each segment as an SVG line. The non-scaling-stroke effect      *
+     *  keeps the width in pixels regardless of the size of the view box.     */
+    for index = 0; index + 1 < len(segments); index += 2 {
+        var start uint32 = segments[index]
+        var end uint32 = segments[index + 1]
+
+        fmt.Fprintf(
+            &builder,
+            "<line x1=\"%.4f\" y1=\"%.4f\" x2=\"%.4f\" y2=\"%.4f\" " +
+            "vector-effect=\"non-scaling-stroke\"/>\n",
+            projected[2 * start], -projected[2 * start + 1],
+            projected[2 * end], -projected[2 * end + 1],
+        )
+    }
+
+    builder.WriteString("</g>\n</svg>\n")
+    return builder.String()
+}
+/*  End of ExportSVGWithStroke.                                               */
@@ -0,0 +1,98 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the opacity of the vertices by their depth.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      FadeByDepth                                                           *
+ *  Purpose:                                                                  *
+ *      Writes an opacity for each vertex into the fourth channel of the      *
+ *      color buffer, fading the far side of the surface as a depth cue. The  *
+ *      depth of a vertex is its component along the viewing direction, the   *
+ *      nearest vertex gets nearAlpha, the farthest farAlpha, and those in    *
+ *      between are interpolated linearly. The red, green, and blue channels  *
+ *      are kept, if there are no colors yet the vertices are made white. If  *
+ *      every vertex is at the same depth they all get nearAlpha.             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose vertices are being faded.                        *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, pointing from the viewer into the scene.   *
+ *      nearAlpha (float32):                                                  *
+ *          The opacity of the nearest vertex.                                *
+ *      farAlpha (float32):                                                   *
+ *          The opacity of the farthest vertex.                               *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) FadeByDepth(direction [3]float32,
+                                nearAlpha, farAlpha float32) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The range of the depths, the first vertex starts both ends.           */
+    var nearest, farthest float32
+
+    /*  The depths are measured along the unit viewing direction.             */
+    var forward [3]float32 = normalizeVector(direction)
+    var depths []float32 = make([]float32, self.NumberOfPoints)
+
+    if self.NumberOfPoints == 0 {
+        return
+    }
+
+    /*  Without colors there is nothing to keep, start from opaque white.     */
+    if len(self.Colors) < 4 * self.NumberOfPoints {
+        self.Colors = resizeBuffer(self.Colors, 4 * self.NumberOfPoints)
+
+        for index = 0; index < self.NumberOfPoints; index++ {
+            setVertexColor(self.Colors, index, [3]float32{1.0, 1.0, 1.0})
+        }
+    }
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        depths[index] = dotProduct(self.point(index), forward)
+
+        if (index == 0) || (depths[index] < nearest) {
+            nearest = depths[index]
+        }
+
+        if (index == 0) || (depths[index] > farthest) {
+            farthest = depths[index]
+        }
+    }
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var t float32 = 0.0
+
+        if farthest > nearest {
+            t = (depths[index] - nearest) / (farthest - nearest)
+        }
+
+        self.Colors[4 * index + 3] = nearAlpha + t * (farAlpha - nearAlpha)
+    }
+}
+/*  End of FadeByDepth.                                                       */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for fading the vertices of a mesh by their depth.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Looking down on the paraboloid the corners, at the top, are the nearest   *
+ *  vertices and get nearAlpha, and the center at the bottom is the farthest  *
+ *  and gets farAlpha. Every other vertex is in between, and the colors are   *
+ *  kept.                                                                     */
+func TestFadeByDepthNearAndFar(t *testing.T) {
+    const nearAlpha float32 = 0.9
+    const farAlpha float32 = 0.2
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    canvas.ColorByRadius(GrayColormap)
+
+    var colors []float32 = append([]float32(nil), canvas.Colors...)
+    var center int = int(canvas.gridIndex(2, 2))
+    var corner int = int(canvas.gridIndex(4, 0))
+
+    canvas.FadeByDepth([3]float32{0.0, 0.0, -2.0}, nearAlpha, farAlpha)
+
+    var cornerAlpha float32 = canvas.Colors[4 * corner + 3]
+    var centerAlpha float32 = canvas.Colors[4 * center + 3]
+
+    if !closeTo(cornerAlpha, nearAlpha, 1.0E-6) {
+        t.Errorf("corner has alpha %g, want %g", cornerAlpha, nearAlpha)
+    }
+
+    if !closeTo(centerAlpha, farAlpha, 1.0E-6) {
+        t.Errorf("center has alpha %g, want %g", centerAlpha, farAlpha)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var alpha float32 = canvas.Colors[4 * index + 3]
+
+        if (alpha < farAlpha - 1.0E-6) || (alpha > nearAlpha + 1.0E-6) {
+            t.Errorf("vertex %d has alpha %g, outside [%g, %g]",
+                     index, alpha, farAlpha, nearAlpha)
+        }
+
+        for channel := 4 * index; channel < 4 * index + 3; channel++ {
+            if canvas.Colors[channel] != colors[channel] {
+                t.Fatalf("color entry %d changed from %g to %g", channel,
+                         colors[channel], canvas.Colors[channel])
+            }
+        }
+    }
+}
+/*  End of TestFadeByDepthNearAndFar.                                         */
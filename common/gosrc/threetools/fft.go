@@ -0,0 +1,184 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Fast Fourier transforms of complex sequences of any length.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sin, Cos, and Pi are provided here, used for the roots of unity.          */
+import "math"
+
+/*  Returns the complex number exp(i angle).                                  */
+func unitComplex(angle float64) complex128 {
+    return complex(math.Cos(angle), math.Sin(angle))
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      fftPowerOfTwo                                                         *
+ *  Purpose:                                                                  *
+ *      Computes the discrete Fourier transform of a sequence, in place, with *
+ *      the iterative radix-2 Cooley-Tukey algorithm. The length must be a    *
+ *      power of two. The forward transform uses exp(-2 pi i jk / n), the     *
+ *      inverse exp(2 pi i jk / n), and neither is normalized.                *
+ *  Arguments:                                                                *
+ *      data ([]complex128):                                                  *
+ *          The sequence, replaced by its transform.                          *
+ *      inverse (bool):                                                       *
+ *          Whether the inverse transform is computed.                        *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func fftPowerOfTwo(data []complex128, inverse bool) {
+
+    /*  Variables for indexing the sequence and the butterflies.              */
+    var index, reversed, start, offset int
+
+    /*  The length of the sequence and the size of the current butterflies.   */
+    var length int = len(data)
+    var size int
+
+    /*  The sign of the exponent of the roots of unity.                       */
+    var sign float64 = -1.0
+
+    if inverse {
+        sign = 1.0
+    }
+
+    /*  Put the elements in bit reversed order.                               */
+    for index = 1; index < length; index++ {
+        var bit int = length >> 1
+
+        for reversed & bit != 0 {
+            reversed ^= bit
+            bit >>= 1
+        }
+
+        reversed ^= bit
+
+        if index < reversed {
+            data[index], data[reversed] = data[reversed], data[index]
+        }
+    }
+
+    /*  Combine transforms of length size / 2 into ones of length size.       */
+    for size = 2; size <= length; size <<= 1 {
+        var step complex128 = unitComplex(sign * 2.0 * math.Pi / float64(size))
+
+        for start = 0; start < length; start += size {
+            var root complex128 = 1.0
+
+            for offset = 0; offset < size / 2; offset++ {
+                var even complex128 = data[start + offset]
+                var odd complex128 = root * data[start + offset + size / 2]
+
+                data[start + offset] = even + odd
+                data[start + offset + size / 2] = even - odd
+                root *= step
+            }
+        }
+    }
+}
+/*  End of fftPowerOfTwo.                                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      fft                                                                   *
+ *  Purpose:                                                                  *
+ *      Computes the discrete Fourier transform of a sequence of any length,  *
+ *      in place. Powers of two use fftPowerOfTwo directly, other lengths use *
+ *      Bluestein's algorithm, which writes the transform as a convolution    *
+ *      and computes that with power of two transforms. The sign convention   *
+ *      matches fftPowerOfTwo, and neither transform is normalized, so the    *
+ *      inverse of the forward transform is the input times its length.       *
+ *  Arguments:                                                                *
+ *      data ([]complex128):                                                  *
+ *          The sequence, replaced by its transform.                          *
+ *      inverse (bool):                                                       *
+ *          Whether the inverse transform is computed.                        *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func fft(data []complex128, inverse bool) {
+
+    /*  Variable for indexing over the sequence.                              */
+    var index int
+
+    /*  The length of the sequence and of the padded convolution.             */
+    var length int = len(data)
+    var padded int = 1
+
+    /*  The sign of the exponent of the roots of unity.                       */
+    var sign float64 = -1.0
+
+    if length < 2 {
+        return
+    }
+
+    if length & (length - 1) == 0 {
+        fftPowerOfTwo(data, inverse)
+        return
+    }
+
+    if inverse {
+        sign = 1.0
+    }
+
+    /*  The convolution has 2 length - 1 terms, padded to a power of two.     */
+    for padded < 2 * length - 1 {
+        padded <<= 1
+    }
+
+    /*  The chirp exp(sign pi i k^2 / length). Since k^2 is only needed mod   *
+     *  2 length, it is reduced first so large indices keep their precision.  */
+    var chirp []complex128 = make([]complex128, length)
+    var signal []complex128 = make([]complex128, padded)
+    var kernel []complex128 = make([]complex128, padded)
+
+    for index = 0; index < length; index++ {
+        var square int = (index * index) % (2 * length)
+        var angle float64 = sign * math.Pi * float64(square) / float64(length)
+
+        chirp[index] = unitComplex(angle)
+        signal[index] = data[index] * chirp[index]
+        kernel[index] = complex(real(chirp[index]), -imag(chirp[index]))
+
+        if index > 0 {
+            kernel[padded - index] = kernel[index]
+        }
+    }
+
+    /*  Convolve the signal with the conjugate chirp.                         */
+    fftPowerOfTwo(signal, false)
+    fftPowerOfTwo(kernel, false)
+
+    for index = 0; index < padded; index++ {
+        signal[index] *= kernel[index]
+    }
+
+    fftPowerOfTwo(signal, true)
+
+    for index = 0; index < length; index++ {
+        data[index] = signal[index] * chirp[index] / complex(float64(padded), 0)
+    }
+}
+/*  End of fft.                                                               */
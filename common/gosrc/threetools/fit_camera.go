@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes a camera distance that frames the whole mesh.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  tan is provided here, used for the field of view.                         */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      FitCamera                                                             *
+ *  Purpose:                                                                  *
+ *      Computes how far a camera on the z axis, looking down towards the     *
+ *      center of the bounding box, must be from that center for the whole    *
+ *      box to be visible. The front face of the box is the nearest, and it   *
+ *      is made to fit within the vertical field of view. The larger of the x *
+ *      and y extents is used so the box also fits horizontally for aspect    *
+ *      ratios of at least one.                                               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh that is being framed.                    *
+ *      fov (float32):                                                        *
+ *          The vertical field of view of the camera, in radians.             *
+ *  Output:                                                                   *
+ *      distance (float32):                                                   *
+ *          The distance from the center of the box to the camera, along the  *
+ *          z axis.                                                           *
+ ******************************************************************************/
+func FitCamera(canvas *Canvas, fov float32) float32 {
+
+    /*  The size of the mesh, the box is centered about the line of sight.    */
+    lower, upper := canvas.BoundingBox()
+
+    /*  Half of the lengths of the sides of the box.                          */
+    var halfWidth float32 = 0.5 * (upper[0] - lower[0])
+    var halfHeight float32 = 0.5 * (upper[1] - lower[1])
+    var halfDepth float32 = 0.5 * (upper[2] - lower[2])
+
+    /*  The tangent of half of the field of view gives the slope of the edges *
+     *  of the view frustum.                                                  */
+    var slope float32 = float32(math.Tan(0.5 * float64(fov)))
+
+    if halfWidth > halfHeight {
+        halfHeight = halfWidth
+    }
+
+    /*  The front face is halfDepth in front of the center. At the distance   *
+     *  halfHeight / slope from the camera its edges touch the frustum.       */
+    return halfDepth + halfHeight / slope
+}
+/*  End of FitCamera.                                                         */
@@ -0,0 +1,79 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the distance that fits the mesh in the view of the camera.  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi, Sqrt, and Atan are provided by math, used for the field of view.      */
+import (
+    "math"
+    "testing"
+)
+
+/*  A box 2 wide, 4 tall, and 1 deep seen with a field of view of 90 degrees  *
+ *  has its front face, 1/2 in front of the center, at a distance of 2 from   *
+ *  the camera, where the half height 2 fills half the view. A box 6 wide and *
+ *  2 tall is limited by its width instead, and with a field of view of 60    *
+ *  degrees needs the distance 3 / tan(30) = 3 sqrt(3) to its front face.     */
+func TestFitCameraBox(t *testing.T) {
+    var cases = []struct {
+        lower, upper [3]float32
+        fov float32
+        distance float32
+    }{
+        {
+            [3]float32{-1.0, -2.0, -0.5}, [3]float32{1.0, 2.0, 0.5},
+            0.5 * math.Pi, 2.5,
+        },
+        {
+            [3]float32{2.0, 0.0, 1.0}, [3]float32{8.0, 2.0, 2.0},
+            math.Pi / 3.0, float32(0.5 + 3.0 * math.Sqrt(3.0)),
+        },
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newPointCanvas(t, [][3]float32{c.lower, c.upper})
+        var distance float32 = FitCamera(canvas, c.fov)
+
+        if !closeTo(distance, c.distance, 1.0E-5) {
+            t.Errorf("box %v to %v, fov %g: distance %g, want %g",
+                     c.lower, c.upper, c.fov, distance, c.distance)
+        }
+
+        /*  The edge of the front face is on the edge of the view frustum.    */
+        var halfSize float32 = 0.5 * (c.upper[1] - c.lower[1])
+
+        if c.upper[0] - c.lower[0] > c.upper[1] - c.lower[1] {
+            halfSize = 0.5 * (c.upper[0] - c.lower[0])
+        }
+
+        var front float32 = distance - 0.5 * (c.upper[2] - c.lower[2])
+        var angle float64 = math.Atan(float64(halfSize / front))
+
+        if !closeTo(float32(2.0 * angle), c.fov, 1.0E-5) {
+            t.Errorf("box %v to %v: the front face spans %g, not fov %g",
+                     c.lower, c.upper, 2.0 * angle, c.fov)
+        }
+    }
+}
+/*  End of TestFitCameraBox.                                                  */
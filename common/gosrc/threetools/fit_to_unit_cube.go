@@ -0,0 +1,78 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Centers and scales a mesh to fit the cube [-1, 1]^3.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      FitToUnitCube                                                         *
+ *  Purpose:                                                                  *
+ *      Moves the mesh so its bounding box is centered on the origin, and     *
+ *      scales it uniformly so the largest side of the box spans [-1, 1].     *
+ *      Every figure then has the same size on screen whatever the scale of   *
+ *      the surface. The scale is the same along every axis, so the aspect    *
+ *      ratio is kept and the mesh fits inside the cube [-1, 1]^3. Normals    *
+ *      point the same way after a uniform scale and need no update. A mesh   *
+ *      that is a single point is only moved.                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being fitted.                            *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) FitToUnitCube() {
+
+    /*  Variables for indexing over the vertices and their coordinates.       */
+    var index, axis int
+
+    /*  The scale factor, one for a mesh with no extent.                      */
+    var scale float32 = 1.0
+
+    /*  The largest side of the bounding box.                                 */
+    var extent float32 = 0.0
+
+    lower, upper := self.BoundingBox()
+    var center [3]float32 = scaleVector(vectorSum(lower, upper), 0.5)
+
+    for axis = 0; axis < 3; axis++ {
+        if upper[axis] - lower[axis] > extent {
+            extent = upper[axis] - lower[axis]
+        }
+    }
+
+    if extent > 0.0 {
+        scale = 2.0 / extent
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        for axis = 0; axis < 3; axis++ {
+            var value float32 = self.Mesh[index + axis] - center[axis]
+            self.Mesh[index + axis] = scale * value
+        }
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of FitToUnitCube.                                                     */
@@ -0,0 +1,51 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for fitting a mesh into the unit cube.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The corners of the box [2, 10] x [-3, -1] x [5, 6], and a point inside,   *
+ *  are moved and scaled uniformly so the longest side, along x, spans        *
+ *  exactly [-1, 1] and the box is centered on the origin, keeping the aspect *
+ *  ratio of the other sides.                                                 */
+func TestFitToUnitCubeBox(t *testing.T) {
+    var points [][3]float32 = [][3]float32{{6.0, -2.0, 5.5}}
+
+    for corner := 0; corner < 8; corner++ {
+        var x float32 = 2.0 + 8.0 * float32(corner & 1)
+        var y float32 = -3.0 + 2.0 * float32((corner >> 1) & 1)
+        var z float32 = 5.0 + 1.0 * float32((corner >> 2) & 1)
+        points = append(points, [3]float32{x, y, z})
+    }
+
+    var canvas *Canvas = newPointCanvas(t, points)
+    canvas.FitToUnitCube()
+
+    lower, upper := canvas.BoundingBox()
+    expectVector(t, "lower corner", lower, [3]float32{-1, -0.25, -0.125}, 0)
+    expectVector(t, "upper corner", upper, [3]float32{1, 0.25, 0.125}, 0)
+    expectVector(t, "center", canvas.point(0), [3]float32{0, 0, 0}, 0)
+}
+/*  End of TestFitToUnitCubeBox.                                              */
@@ -0,0 +1,51 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Performs a pending regeneration of a canvas.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Flush                                                                 *
+ *  Purpose:                                                                  *
+ *      Regenerates the canvas if it has been marked dirty since the last     *
+ *      regeneration. Setters only mark the canvas, and the animation loop    *
+ *      calls this once per frame, so many updates within one frame, like     *
+ *      those from dragging a slider, cost a single regeneration.             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being flushed.                                         *
+ *  Output:                                                                   *
+ *      regenerated (bool):                                                   *
+ *          True if the mesh was regenerated.                                 *
+ *      err (error):                                                          *
+ *          The error from Regenerate, if any.                                *
+ ******************************************************************************/
+func (self *Canvas) Flush() (bool, error) {
+    if !self.Dirty {
+        return false, nil
+    }
+
+    return true, self.Regenerate()
+}
+/*  End of Flush.                                                             */
@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for regenerating a canvas once per frame.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A clean canvas is left alone, and a dirty one is regenerated once.        */
+func TestFlushRegeneratesOnce(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 4))
+
+    if regenerated, err := canvas.Flush(); regenerated || (err != nil) {
+        t.Fatalf("Flush of a clean canvas = (%v, %v)", regenerated, err)
+    }
+
+    canvas.Dirty = true
+
+    if regenerated, err := canvas.Flush(); !regenerated || (err != nil) {
+        t.Fatalf("Flush of a dirty canvas = (%v, %v)", regenerated, err)
+    }
+
+    if canvas.Dirty {
+        t.Errorf("canvas is still dirty after Flush")
+    }
+}
+/*  End of TestFlushRegeneratesOnce.                                          */
+
+/*  The error from Regenerate is passed up.                                   */
+func TestFlushReturnsError(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 4))
+
+    canvas.MeshType = TorodialSquareWireframe
+    canvas.Dirty = true
+
+    regenerated, err := canvas.Flush()
+
+    if !regenerated || !errors.Is(err, ErrIncompatibleGenerator) {
+        t.Errorf("Flush = (%v, %v), want ErrIncompatibleGenerator",
+                 regenerated, err)
+    }
+}
+/*  End of TestFlushReturnsError.                                             */
@@ -0,0 +1,38 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Returns the address of the mesh JavaScript should read.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the address of the front mesh buffer, the one JavaScript reads.   *
+ *  Before the buffers are first swapped there is only one buffer, and its    *
+ *  address is returned. This changes with every SwapBuffers, so JavaScript   *
+ *  should create its view of the memory again after each swap.               */
+func (self *Canvas) FrontMeshAddress() uintptr {
+    if len(self.FrontMesh) == 0 {
+        return SliceAddress(self.Mesh)
+    }
+
+    return SliceAddress(self.FrontMesh)
+}
+/*  End of FrontMeshAddress.                                                  */
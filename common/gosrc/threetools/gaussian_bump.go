@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Surface given by a Gaussian bump.                                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp is provided here.                                                     */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GaussianBump                                                          *
+ *  Purpose:                                                                  *
+ *      Returns the surface z = A exp(-(x^2 + y^2) / (2 sigma^2)), a smooth   *
+ *      bump with peak A at the origin. Its derivatives are known in closed   *
+ *      form, making it a good test surface for normals and curvature.        *
+ *  Arguments:                                                                *
+ *      amplitude (float32):                                                  *
+ *          The height of the peak, A.                                        *
+ *      sigma (float32):                                                      *
+ *          The width of the bump, the standard deviation.                    *
+ *  Output:                                                                   *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface.                                                      *
+ ******************************************************************************/
+func GaussianBump(amplitude, sigma float32) SurfaceParametrization {
+
+    /*  Precompute the reciprocal of 2 sigma^2 once.                          */
+    var rcpr float64 = 0.5 / float64(sigma * sigma)
+
+    return func(x, y float32) float32 {
+        var rsq float64 = float64(x * x + y * y)
+        return amplitude * float32(math.Exp(-rsq * rcpr))
+    }
+}
+/*  End of GaussianBump.                                                      */
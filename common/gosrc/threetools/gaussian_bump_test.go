@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the Gaussian bump surface.                                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp is provided by math, used for the closed form.                        */
+import (
+    "math"
+    "testing"
+)
+
+/*  The bump has its peak A at the origin, the height A exp(-1/2) one         *
+ *  standard deviation away in any direction, and falls off monotonically     *
+ *  along a ray from the origin.                                              */
+func TestGaussianBump(t *testing.T) {
+    const amplitude, sigma float32 = 2.5, 0.4
+
+    var f SurfaceParametrization = GaussianBump(amplitude, sigma)
+    var atSigma float32 = amplitude * float32(math.Exp(-0.5))
+
+    if z := f(0.0, 0.0); z != amplitude {
+        t.Errorf("peak is %g, want %g", z, amplitude)
+    }
+
+    if z := f(0.0, sigma); !closeTo(z, atSigma, 1.0E-6) {
+        t.Errorf("height at (0, sigma) is %g, want %g", z, atSigma)
+    }
+
+    if z := f(0.6 * sigma, -0.8 * sigma); !closeTo(z, atSigma, 1.0E-6) {
+        t.Errorf("height at distance sigma is %g, want %g", z, atSigma)
+    }
+
+    var previous float32 = amplitude
+
+    for r := float32(0.1); r < 3.0; r += 0.1 {
+        var z float32 = f(r, r)
+
+        if (z >= previous) || (z < 0.0) {
+            t.Errorf("height %g at (%g, %g) does not decay from %g",
+                     z, r, r, previous)
+        }
+
+        previous = z
+    }
+}
+/*  End of TestGaussianBump.                                                  */
@@ -0,0 +1,168 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Draws the wireframe more densely where the surface bends.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Returns the stride of the grid lines drawn at the given level of detail.  *
+ *  The top level draws every line, and each level below draws half as many.  */
+func adaptiveStride(level, levels int) uint32 {
+    return uint32(1) << uint(levels - level)
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateAdaptiveWireframe                                             *
+ *  Purpose:                                                                  *
+ *      Generates the square wireframe with more lines where the estimate of  *
+ *      the cells is large and fewer where it is small, to show where a       *
+ *      surface bends. The vertices are not changed, only which segments are  *
+ *      drawn. The level of a cell is the number of thresholds its estimate   *
+ *      exceeds. At the top level every grid line is drawn, and each level    *
+ *      below draws every other line of the level above, and the edges of the *
+ *      grid are always drawn. A segment takes the highest level of the cells *
+ *      on either side of it. With CellNormalTurning the density follows the  *
+ *      curvature, and with CellHeightChange the slope. The seams are not     *
+ *      closed, and IndexSize is set to the indices written.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation, a rectangular grid.                 *
+ *      estimate (CellEstimate):                                              *
+ *          The indicator for each cell.                                      *
+ *      thresholds ([]float32):                                               *
+ *          The levels of the estimate, increasing.                           *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooSmall, ErrBufferTooSmall, or from ValidateBuffers.      *
+ ******************************************************************************/
+func (self *Canvas) GenerateAdaptiveWireframe(estimate CellEstimate,
+                                              thresholds []float32) error {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Variable for indexing over the array being written to.                */
+    var index int = 0
+
+    /*  The grid dimensions, and the number of cells along each axis.         */
+    var width, height uint32 = self.NxPts, self.NyPts
+    var cellsX, cellsY uint32
+
+    if (width < 2) || (height < 2) {
+        return ErrGridTooSmall
+    }
+
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    cellsX, cellsY = width - 1, height - 1
+
+    /*  Every segment of the square wireframe, at the top level.              */
+    var needed int = int(2 * (width * cellsY + height * cellsX))
+
+    if needed > len(self.IndexStorage) {
+        return fmt.Errorf(
+            "%w: %d wireframe indices, storage holds %d",
+            ErrBufferTooSmall, needed, len(self.IndexStorage),
+        )
+    }
+
+    /*  The level of detail of each cell, computed before drawing.            */
+    var levels []int = make([]int, cellsX * cellsY)
+
+    for yIndex = 0; yIndex < cellsY; yIndex++ {
+        for xIndex = 0; xIndex < cellsX; xIndex++ {
+            var value float32 = estimate(self, xIndex, yIndex)
+
+            for _, threshold := range thresholds {
+                if value > threshold {
+                    levels[yIndex * cellsX + xIndex]++
+                }
+            }
+        }
+    }
+
+    /*  The level of the cell (x, y), zero for cells outside of the grid.     *
+     *  Before the first row or column the index wraps around to a large      *
+     *  unsigned integer, which is also outside.                              */
+    var level = func(x, y uint32) int {
+        if (x >= cellsX) || (y >= cellsY) {
+            return 0
+        }
+
+        return levels[y * cellsX + x]
+    }
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var index00 uint32 = self.gridIndex(xIndex, yIndex)
+
+            /*  The segment up from this point is part of column xIndex,      *
+             *  between the cells to its left and its right.                  */
+            if yIndex < cellsY {
+                var cellLevel int = level(xIndex, yIndex)
+
+                if level(xIndex - 1, yIndex) > cellLevel {
+                    cellLevel = level(xIndex - 1, yIndex)
+                }
+
+                var stride uint32 = adaptiveStride(cellLevel, len(thresholds))
+
+                if isWireframeLine(xIndex, width, stride) {
+                    self.IndexStorage[index] = index00
+                    self.IndexStorage[index + 1] =
+                        self.gridIndex(xIndex, yIndex + 1)
+                    index += 2
+                }
+            }
+
+            /*  The segment to the right is part of row yIndex, between the   *
+             *  cells below and above it.                                     */
+            if xIndex < cellsX {
+                var cellLevel int = level(xIndex, yIndex)
+
+                if level(xIndex, yIndex - 1) > cellLevel {
+                    cellLevel = level(xIndex, yIndex - 1)
+                }
+
+                var stride uint32 = adaptiveStride(cellLevel, len(thresholds))
+
+                if isWireframeLine(yIndex, height, stride) {
+                    self.IndexStorage[index] = index00
+                    self.IndexStorage[index + 1] =
+                        self.gridIndex(xIndex + 1, yIndex)
+                    index += 2
+                }
+            }
+        }
+    }
+
+    self.Indices = self.IndexStorage[0:index]
+    self.IndexSize = index
+    return nil
+}
+/*  End of GenerateAdaptiveWireframe.                                         */
@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for varying the density of the wireframe across the grid.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Counts the segments of the wireframe with midpoints in the middle half of *
+ *  a 17x17 grid, and those outside of it.                                    */
+func countCenterAndRim(canvas *Canvas) (int, int) {
+    var center, rim int = 0, 0
+
+    for _, s := range wireframeSegments(canvas) {
+        var x uint32 = s.x0 + s.x1
+        var y uint32 = s.y0 + s.y1
+
+        /*  The doubled midpoint is within [8, 24] in the middle half.        */
+        if (x >= 8) && (x <= 24) && (y >= 8) && (y <= 24) {
+            center++
+        } else {
+            rim++
+        }
+    }
+
+    return center, rim
+}
+/*  End of countCenterAndRim.                                                 */
+
+/*  Following the slope, the paraboloid keeps a larger share of its segments  *
+ *  near the steep rim than at the flat center. With no thresholds every line *
+ *  is drawn, which gives the shares.                                         */
+func TestGenerateAdaptiveWireframeDenserAtRim(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(17, 17))
+
+    var err error = canvas.GenerateAdaptiveWireframe(CellHeightChange, nil)
+
+    if err != nil {
+        t.Fatalf("GenerateAdaptiveWireframe: %v", err)
+    }
+
+    fullCenter, fullRim := countCenterAndRim(canvas)
+    var thresholds []float32 = []float32{0.05, 0.15}
+
+    err = canvas.GenerateAdaptiveWireframe(CellHeightChange, thresholds)
+
+    if err != nil {
+        t.Fatalf("GenerateAdaptiveWireframe: %v", err)
+    }
+
+    center, rim := countCenterAndRim(canvas)
+    var centerShare float32 = float32(center) / float32(fullCenter)
+    var rimShare float32 = float32(rim) / float32(fullRim)
+
+    if !(centerShare < rimShare) || (center + rim >= fullCenter + fullRim) {
+        t.Errorf("kept %d of %d center and %d of %d rim segments",
+                 center, fullCenter, rim, fullRim)
+    }
+}
+/*  End of TestGenerateAdaptiveWireframeDenserAtRim.                          */
@@ -0,0 +1,137 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates the line segments along the boundary of the grid.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Returns whether the left and right edges, and the top and bottom edges,   *
+ *  are glued together, by the plain WrapU and WrapV flags or by the seams of *
+ *  the mesh type. Glued edges are not part of the boundary of the surface.   */
+func (self *Canvas) boundaryClosed() (bool, bool) {
+    wrapU, wrapV := self.gridWraps()
+    wrapX, _, wrapY, _ := self.seamTopology()
+    return wrapU || wrapX, wrapV || wrapY
+}
+
+/*  Writes the boundary segments to the indices and returns how many indices  *
+ *  there are. If indices is nil nothing is written, only the count.          */
+func (self *Canvas) boundaryOutline(indices []uint32) int {
+
+    /*  Variable for indexing along the edges of the grid.                    */
+    var step uint32
+
+    /*  The number of indices, written or not.                                */
+    var count int = 0
+
+    /*  Adds the segment between two vertices.                                */
+    var emit = func(start, end uint32) {
+        if indices != nil {
+            indices[count] = start
+            indices[count + 1] = end
+        }
+
+        count += 2
+    }
+
+    /*  Edges glued to the opposite edge are not part of the boundary.        */
+    closedX, closedY := self.boundaryClosed()
+
+    /*  The number of segments along the rows and columns. Across a closed    *
+     *  seam of a plain mesh the rows and columns gain one more segment.      */
+    cellsX, cellsY := self.gridCells()
+
+    /*  The bottom and top rows, unless the top and bottom edges are glued. A *
+     *  grid with a single row has just the one.                              */
+    if !closedY && (cellsX > 0) {
+        var top uint32 = self.NyPts - 1
+
+        for step = 0; step < cellsX; step++ {
+            var next uint32 = (step + 1) % self.NxPts
+            emit(self.gridIndex(step, 0), self.gridIndex(next, 0))
+        }
+
+        for step = 0; (top > 0) && (step < cellsX); step++ {
+            var next uint32 = (step + 1) % self.NxPts
+            emit(self.gridIndex(step, top), self.gridIndex(next, top))
+        }
+    }
+
+    /*  The left and right columns, similarly.                                */
+    if !closedX && (cellsY > 0) {
+        var right uint32 = self.NxPts - 1
+
+        for step = 0; step < cellsY; step++ {
+            var next uint32 = (step + 1) % self.NyPts
+            emit(self.gridIndex(0, step), self.gridIndex(0, next))
+        }
+
+        for step = 0; (right > 0) && (step < cellsY); step++ {
+            var next uint32 = (step + 1) % self.NyPts
+            emit(self.gridIndex(right, step), self.gridIndex(right, next))
+        }
+    }
+
+    return count
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateBoundaryOutline                                               *
+ *  Purpose:                                                                  *
+ *      Generates only the line segments along the four edges of the grid,    *
+ *      the outline of the surface, rather than the full wireframe. An open   *
+ *      grid has 2(NxPts - 1) + 2(NyPts - 1) segments. Edges that are glued   *
+ *      together are left out, so a cylinder or a Mobius strip keeps only its *
+ *      top and bottom rows, and a torus has no boundary at all, in which     *
+ *      case IndexSize is zero. IndexSize is set to the number of indices     *
+ *      written.                                                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation.                                     *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrBufferTooSmall or the error from ValidateBuffers.              *
+ ******************************************************************************/
+func (self *Canvas) GenerateBoundaryOutline() error {
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    var count int = self.boundaryOutline(nil)
+
+    if count > len(self.IndexStorage) {
+        return fmt.Errorf(
+            "%w: %d outline indices, storage holds %d",
+            ErrBufferTooSmall, count, len(self.IndexStorage),
+        )
+    }
+
+    self.Indices = self.IndexStorage[0:count]
+    self.IndexSize = count
+    self.boundaryOutline(self.Indices)
+    return nil
+}
+/*  End of GenerateBoundaryOutline.                                           */
@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for drawing only the outline of the grid.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The outline of an open grid has 2(NxPts - 1) + 2(NyPts - 1) segments,     *
+ *  each joining neighboring vertices along one of the four edges, and glued  *
+ *  edges are left out.                                                       */
+func TestGenerateBoundaryOutlineCounts(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshType uint
+        segments int
+    }{
+        {"square", SquareWireframe, 2 * 5 + 2 * 3},
+        {"triangle", TriangleWireframe, 2 * 5 + 2 * 3},
+        {"cylinder", CylindricalSquareWireframe, 2 * 5},
+        {"torus", TorodialSquareWireframe, 0},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(6, 4), WithMeshType(c.meshType),
+        )
+
+        if err := canvas.GenerateBoundaryOutline(); err != nil {
+            t.Fatalf("%s: GenerateBoundaryOutline: %v", c.name, err)
+        }
+
+        if canvas.IndexSize != 2 * c.segments {
+            t.Errorf("%s: %d segments, want %d",
+                     c.name, canvas.IndexSize / 2, c.segments)
+        }
+
+        for _, s := range wireframeSegments(canvas) {
+            var row bool = (s.y0 == s.y1) && ((s.y0 == 0) || (s.y0 == 3))
+            var column bool = (s.x0 == s.x1) && ((s.x0 == 0) || (s.x0 == 5))
+
+            var length int = int(s.x1) - int(s.x0) + int(s.y1) - int(s.y0)
+
+            if (!row && !column) || (length != 1) {
+                t.Errorf("%s: segment %+v is not an edge step", c.name, s)
+            }
+        }
+    }
+}
+/*  End of TestGenerateBoundaryOutlineCounts.                                 */
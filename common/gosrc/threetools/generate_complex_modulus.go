@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the modulus surface of a complex function.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Atan2 and Hypot are provided here, errors compares the wrapped errors.    */
+import (
+    "errors"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateComplexModulus                                                *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the surface z = |w(x + iy)| over the grid of *
+ *      the canvas, and colors each vertex by the argument of w, using domain *
+ *      coloring. Red is a positive real value, and the hue goes around the   *
+ *      color wheel as the argument increases. Zeros of w touch the plane,    *
+ *      poles rise up, and the colors wind around both. As in Regenerate, a   *
+ *      mesh with a few non-finite points is still colored, and the error is  *
+ *      reported afterwards. On any other failure the colors are unchanged.   *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid already set up.       *
+ *      w (ComplexFunction):                                                  *
+ *          The complex function, taking and returning real and imaginary     *
+ *          parts.                                                            *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from GenerateMeshFromParametrization, if any.           *
+ ******************************************************************************/
+func GenerateComplexModulus(canvas *Canvas, w ComplexFunction) error {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The modulus is a surface of the form z = f(x, y).                     */
+    var modulus = func(x, y float32) float32 {
+        re, im := w(x, y)
+        return float32(math.Hypot(float64(re), float64(im)))
+    }
+
+    err := canvas.GenerateMeshFromParametrization(modulus)
+
+    if (err != nil) && !errors.Is(err, ErrNonFinite) {
+        return err
+    }
+
+    canvas.Colors = resizeBuffer(canvas.Colors, 4 * canvas.NumberOfPoints)
+
+    /*  The x and y coordinates of the vertices are the input to w.           */
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        re, im := w(canvas.Mesh[3 * index], canvas.Mesh[3 * index + 1])
+
+        /*  Map the argument from (-pi, pi] to a hue in [0, 1).               */
+        var argument float64 = math.Atan2(float64(im), float64(re))
+        var hue float32 = float32(0.5 * argument / math.Pi)
+        var color [3]float32 = hueToRGB(hue)
+
+        setVertexColor(canvas.Colors, index, color)
+    }
+
+    return err
+}
+/*  End of GenerateComplexModulus.                                            */
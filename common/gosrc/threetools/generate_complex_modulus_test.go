@@ -0,0 +1,82 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the domain coloring of complex functions.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The function w(z) = z, whose modulus is a cone with a zero at the origin. */
+func complexIdentity(x, y float32) (float32, float32) {
+    return x, y
+}
+/*  End of complexIdentity.                                                   */
+
+/*  The modulus of w(z) = z is the distance to the origin, and the positive   *
+ *  real axis is red.                                                         */
+func TestGenerateComplexModulus(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(5, 5), WithDomain(2.0, 2.0, -1.0, -1.0),
+    )
+
+    if err := GenerateComplexModulus(canvas, complexIdentity); err != nil {
+        t.Fatalf("GenerateComplexModulus: %v", err)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var want float32 = vectorNorm([3]float32{p[0], p[1], 0.0})
+
+        if !closeTo(p[2], want, 1.0E-6) {
+            t.Errorf("height at %v, want %g", p, want)
+        }
+    }
+
+    /*  The vertex (1, 0) is in the last column of the middle row.            */
+    var index uint32 = canvas.gridIndex(4, 2)
+    var got [3]float32 = [3]float32{
+        canvas.Colors[4 * index],
+        canvas.Colors[4 * index + 1],
+        canvas.Colors[4 * index + 2],
+    }
+
+    expectVector(t, "color of (1, 0)", got, [3]float32{1.0, 0.0, 0.0}, 1.0E-6)
+}
+/*  End of TestGenerateComplexModulus.                                        */
+
+/*  A mesh type with closed seams is not a graph. The error is returned and   *
+ *  the colors are left alone.                                                */
+func TestGenerateComplexModulusFailure(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(5, 5), WithMeshType(TorodialSquareWireframe),
+    )
+
+    if err := GenerateComplexModulus(canvas, complexIdentity); err == nil {
+        t.Fatalf("GenerateComplexModulus succeeded on a torus")
+    }
+
+    if len(canvas.Colors) != 0 {
+        t.Errorf("%d colors written onto a failed mesh", len(canvas.Colors))
+    }
+}
+/*  End of TestGenerateComplexModulusFailure.                                 */
@@ -0,0 +1,180 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates fractal terrain with the diamond-square algorithm.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errorf and the random number generator are provided here.                 */
+import (
+    "fmt"
+    "math/rand"
+)
+
+/*  Determines if n = 2^k + 1 for some k >= 1, the sizes diamond-square uses. */
+func isPowerOfTwoPlusOne(n uint32) bool {
+    return (n >= 3) && ((n - 1) & (n - 2) == 0)
+}
+
+/*  Returns the average of the heights at the given points of a square grid   *
+ *  of the given size, skipping the points that fall outside of the grid.     */
+func averageInGrid(heights []float32, size int, points [4][2]int) float32 {
+    var sum float32 = 0.0
+    var count int = 0
+
+    for _, point := range points {
+        var x, y int = point[0], point[1]
+
+        if (x < 0) || (y < 0) || (x >= size) || (y >= size) {
+            continue
+        }
+
+        sum += heights[y * size + x]
+        count++
+    }
+
+    return sum / float32(count)
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateDiamondSquare                                                 *
+ *  Purpose:                                                                  *
+ *      Fills the mesh with fractal terrain using the diamond-square midpoint *
+ *      displacement algorithm. The corners of the grid get random heights in *
+ *      [-1, 1], and each level sets the centers of the squares, the diamond  *
+ *      step, and then the midpoints of their sides, the square step, to the  *
+ *      average of their neighbors plus a random offset. The size of the      *
+ *      offsets is multiplied by the roughness at every level, so values near *
+ *      1 give jagged terrain and values near 0 smooth hills. The number of   *
+ *      points along each axis must be 2^n + 1, the two axes may use          *
+ *      different n. The terrain is built on the larger of the two and cut    *
+ *      down to the grid. The same seed always gives the same terrain. The x  *
+ *      and y values follow the domain of the canvas as for                   *
+ *      GenerateMeshFromParametrization. There is no surface behind the       *
+ *      heights, so the stored surface is cleared and Regenerate leaves the   *
+ *      terrain alone.                                                        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid set up.               *
+ *      seed (int64):                                                         *
+ *          The seed for the random number generator.                         *
+ *      roughness (float32):                                                  *
+ *          The factor the offsets shrink by at each level.                   *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrUnsupportedGrid if the grid is not 2^n + 1 along each axis, or *
+ *          the error from ValidateBuffers. The mesh is unchanged on error.   *
+ ******************************************************************************/
+func GenerateDiamondSquare(canvas *Canvas,
+                           seed int64, roughness float32) error {
+
+    /*  Variables for indexing over the points of the terrain.                */
+    var x, y int
+
+    /*  The size of the random offsets, reduced at every level.               */
+    var scale float32 = 1.0
+
+    /*  The random number generator, seeded so the terrain is reproducible.   */
+    var generator *rand.Rand = rand.New(rand.NewSource(seed))
+
+    if err := canvas.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    if !isPowerOfTwoPlusOne(canvas.NxPts) ||
+       !isPowerOfTwoPlusOne(canvas.NyPts) {
+        return fmt.Errorf(
+            "%w: diamond-square needs 2^n + 1 points per axis, got %dx%d",
+            ErrUnsupportedGrid, canvas.NxPts, canvas.NyPts,
+        )
+    }
+
+    /*  The terrain is computed on a square covering the grid.                */
+    var size int = int(canvas.NxPts)
+
+    if canvas.NyPts > canvas.NxPts {
+        size = int(canvas.NyPts)
+    }
+
+    var heights []float32 = make([]float32, size * size)
+
+    /*  Returns a random offset in [-scale, scale].                           */
+    var offset = func() float32 {
+        return scale * (2.0 * generator.Float32() - 1.0)
+    }
+
+    heights[0] = offset()
+    heights[size - 1] = offset()
+    heights[(size - 1) * size] = offset()
+    heights[size * size - 1] = offset()
+
+    for step := size - 1; step > 1; step /= 2 {
+        var half int = step / 2
+
+        /*  Diamond step, the center of each square from its corners.         */
+        for y = half; y < size; y += step {
+            for x = half; x < size; x += step {
+                heights[y * size + x] = offset() +
+                    averageInGrid(heights, size, [4][2]int{
+                        {x - half, y - half}, {x + half, y - half},
+                        {x - half, y + half}, {x + half, y + half},
+                    })
+            }
+        }
+
+        /*  Square step, the midpoint of each side from the neighbors above,  *
+         *  below, left, and right. Points on the boundary have only three.   */
+        for y = 0; y < size; y += half {
+            for x = (y + half) % step; x < size; x += step {
+                heights[y * size + x] = offset() +
+                    averageInGrid(heights, size, [4][2]int{
+                        {x - half, y}, {x + half, y},
+                        {x, y - half}, {x, y + half},
+                    })
+            }
+        }
+
+        scale *= roughness
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    canvas.invalidateBaseOrientation()
+
+    /*  Step sizes in the horizontal and vertical axes.                       */
+    var dx float32 = canvas.Width / float32(canvas.NxPts - 1)
+    var dy float32 = canvas.Height / float32(canvas.NyPts - 1)
+
+    for y = 0; y < int(canvas.NyPts); y++ {
+        for x = 0; x < int(canvas.NxPts); x++ {
+            var index int = 3 * int(canvas.gridIndex(uint32(x), uint32(y)))
+
+            canvas.Mesh[index] = canvas.HorizontalStart + float32(x) * dx
+            canvas.Mesh[index + 1] = canvas.VerticalStart + float32(y) * dy
+            canvas.Mesh[index + 2] = heights[y * size + x]
+        }
+    }
+
+    canvas.Surface = nil
+    return nil
+}
+/*  End of GenerateDiamondSquare.                                             */
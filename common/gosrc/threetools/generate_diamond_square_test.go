@@ -0,0 +1,91 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the diamond-square fractal terrain.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  The same seed gives the same terrain on two canvases, bit for bit, and a  *
+ *  different seed gives a different terrain.                                 */
+func TestDiamondSquareDeterministic(t *testing.T) {
+    var first *Canvas = newTestCanvas(t, WithGrid(17, 9))
+    var second *Canvas = newTestCanvas(t, WithGrid(17, 9))
+    var other *Canvas = newTestCanvas(t, WithGrid(17, 9))
+
+    for _, canvas := range []*Canvas{first, second} {
+        if err := GenerateDiamondSquare(canvas, 42, 0.6); err != nil {
+            t.Fatalf("GenerateDiamondSquare: %v", err)
+        }
+    }
+
+    if err := GenerateDiamondSquare(other, 43, 0.6); err != nil {
+        t.Fatalf("GenerateDiamondSquare: %v", err)
+    }
+
+    /*  Set if any of the heights differ between the two seeds.               */
+    var differs bool = false
+
+    for index := 0; index < first.MeshSize; index++ {
+        if first.Mesh[index] != second.Mesh[index] {
+            t.Fatalf("mesh entry %d is %g and %g for the same seed",
+                     index, first.Mesh[index], second.Mesh[index])
+        }
+
+        differs = differs || (first.Mesh[index] != other.Mesh[index])
+    }
+
+    if !differs {
+        t.Error("seeds 42 and 43 gave the same terrain")
+    }
+}
+/*  End of TestDiamondSquareDeterministic.                                    */
+
+/*  Grids that are not 2^n + 1 along each axis are rejected with              *
+ *  ErrUnsupportedGrid and the mesh is left alone.                            */
+func TestDiamondSquareUnsupportedGrid(t *testing.T) {
+    for _, grid := range [][2]uint32{{16, 17}, {17, 10}, {2, 2}, {3, 6}} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(grid[0], grid[1]),
+        )
+        var before *Canvas = canvas.Clone()
+        var err error = GenerateDiamondSquare(canvas, 42, 0.6)
+
+        if !errors.Is(err, ErrUnsupportedGrid) {
+            t.Errorf("%dx%d grid: got %v, want ErrUnsupportedGrid",
+                     grid[0], grid[1], err)
+        }
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            if canvas.point(index) != before.point(index) {
+                t.Fatalf("%dx%d grid: vertex %d changed on error",
+                         grid[0], grid[1], index)
+            }
+        }
+    }
+}
+/*  End of TestDiamondSquareUnsupportedGrid.                                  */
@@ -0,0 +1,54 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the mesh for the difference of two surfaces.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateDifference                                                    *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the surface z = f(x, y) - g(x, y) over the   *
+ *      grid of the canvas. This is useful for error plots, where f is an     *
+ *      approximation and g is the exact function.                            *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid already set up.       *
+ *      f (SurfaceParametrization):                                           *
+ *          The first surface.                                                *
+ *      g (SurfaceParametrization):                                           *
+ *          The surface being subtracted.                                     *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from GenerateMeshFromParametrization, if any.           *
+ ******************************************************************************/
+func GenerateDifference(canvas *Canvas, f, g SurfaceParametrization) error {
+
+    /*  The difference is itself a surface of the form z = h(x, y).           */
+    var difference = func(x, y float32) float32 {
+        return f(x, y) - g(x, y)
+    }
+
+    return canvas.GenerateMeshFromParametrization(difference)
+}
+/*  End of GenerateDifference.                                                */
@@ -0,0 +1,60 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the difference of two surfaces.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Inf is provided by math, used for a surface that is not finite.           */
+import (
+    "math"
+    "testing"
+)
+
+/*  The difference of a surface with itself is the plane z = 0.               */
+func TestGenerateDifferenceOfSelf(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(6, 4))
+
+    if err := GenerateDifference(canvas, paraboloid, paraboloid); err != nil {
+        t.Fatalf("GenerateDifference: %v", err)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        if z := canvas.point(index)[2]; z != 0.0 {
+            t.Errorf("height %g at vertex %d, want 0", z, index)
+        }
+    }
+}
+/*  End of TestGenerateDifferenceOfSelf.                                      */
+
+/*  A difference that is not finite is reported.                              */
+func TestGenerateDifferenceNonFinite(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(6, 4))
+    var infinite = func(x, y float32) float32 {
+        return float32(math.Inf(1))
+    }
+
+    if err := GenerateDifference(canvas, infinite, paraboloid); err == nil {
+        t.Errorf("GenerateDifference succeeded with an infinite surface")
+    }
+}
+/*  End of TestGenerateDifferenceNonFinite.                                   */
@@ -0,0 +1,94 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates the triangles of a disk mesh.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateDiskFaces                                                     *
+ *  Purpose:                                                                  *
+ *      Stores the triangles of a disk mesh, see GenerateDiskMesh, in the     *
+ *      face buffer. The innermost ring is fanned from the shared center      *
+ *      vertex, one triangle per sector, and each pair of adjacent rings is   *
+ *      joined by two triangles per sector. Every triangle is                 *
+ *      counterclockwise when viewed from above, so for a graph the faces     *
+ *      point up.                                                             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the disk mesh.                                    *
+ *      rings (uint32):                                                       *
+ *          The number of rings, not counting the center.                     *
+ *      sectors (uint32):                                                     *
+ *          The number of vertices on each ring.                              *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateDiskFaces(rings, sectors uint32) {
+
+    /*  Variables for indexing over the rings and sectors.                    */
+    var ring, sector uint32
+
+    /*  Variable for indexing over the array being written to.                */
+    var index int = 0
+
+    /*  One triangle per sector around the center, two for every other ring.  */
+    var triangles int = int(sectors) * (2 * int(rings) - 1)
+
+    if rings == 0 {
+        triangles = 0
+    }
+
+    self.Faces = resizeBuffer(self.Faces, 3 * triangles)
+
+    /*  The fan around the center.                                            */
+    for sector = 0; (rings > 0) && (sector < sectors); sector++ {
+        self.Faces[index] = 0
+        self.Faces[index + 1] = diskVertex(1, sector, sectors)
+        self.Faces[index + 2] = diskVertex(1, sector + 1, sectors)
+        index += 3
+    }
+
+    /*  The band between ring and ring + 1. The corners are named as in       *
+     *  GenerateTriangleFaces, the first digit is the ring, the second the    *
+     *  sector, both relative to the current cell.                            */
+    for ring = 1; ring < rings; ring++ {
+        for sector = 0; sector < sectors; sector++ {
+            var index00 uint32 = diskVertex(ring, sector, sectors)
+            var index01 uint32 = diskVertex(ring, sector + 1, sectors)
+            var index10 uint32 = diskVertex(ring + 1, sector, sectors)
+            var index11 uint32 = diskVertex(ring + 1, sector + 1, sectors)
+
+            self.Faces[index] = index00
+            self.Faces[index + 1] = index10
+            self.Faces[index + 2] = index11
+
+            self.Faces[index + 3] = index00
+            self.Faces[index + 4] = index11
+            self.Faces[index + 5] = index01
+
+            index += 6
+        }
+    }
+}
+/*  End of GenerateDiskFaces.                                                 */
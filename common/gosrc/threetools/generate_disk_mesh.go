@@ -0,0 +1,131 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a mesh over a disk from a function in polar coordinates.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi, Min, and Abs are provided here.                                       */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateDiskMesh                                                      *
+ *  Purpose:                                                                  *
+ *      Creates a mesh of concentric rings for a surface over a disk, z =     *
+ *      f(r, theta), avoiding the stretched corners of a square grid. The     *
+ *      disk is the largest one inside the domain of the canvas, centered in  *
+ *      it. The center is a single vertex, index zero, followed by the rings  *
+ *      from the inside out, each with sectors evenly spaced vertices. The    *
+ *      line segments are the spokes from the center and the circles of the   *
+ *      rings, and the faces are created by GenerateDiskFaces. The result is  *
+ *      not a grid, like ImportOBJ the canvas is treated as a single row of   *
+ *      vertices and the other per-vertex buffers are cleared.                *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas the mesh is written to.                                *
+ *      f (PolarSurface):                                                     *
+ *          The height of the surface, z = f(r, theta).                       *
+ *      rings (uint32):                                                       *
+ *          The number of rings, not counting the center.                     *
+ *      sectors (uint32):                                                     *
+ *          The number of vertices on each ring, at least 3.                  *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooSmall or ErrGridTooLarge if nothing was written.        *
+ ******************************************************************************/
+func GenerateDiskMesh(canvas *Canvas,
+                      f PolarSurface, rings, sectors uint32) error {
+
+    /*  Variables for indexing over the rings and sectors.                    */
+    var ring, sector uint32
+
+    /*  Variable for indexing over the line segments.                         */
+    var index int = 0
+
+    /*  Sizes computed in 64 bits so that large inputs can not overflow.      */
+    var numberOfPoints uint64 = 1 + uint64(rings) * uint64(sectors)
+    var indexSize uint64 = 4 * uint64(rings) * uint64(sectors)
+
+    /*  The center and radius of the disk inscribed in the domain.            */
+    var xCenter float32 = canvas.HorizontalStart + 0.5 * canvas.Width
+    var yCenter float32 = canvas.VerticalStart + 0.5 * canvas.Height
+    var radius float32 = 0.5 * float32(
+        math.Min(math.Abs(float64(canvas.Width)),
+                 math.Abs(float64(canvas.Height))),
+    )
+
+    if (rings == 0) || (sectors < 3) {
+        return ErrGridTooSmall
+    }
+
+    /*  Grow the storage if allowed, a disk that still does not fit is        *
+     *  rejected below.                                                       */
+    canvas.ReserveStorage(3 * numberOfPoints, indexSize)
+
+    if (3 * numberOfPoints > uint64(len(canvas.MeshStorage))) ||
+       (indexSize > uint64(len(canvas.IndexStorage))) {
+        return ErrGridTooLarge
+    }
+
+    canvas.NumberOfPoints = int(numberOfPoints)
+    canvas.MeshSize = 3 * canvas.NumberOfPoints
+    canvas.IndexSize = int(indexSize)
+    canvas.NxPts = uint32(canvas.NumberOfPoints)
+    canvas.NyPts = 1
+    canvas.Mesh = canvas.MeshStorage[0:canvas.MeshSize]
+    canvas.Indices = canvas.IndexStorage[0:canvas.IndexSize]
+    canvas.clearDerivedBuffers()
+
+    /*  The center is shared by every sector, the angle does not matter.      */
+    canvas.Mesh[0] = xCenter
+    canvas.Mesh[1] = yCenter
+    canvas.Mesh[2] = f(0.0, 0.0)
+
+    for ring = 1; ring <= rings; ring++ {
+        var r float32 = radius * float32(ring) / float32(rings)
+
+        for sector = 0; sector < sectors; sector++ {
+            var theta float32 = 2.0 * math.Pi * float32(sector) /
+                                float32(sectors)
+            var direction UnitVector = UnitVectorFromAngle(theta)
+            var vertex int = 3 * int(diskVertex(ring, sector, sectors))
+
+            canvas.Mesh[vertex] = xCenter + r * direction.AngleCos
+            canvas.Mesh[vertex + 1] = yCenter + r * direction.AngleSin
+            canvas.Mesh[vertex + 2] = f(r, theta)
+
+            /*  The spoke from the previous ring, or the center, to here.     */
+            canvas.Indices[index] = diskVertex(ring - 1, sector, sectors)
+            canvas.Indices[index + 1] = diskVertex(ring, sector, sectors)
+
+            /*  The arc of the ring to the next sector.                       */
+            canvas.Indices[index + 2] = diskVertex(ring, sector, sectors)
+            canvas.Indices[index + 3] = diskVertex(ring, sector + 1, sectors)
+            index += 4
+        }
+    }
+
+    canvas.GenerateDiskFaces(rings, sectors)
+    return nil
+}
+/*  End of GenerateDiskMesh.                                                  */
@@ -0,0 +1,105 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the radial disk mesh.                                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The bowl z = r^2, the paraboloid written in polar coordinates.            */
+func polarParaboloid(r, theta float32) float32 {
+    return r*r
+}
+/*  End of polarParaboloid.                                                   */
+
+/*  The center is a single vertex, in the middle of the domain, and it is     *
+ *  shared by every triangle of the innermost ring, one per sector, each      *
+ *  counterclockwise so the fan points up.                                    */
+func TestDiskCenterSharedByInnerRing(t *testing.T) {
+    const rings, sectors uint32 = 4, 8
+    var canvas *Canvas = newTestCanvas(t, WithDomain(2.0, 2.0, -1.0, -1.0))
+
+    err := GenerateDiskMesh(canvas, polarParaboloid, rings, sectors)
+
+    if err != nil {
+        t.Fatalf("GenerateDiskMesh: %v", err)
+    }
+
+    var center [3]float32 = canvas.point(0)
+
+    if center != [3]float32{0.0, 0.0, 0.0} {
+        t.Fatalf("center vertex is %v, want the origin", center)
+    }
+
+    /*  No other vertex sits at the center, it is not duplicated per sector.  */
+    for index := 1; index < canvas.NumberOfPoints; index++ {
+        if canvas.point(index) == center {
+            t.Errorf("vertex %d duplicates the center", index)
+        }
+    }
+
+    /*  The number of inner-ring triangles, and those using the center.       */
+    var inner, fanned int = 0, 0
+    var lastInner uint32 = diskVertex(1, sectors - 1, sectors)
+
+    for face := 0; 3 * face < len(canvas.Faces); face++ {
+        var triangle []uint32 = canvas.Faces[3 * face : 3 * face + 3]
+        var usesCenter, beyond bool = false, false
+
+        for _, vertex := range triangle {
+            usesCenter = usesCenter || (vertex == 0)
+            beyond = beyond || (vertex > lastInner)
+        }
+
+        if beyond {
+            continue
+        }
+
+        inner++
+
+        if !usesCenter {
+            t.Errorf("inner triangle %d, %v, does not use the center",
+                     face, triangle)
+            continue
+        }
+
+        fanned++
+
+        var p0 [3]float32 = canvas.point(int(triangle[0]))
+        var p1 [3]float32 = canvas.point(int(triangle[1]))
+        var p2 [3]float32 = canvas.point(int(triangle[2]))
+        var normal [3]float32 = crossProduct(
+            vectorDifference(p1, p0), vectorDifference(p2, p0),
+        )
+
+        if normal[2] <= 0.0 {
+            t.Errorf("inner triangle %d, %v, points down", face, triangle)
+        }
+    }
+
+    if (inner != int(sectors)) || (fanned != int(sectors)) {
+        t.Errorf("%d inner triangles, %d using the center, want %d of each",
+                 inner, fanned, sectors)
+    }
+}
+/*  End of TestDiskCenterSharedByInnerRing.                                   */
@@ -0,0 +1,95 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes texture coordinates for disk meshes.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library packages for Pi and formatting the error messages.       */
+import (
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateDiskUVs                                                       *
+ *  Purpose:                                                                  *
+ *      Computes the texture coordinates of a disk mesh, see                  *
+ *      GenerateDiskMesh, from the polar coordinates of the vertices rather   *
+ *      than their place in the buffer, which GenerateUVs would treat as a    *
+ *      single row. A vertex on ring k at angle theta, with rho = k / rings,  *
+ *      is given the point (0.5 + 0.5 rho cos(theta), 0.5 + 0.5 rho           *
+ *      sin(theta)) of the square texture. The angle sets the direction from  *
+ *      the center of the texture and the radius the distance, so the center  *
+ *      vertex gets (0.5, 0.5), the rim runs around the inscribed circle of   *
+ *      the texture, and there is no seam where the sectors wrap around.      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the disk mesh.                                    *
+ *      rings (uint32):                                                       *
+ *          The number of rings, not counting the center.                     *
+ *      sectors (uint32):                                                     *
+ *          The number of vertices on each ring.                              *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrSizeMismatch if the mesh is not a disk of this size.           *
+ ******************************************************************************/
+func (self *Canvas) GenerateDiskUVs(rings, sectors uint32) error {
+
+    /*  Variables for indexing over the rings and sectors.                    */
+    var ring, sector uint32
+
+    /*  The center and every ring, checked in 64 bits to avoid overflow.      */
+    var numberOfPoints uint64 = 1 + uint64(rings) * uint64(sectors)
+
+    if (rings == 0) || (numberOfPoints != uint64(self.NumberOfPoints)) {
+        return fmt.Errorf(
+            "%w: %d rings of %d sectors for %d vertices",
+            ErrSizeMismatch, rings, sectors, self.NumberOfPoints,
+        )
+    }
+
+    /*  Each vertex needs two floats, u and v.                                */
+    self.UVs = resizeBuffer(self.UVs, 2 * self.NumberOfPoints)
+
+    /*  The center is shared by every sector, it maps to the center.          */
+    self.UVs[0] = 0.5
+    self.UVs[1] = 0.5
+
+    for ring = 1; ring <= rings; ring++ {
+        var rho float32 = float32(ring) / float32(rings)
+
+        for sector = 0; sector < sectors; sector++ {
+            var theta float32 = 2.0 * math.Pi * float32(sector) /
+                                float32(sectors)
+            var direction UnitVector = UnitVectorFromAngle(theta)
+            var vertex int = 2 * int(diskVertex(ring, sector, sectors))
+
+            self.UVs[vertex] = 0.5 + 0.5 * rho * direction.AngleCos
+            self.UVs[vertex + 1] = 0.5 + 0.5 * rho * direction.AngleSin
+        }
+    }
+
+    return nil
+}
+/*  End of GenerateDiskUVs.                                                   */
@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the texture coordinates of disk meshes.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  The center vertex maps to the center of the texture, and each rim vertex  *
+ *  to the point of the inscribed circle in the direction of the vertex, so   *
+ *  the rim covers the full circle of angles.                                 */
+func TestGenerateDiskUVsCenterAndRim(t *testing.T) {
+    const rings, sectors uint32 = 4, 8
+    var canvas *Canvas = newTestCanvas(t, WithDomain(2.0, 2.0, -1.0, -1.0))
+
+    err := GenerateDiskMesh(canvas, polarParaboloid, rings, sectors)
+
+    if err != nil {
+        t.Fatalf("GenerateDiskMesh: %v", err)
+    }
+
+    if err = canvas.GenerateDiskUVs(rings, sectors); err != nil {
+        t.Fatalf("GenerateDiskUVs: %v", err)
+    }
+
+    if (canvas.UVs[0] != 0.5) || (canvas.UVs[1] != 0.5) {
+        t.Errorf("center maps to (%g, %g), want (0.5, 0.5)",
+                 canvas.UVs[0], canvas.UVs[1])
+    }
+
+    for sector := uint32(0); sector < sectors; sector++ {
+        var vertex int = int(diskVertex(rings, sector, sectors))
+        var p [3]float32 = canvas.point(vertex)
+        var direction [3]float32 = normalizeVector([3]float32{p[0], p[1], 0})
+        var got [3]float32 = [3]float32{
+            canvas.UVs[2 * vertex] - 0.5, canvas.UVs[2 * vertex + 1] - 0.5, 0,
+        }
+
+        expectVector(t, "rim", got, scaleVector(direction, 0.5), 1.0E-6)
+    }
+}
+/*  End of TestGenerateDiskUVsCenterAndRim.                                   */
+
+/*  A ring and sector count that does not match the mesh is rejected.         */
+func TestGenerateDiskUVsSizeMismatch(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t)
+
+    if err := GenerateDiskMesh(canvas, polarParaboloid, 4, 8); err != nil {
+        t.Fatalf("GenerateDiskMesh: %v", err)
+    }
+
+    if err := canvas.GenerateDiskUVs(4, 7); !errors.Is(err, ErrSizeMismatch) {
+        t.Errorf("got %v, want ErrSizeMismatch", err)
+    }
+}
+/*  End of TestGenerateDiskUVsSizeMismatch.                                   */
@@ -0,0 +1,109 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a mesh from an array of heights.                              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateMeshFromData                                                  *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the mesh from precomputed heights, for data  *
+ *      computed in JavaScript or read from a file, rather than from a        *
+ *      parametrization. The heights are given row by row, the height of      *
+ *      column x and row y being heights[y * NxPts + x], regardless of the    *
+ *      layout of the mesh. The x and y coordinates are those of the grid of  *
+ *      the canvas. The heights are copied, and the surface of the canvas is  *
+ *      cleared so that Regenerate does not overwrite the data.               *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation.                                     *
+ *      heights ([]float32):                                                  *
+ *          The heights, NxPts * NyPts of them.                               *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrSizeMismatch, ErrNonFinite, ErrIncompatibleGenerator, or from  *
+ *          ValidateBuffers.                                                  *
+ ******************************************************************************/
+func (self *Canvas) GenerateMeshFromData(heights []float32) error {
+
+    /*  Step sizes in the horizontal and vertical axes.                       */
+    var dx float32 = self.Width / float32(self.NxPts - 1)
+    var dy float32 = self.Height / float32(self.NyPts - 1)
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Set if any of the heights is a NaN or an infinity.                    */
+    var nonFinite bool = false
+
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    if err := self.checkGraphTopology(); err != nil {
+        return err
+    }
+
+    if uint64(len(heights)) != uint64(self.NxPts) * uint64(self.NyPts) {
+        return fmt.Errorf(
+            "%w: %d heights for a %d by %d grid",
+            ErrSizeMismatch, len(heights), self.NxPts, self.NyPts,
+        )
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+
+            /*  The heights follow the coordinates, not the traversal.        */
+            xStep, yStep := self.domainSteps(xIndex, yIndex)
+            var xPt float32 = self.HorizontalStart + float32(xStep) * dx
+            var yPt float32 = self.VerticalStart + float32(yStep) * dy
+            var zPt float32 = heights[yStep * self.NxPts + xStep]
+
+            if !isFinite(zPt) {
+                nonFinite = true
+            }
+
+            var index uint32 = 3 * self.gridIndex(xIndex, yIndex)
+            self.Mesh[index] = xPt
+            self.Mesh[index + 1] = yPt
+            self.Mesh[index + 2] = zPt
+        }
+    }
+
+    self.Surface = nil
+
+    if nonFinite {
+        return ErrNonFinite
+    }
+
+    return nil
+}
+/*  End of GenerateMeshFromData.                                              */
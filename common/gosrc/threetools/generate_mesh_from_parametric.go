@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the locations of the points in the mesh for a parametric     *
+ *      surface.                                                              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateMeshFromParametric                                            *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of a mesh from a parametric surface (x, y, z) = *
+ *      f(u, v). The u parameter runs along the horizontal axis of the grid,  *
+ *      from HorizontalStart to HorizontalStart + Width, and v along the      *
+ *      vertical axis, from VerticalStart to VerticalStart + Height. Unlike   *
+ *      GenerateMeshFromParametrization the surface need not be a graph, so   *
+ *      closed surfaces like spheres and tori can be drawn. Set WrapU or      *
+ *      WrapV to close the seams in u or v.                                   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation. This contains geometry and buffers. *
+ *      f (ParametricSurface):                                                *
+ *          The function that defines the surface.                            *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateMeshFromParametric(f ParametricSurface) {
+
+    /*  Step sizes in the horizontal and vertical axes. Along a closed seam   *
+     *  the first column or row is not repeated at the end, the points are    *
+     *  spread over [start, start + width) instead.                           */
+    var du float32 = self.Width / float32(self.NxPts - 1)
+    var dv float32 = self.Height / float32(self.NyPts - 1)
+    wrapU, wrapV := self.gridWraps()
+
+    if wrapU {
+        du = self.Width / float32(self.NxPts)
+    }
+
+    if wrapV {
+        dv = self.Height / float32(self.NyPts)
+    }
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var uIndex, vIndex uint32
+
+    /*  Avoid writing beyond the bounds of the array that was allocated.      */
+    if (self.NxPts > MaxWidth) || (self.NyPts > MaxHeight) {
+        return
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
+    /*  Same layout as the graphs, index = v * width + u for row-major.       */
+    for vIndex = 0; vIndex < self.NyPts; vIndex++ {
+        for uIndex = 0; uIndex < self.NxPts; uIndex++ {
+            uStep, vStep := self.domainSteps(uIndex, vIndex)
+            var u float32 = self.HorizontalStart + float32(uStep) * du
+            var v float32 = self.VerticalStart + float32(vStep) * dv
+            var point [3]float32 = f(u, v)
+            var index uint32 = 3 * self.gridIndex(uIndex, vIndex)
+
+            self.Mesh[index] = point[0]
+            self.Mesh[index + 1] = point[1]
+            self.Mesh[index + 2] = point[2]
+        }
+    }
+}
+/*  End of GenerateMeshFromParametric.                                        */
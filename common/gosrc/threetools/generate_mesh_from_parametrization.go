@@ -28,16 +28,22 @@ package threetools
  *  Function:                                                                 *
  *      GenerateMeshFromParametrization                                       *
  *  Purpose:                                                                  *
- *      Computes the vertices of a mesh from a parametric equation.           *
+ *      Computes the vertices of a mesh from a parametric equation. Points    *
+ *      where the surface is not finite are still written, and reported by    *
+ *      the returned error once the whole mesh is done.                       *
  *  Arguments:                                                                *
  *      self (*Canvas):                                                       *
  *          The canvas for the animation. This contains geometry and buffers. *
  *      f (SurfaceParametrization):                                           *
  *          The function that defines the surface, z = f(x, y).               *
  *  Output:                                                                   *
- *      None.                                                                 *
+ *      err (error):                                                          *
+ *          The error from ValidateBuffers, ErrIncompatibleGenerator for a    *
+ *          mesh type with closed seams, or ErrNonFinite, on failure.         *
  ******************************************************************************/
-func (self *Canvas) GenerateMeshFromParametrization(f SurfaceParametrization) {
+func (self *Canvas) GenerateMeshFromParametrization(
+    f SurfaceParametrization,
+) error {
 
     /*  Step sizes in the horizontal and vertical axes.                       */
     var dx float32 = self.Width / float32(self.NxPts - 1)
@@ -46,42 +52,58 @@ func (self *Canvas) GenerateMeshFromParametrization(f SurfaceParametrization) {
     /*  Variables for indexing the horizontal and vertical axes.              */
     var xIndex, yIndex uint32
 
-    /*  Variable for indexing over the array being written to.                */
-    var index uint32 = 0
+    /*  Set if any of the heights is a NaN or an infinity.                    */
+    var nonFinite bool = false
 
     /*  Avoid writing beyond the bounds of the array that was allocated.      *
      *  Check if the input sizes are too big.                                 */
-    if (self.NxPts > MaxWidth) || (self.NyPts > MaxHeight) {
-        return
+    if err := self.ValidateBuffers(); err != nil {
+        return err
     }
 
+    /*  A graph has no seams, wrapped surfaces need a parametrization.        */
+    if err := self.checkGraphTopology(); err != nil {
+        return err
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
     /*  Loop over the vertical axis. The surface is of the form z = f(x, y).  *
-     *  Note, since the y index is the outer for-loop, the array is indexed   *
-     *  in row-major fashion. That is, index = y * width + x.                 */
+     *  The array is indexed in row-major fashion, index = y * width + x,     *
+     *  unless the canvas uses the column-major layout, see gridIndex.        */
     for yIndex = 0; yIndex < self.NyPts; yIndex++ {
 
-        /*  Convert pixel index to y coordinate.                              */
-        var yPt float32 = self.VerticalStart + float32(yIndex) * dy
-
         /*  Loop through the horizontal component of the object.              */
         for xIndex = 0; xIndex < self.NxPts; xIndex++ {
 
-            /*  Convert pixel index to x coordinate in the plane.             */
-            var xPt float32 = self.HorizontalStart + float32(xIndex) * dx
+            /*  Convert pixel index to a point in the plane. The steps are    *
+             *  counted from the far edge along flipped axes.                 */
+            xStep, yStep := self.domainSteps(xIndex, yIndex)
+            var xPt float32 = self.HorizontalStart + float32(xStep) * dx
+            var yPt float32 = self.VerticalStart + float32(yStep) * dy
 
             /*  Get the z component using the provided parametrization.       */
             var zPt float32 = f(xPt, yPt)
 
-            /*  Add this point to our vertex array.                           */
+            if !isFinite(zPt) {
+                nonFinite = true
+            }
+
+            /*  Add this point to our vertex array. A point needs 3 floats.   */
+            var index uint32 = 3 * self.gridIndex(xIndex, yIndex)
             self.Mesh[index] = xPt
             self.Mesh[index + 1] = yPt
             self.Mesh[index + 2] = zPt
-
-            /*  Move on to the next point in the mesh. A point needs 3 floats.*/
-            index += 3
         }
         /*  End of horizontal for-loop.                                       */
     }
     /*  End of vertical for-loop.                                             */
+
+    if nonFinite {
+        return ErrNonFinite
+    }
+
+    return nil
 }
 /*  End of GenerateMeshFromParametrization.                                   */
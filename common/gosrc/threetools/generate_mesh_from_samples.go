@@ -0,0 +1,91 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the mesh of a surface at arbitrary sample positions.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateMeshFromSamples                                               *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the surface z = f(x, y) on the grid given by *
+ *      the provided x and y coordinates, instead of uniformly spaced points. *
+ *      This allows, for example, logarithmic spacing near an edge where the  *
+ *      surface changes rapidly. The grid is still rectangular, NxPts and     *
+ *      NyPts are set to the number of samples, and the domain of the canvas  *
+ *      is set to span the first to the last sample. The mesh and index       *
+ *      buffers are resized for the new grid, generate the wireframe          *
+ *      afterwards as usual. Nothing is done if there are too many samples    *
+ *      for the buffers.                                                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation.                                     *
+ *      f (SurfaceParametrization):                                           *
+ *          The function that defines the surface, z = f(x, y).               *
+ *      xs ([]float32):                                                       *
+ *          The x coordinates of the columns of the grid.                     *
+ *      ys ([]float32):                                                       *
+ *          The y coordinates of the rows of the grid.                        *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateMeshFromSamples(f SurfaceParametrization,
+                                            xs, ys []float32) {
+
+    /*  Variables for indexing over the samples and the mesh.                 */
+    var xIndex, yIndex, index int
+
+    if (len(xs) == 0) || (len(ys) == 0) ||
+       (len(xs) > int(MaxWidth)) || (len(ys) > int(MaxHeight)) {
+        return
+    }
+
+    if self.reserveGrid(uint32(len(xs)), uint32(len(ys))) != nil {
+        return
+    }
+
+    self.NxPts = uint32(len(xs))
+    self.NyPts = uint32(len(ys))
+    self.HorizontalStart = xs[0]
+    self.Width = xs[len(xs) - 1] - xs[0]
+    self.VerticalStart = ys[0]
+    self.Height = ys[len(ys) - 1] - ys[0]
+
+    if self.Recompute(self.MeshStorage, self.IndexStorage) != nil {
+        return
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
+    /*  Same layout as GenerateMeshFromParametrization, see gridIndex.        */
+    for yIndex = 0; yIndex < len(ys); yIndex++ {
+        for xIndex = 0; xIndex < len(xs); xIndex++ {
+            index = 3 * int(self.gridIndex(uint32(xIndex), uint32(yIndex)))
+            self.Mesh[index] = xs[xIndex]
+            self.Mesh[index + 1] = ys[yIndex]
+            self.Mesh[index + 2] = f(xs[xIndex], ys[yIndex])
+        }
+    }
+}
+/*  End of GenerateMeshFromSamples.                                           */
@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for generating the mesh on a non-uniform grid.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pow is provided by math, used for the logarithmic spacing.                */
+import (
+    "math"
+    "testing"
+)
+
+/*  With logarithmically spaced x samples every vertex is placed at exactly   *
+ *  the supplied coordinates, and the grid and domain follow the samples.     */
+func TestGenerateMeshFromSamplesLogarithmic(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(3, 3))
+    var xs []float32 = make([]float32, 7)
+    var ys []float32 = []float32{-1.0, 0.0, 0.25, 2.0}
+
+    for index := range xs {
+        xs[index] = float32(math.Pow(10.0, float64(index) - 3.0))
+    }
+
+    canvas.GenerateMeshFromSamples(paraboloid, xs, ys)
+
+    if (canvas.NxPts != 7) || (canvas.NyPts != 4) ||
+       (canvas.NumberOfPoints != 28) {
+        t.Fatalf("%dx%d grid with %d points, want 7x4 with 28",
+                 canvas.NxPts, canvas.NyPts, canvas.NumberOfPoints)
+    }
+
+    if (canvas.HorizontalStart != xs[0]) || (canvas.VerticalStart != -1.0) ||
+       (canvas.Width != xs[6] - xs[0]) || (canvas.Height != 3.0) {
+        t.Errorf("domain starts at (%g, %g) with size %g by %g",
+                 canvas.HorizontalStart, canvas.VerticalStart,
+                 canvas.Width, canvas.Height)
+    }
+
+    for yIndex, y := range ys {
+        for xIndex, x := range xs {
+            var index uint32 = canvas.gridIndex(uint32(xIndex), uint32(yIndex))
+            var want [3]float32 = [3]float32{x, y, paraboloid(x, y)}
+            expectVector(t, "vertex", canvas.point(int(index)), want, 0.0)
+        }
+    }
+}
+/*  End of TestGenerateMeshFromSamplesLogarithmic.                            */
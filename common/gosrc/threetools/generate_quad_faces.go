@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates the quadrilateral faces of a rectangular grid.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateQuadFaces                                                     *
+ *  Purpose:                                                                  *
+ *      Stores one quadrilateral per cell of the grid in the quad face        *
+ *      buffer, four vertex indices per face. The corners are listed in the   *
+ *      order index00, index01, index11, index10, which is counterclockwise   *
+ *      when the grid is viewed with x increasing to the right and y          *
+ *      increasing upwards, the same winding as GenerateTriangleFaces. For a  *
+ *      graph z = f(x, y) the faces point up, for reversed domains as well.   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose faces are being generated.                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateQuadFaces() {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Variable for indexing over the array being written to.                */
+    var index int = 0
+
+    /*  The number of cells in the grid. An empty grid has no cells.          */
+    var numberOfCells int = 0
+
+    if (self.NxPts > 1) && (self.NyPts > 1) {
+        numberOfCells = int((self.NxPts - 1) * (self.NyPts - 1))
+    }
+
+    /*  A reversed domain mirrors the grid, swap the winding to undo this.    */
+    var reversed bool = self.domainOrientation() < 0.0
+
+    self.QuadFaces = resizeBuffer(self.QuadFaces, 4 * numberOfCells)
+
+    /*  Loop over the bottom left corners of the cells, row by row.           */
+    for yIndex = 0; yIndex + 1 < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex + 1 < self.NxPts; xIndex++ {
+
+            /*  The four corners of the cell, the naming follows that of      *
+             *  GenerateRectangularWireframe.                                 */
+            var index00 uint32 = self.gridIndex(xIndex, yIndex)
+            var index01 uint32 = self.gridIndex(xIndex + 1, yIndex)
+            var index10 uint32 = self.gridIndex(xIndex, yIndex + 1)
+            var index11 uint32 = self.gridIndex(xIndex + 1, yIndex + 1)
+
+            if reversed {
+                index01, index10 = index10, index01
+            }
+
+            self.QuadFaces[index] = index00
+            self.QuadFaces[index + 1] = index01
+            self.QuadFaces[index + 2] = index11
+            self.QuadFaces[index + 3] = index10
+
+            index += 4
+        }
+    }
+}
+/*  End of GenerateQuadFaces.                                                 */
@@ -0,0 +1,104 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the quadrilateral faces of a grid.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Returns the z component of the normal of a quad, the cross product of its *
+ *  diagonals. This is positive if the quad is counterclockwise when seen     *
+ *  from above.                                                               */
+func quadNormalZ(canvas *Canvas, quad []uint32) float32 {
+    var p0 [3]float32 = canvas.point(int(quad[0]))
+    var p1 [3]float32 = canvas.point(int(quad[1]))
+    var p2 [3]float32 = canvas.point(int(quad[2]))
+    var p3 [3]float32 = canvas.point(int(quad[3]))
+
+    var diagonal [3]float32 = vectorDifference(p2, p0)
+    var other [3]float32 = vectorDifference(p3, p1)
+    return crossProduct(diagonal, other)[2]
+}
+/*  End of quadNormalZ.                                                       */
+
+/*  Every cell, in row order, gets exactly one quad made of its four corners, *
+ *  wound counterclockwise so the faces of the graph point up. This holds for *
+ *  a reversed domain and a column-major layout as well.                      */
+func TestQuadFacesOnePerCell(t *testing.T) {
+    var cases = []struct {
+        name string
+        options []CanvasOption
+    }{
+        {"default", []CanvasOption{WithGrid(5, 4)}},
+        {
+            "reversed domain",
+            []CanvasOption{WithGrid(5, 4), WithDomain(-2.0, 2.0, 1.0, -1.0)},
+        },
+        {"column-major", []CanvasOption{WithGrid(5, 4), WithColumnMajor(true)}},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newGraphCanvas(t, paraboloid, c.options...)
+        canvas.GenerateQuadFaces()
+
+        var cells int = int((canvas.NxPts - 1) * (canvas.NyPts - 1))
+
+        if canvas.QuadFaceCount() != cells {
+            t.Errorf("%s: %d quads, want one per cell, %d",
+                     c.name, canvas.QuadFaceCount(), cells)
+            continue
+        }
+
+        var face int = 0
+
+        for yIndex := uint32(0); yIndex + 1 < canvas.NyPts; yIndex++ {
+            for xIndex := uint32(0); xIndex + 1 < canvas.NxPts; xIndex++ {
+                var quad []uint32 = canvas.QuadFaces[4 * face : 4 * face + 4]
+                var corners map[uint32]bool = map[uint32]bool{
+                    canvas.gridIndex(xIndex, yIndex): true,
+                    canvas.gridIndex(xIndex + 1, yIndex): true,
+                    canvas.gridIndex(xIndex, yIndex + 1): true,
+                    canvas.gridIndex(xIndex + 1, yIndex + 1): true,
+                }
+
+                for _, vertex := range quad {
+                    if !corners[vertex] {
+                        t.Errorf("%s: quad %d is %v, not the corners of " +
+                                 "cell (%d, %d)", c.name, face, quad,
+                                 xIndex, yIndex)
+                    }
+
+                    delete(corners, vertex)
+                }
+
+                if quadNormalZ(canvas, quad) <= 0.0 {
+                    t.Errorf("%s: quad %d, %v, is wound clockwise",
+                             c.name, face, quad)
+                }
+
+                face++
+            }
+        }
+    }
+}
+/*  End of TestQuadFacesOnePerCell.                                           */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the directions of the lines drawn by the rectangular        *
+ *      wireframe.                                                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Each wireframe direction draws only its own kind of segment on a 4x3      *
+ *  grid: nine horizontal segments, eight vertical ones, or six diagonals,    *
+ *  and both directions draw the seventeen horizontal and vertical ones.      */
+func TestWireframeDirections(t *testing.T) {
+    var cases = []struct {
+        name string
+        direction uint
+        horizontal, vertical, diagonal int
+    }{
+        {"both", BothDirections, 9, 8, 0},
+        {"horizontal", HorizontalDirection, 9, 0, 0},
+        {"vertical", VerticalDirection, 0, 8, 0},
+        {"diagonal", DiagonalDirection, 0, 0, 6},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(4, 3), WithWireframeDirection(c.direction),
+        )
+
+        var horizontal, vertical, diagonal int
+
+        for _, segment := range wireframeSegments(canvas) {
+            switch {
+                case (segment.y0 == segment.y1) &&
+                     (segment.x1 == segment.x0 + 1):
+                    horizontal++
+                case (segment.x0 == segment.x1) &&
+                     (segment.y1 == segment.y0 + 1):
+                    vertical++
+                case (segment.x1 == segment.x0 + 1) &&
+                     (segment.y1 == segment.y0 + 1):
+                    diagonal++
+                default:
+                    t.Errorf("%s: unexpected segment %+v", c.name, segment)
+            }
+        }
+
+        if (horizontal != c.horizontal) || (vertical != c.vertical) ||
+           (diagonal != c.diagonal) {
+            t.Errorf("%s: %d horizontal, %d vertical, %d diagonal, " +
+                     "want %d, %d, %d", c.name, horizontal, vertical,
+                     diagonal, c.horizontal, c.vertical, c.diagonal)
+        }
+    }
+}
+/*  End of TestWireframeDirections.                                           */
@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a flat grid on the plane z = 0 for scale.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateReferenceGrid                                                 *
+ *  Purpose:                                                                  *
+ *      Creates a flat grid on the plane z = 0 spanning the domain of the     *
+ *      canvas, cut into the given number of cells along each axis, so a      *
+ *      front-end may draw it faintly beneath the surface for scale. The grid *
+ *      has divisions + 1 lines along each axis, every line a single segment  *
+ *      from one side of the domain to the other. Its vertices are stored in  *
+ *      ReferenceMesh and its segments, with indices into ReferenceMesh, in   *
+ *      ReferenceIndices, so the surface mesh and index buffer are not        *
+ *      changed and both may be shown together.                               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose domain the grid covers.                          *
+ *      divisions (uint32):                                                   *
+ *          The number of cells along each axis. Zero is treated as one.      *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func GenerateReferenceGrid(canvas *Canvas, divisions uint32) {
+
+    /*  Variable for indexing over the lines along each axis.                 */
+    var line uint32
+
+    /*  The corners of the domain.                                            */
+    var xStart float32 = canvas.HorizontalStart
+    var yStart float32 = canvas.VerticalStart
+    var xEnd float32 = xStart + canvas.Width
+    var yEnd float32 = yStart + canvas.Height
+
+    if divisions == 0 {
+        divisions = 1
+    }
+
+    /*  Lines of constant y and lines of constant x, two vertices each.       */
+    var numberOfLines int = 2 * int(divisions + 1)
+
+    canvas.ReferenceMesh = resizeBuffer(canvas.ReferenceMesh, 6 * numberOfLines)
+    canvas.ReferenceIndices =
+        resizeBuffer(canvas.ReferenceIndices, 2 * numberOfLines)
+
+    for line = 0; line <= divisions; line++ {
+        var t float32 = float32(line) / float32(divisions)
+        var x float32 = xStart + t * canvas.Width
+        var y float32 = yStart + t * canvas.Height
+
+        /*  The line of constant y, followed by the line of constant x.       */
+        var row int = 12 * int(line)
+
+        copy(canvas.ReferenceMesh[row:row + 12], []float32{
+            xStart, y, 0.0, xEnd, y, 0.0,
+            x, yStart, 0.0, x, yEnd, 0.0,
+        })
+    }
+
+    /*  The vertices of each line are stored next to each other.              */
+    for index := range canvas.ReferenceIndices {
+        canvas.ReferenceIndices[index] = uint32(index)
+    }
+}
+/*  End of GenerateReferenceGrid.                                             */
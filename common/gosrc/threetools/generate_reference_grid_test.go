@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the flat reference grid drawn beneath the surface.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  With n divisions there are n + 1 lines along each axis, each a single     *
+ *  segment on the plane z = 0 spanning the domain. The surface mesh and its  *
+ *  wireframe are left alone.                                                 */
+func TestReferenceGridLineCount(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 4), WithDomain(3.0, 2.0, -1.0, 0.5),
+    )
+    var indexSize int = canvas.IndexSize
+
+    for _, divisions := range []uint32{1, 4, 7} {
+        GenerateReferenceGrid(canvas, divisions)
+
+        var lines int = 2 * int(divisions + 1)
+
+        if (len(canvas.ReferenceIndices) != 2 * lines) ||
+           (len(canvas.ReferenceMesh) != 6 * lines) {
+            t.Errorf("%d divisions: %d indices and %d floats, want %d lines",
+                     divisions, len(canvas.ReferenceIndices),
+                     len(canvas.ReferenceMesh), lines)
+            continue
+        }
+
+        /*  The number of lines of constant x and of constant y.              */
+        var vertical, horizontal int = 0, 0
+
+        for line := 0; line < lines; line++ {
+            var a int = 3 * int(canvas.ReferenceIndices[2 * line])
+            var b int = 3 * int(canvas.ReferenceIndices[2 * line + 1])
+            var p [3]float32
+            var q [3]float32
+
+            copy(p[:], canvas.ReferenceMesh[a : a + 3])
+            copy(q[:], canvas.ReferenceMesh[b : b + 3])
+
+            if (p[2] != 0.0) || (q[2] != 0.0) {
+                t.Errorf("%d divisions: line %d is off the plane z = 0",
+                         divisions, line)
+            }
+
+            switch {
+                case (p[1] == q[1]) && (q[0] - p[0] == canvas.Width):
+                    horizontal++
+                case (p[0] == q[0]) && (q[1] - p[1] == canvas.Height):
+                    vertical++
+                default:
+                    t.Errorf("%d divisions: line %d from %v to %v does not " +
+                             "span the domain", divisions, line, p, q)
+            }
+        }
+
+        if (horizontal != int(divisions + 1)) ||
+           (vertical != int(divisions + 1)) {
+            t.Errorf("%d divisions: %d horizontal and %d vertical lines",
+                     divisions, horizontal, vertical)
+        }
+    }
+
+    if canvas.IndexSize != indexSize {
+        t.Errorf("IndexSize changed from %d to %d", indexSize, canvas.IndexSize)
+    }
+}
+/*  End of TestReferenceGridLineCount.                                        */
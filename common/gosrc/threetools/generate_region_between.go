@@ -0,0 +1,221 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a closed solid between two surfaces.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateRegionBetween                                                 *
+ *  Purpose:                                                                  *
+ *      Creates the closed solid between the graphs z = g(x, y) below and z = *
+ *      f(x, y) above over the domain of the canvas, for showing the volume   *
+ *      between two surfaces. The vertices of the top grid come first, row by *
+ *      row, then those of the bottom grid. The faces are the triangles of    *
+ *      both grids and of the four side walls joining their edges, wound      *
+ *      counterclockwise from outside the solid when f is above g, for a      *
+ *      transparent cap over the region. The line segments are the square     *
+ *      wireframes of both grids and the four vertical edges at the corners.  *
+ *      Like ImportOBJ, the result is not a grid, the canvas is treated as a  *
+ *      single row of vertices and the per-vertex buffers are cleared. The    *
+ *      storage grows if needed.                                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation, with the grid set.                  *
+ *      f (SurfaceParametrization):                                           *
+ *          The top of the solid.                                             *
+ *      g (SurfaceParametrization):                                           *
+ *          The bottom of the solid.                                          *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooSmall, or ErrGridTooLarge if the grid is beyond         *
+ *          MaxWidth or MaxHeight or the solid does not fit. The canvas is    *
+ *          left unchanged on failure.                                        *
+ ******************************************************************************/
+func (self *Canvas) GenerateRegionBetween(f, g SurfaceParametrization) error {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  The grid dimensions, and the number of points in each grid.           */
+    var width, height uint32 = self.NxPts, self.NyPts
+    var points uint32 = width * height
+
+    if (width < 2) || (height < 2) {
+        return ErrGridTooSmall
+    }
+
+    /*  Check the grid before anything is written, a larger grid could also   *
+     *  overflow the number of points.                                        */
+    if (width > MaxWidth) || (height > MaxHeight) {
+        return ErrGridTooLarge
+    }
+
+    /*  Step sizes in the horizontal and vertical axes.                       */
+    var dx float32 = self.Width / float32(width - 1)
+    var dy float32 = self.Height / float32(height - 1)
+
+    /*  The segments of one grid, and the vertical edges at the corners.      */
+    var segments uint32 = width * (height - 1) + height * (width - 1)
+    var indexSize uint64 = 2 * (2 * uint64(segments) + 4)
+
+    err := self.ReserveStorage(6 * uint64(points), indexSize)
+
+    if err != nil {
+        return err
+    }
+
+    /*  The vertices of the top and bottom grids.                             */
+    var top = func(x, y uint32) uint32 {
+        return y * width + x
+    }
+
+    var bottom = func(x, y uint32) uint32 {
+        return points + y * width + x
+    }
+
+    self.NumberOfPoints = 2 * int(points)
+    self.MeshSize = 3 * self.NumberOfPoints
+    self.IndexSize = int(indexSize)
+    self.Mesh = self.MeshStorage[0:self.MeshSize]
+    self.Indices = self.IndexStorage[0:self.IndexSize]
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        var yPt float32 = self.VerticalStart + float32(yIndex) * dy
+
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var xPt float32 = self.HorizontalStart + float32(xIndex) * dx
+            var upper uint32 = 3 * top(xIndex, yIndex)
+            var lower uint32 = 3 * bottom(xIndex, yIndex)
+
+            self.Mesh[upper] = xPt
+            self.Mesh[upper + 1] = yPt
+            self.Mesh[upper + 2] = f(xPt, yPt)
+            self.Mesh[lower] = xPt
+            self.Mesh[lower + 1] = yPt
+            self.Mesh[lower + 2] = g(xPt, yPt)
+        }
+    }
+
+    /*  The line segments, both grids and then the corner edges.              */
+    var index int = 0
+
+    var addSegment = func(start, end uint32) {
+        self.Indices[index] = start
+        self.Indices[index + 1] = end
+        index += 2
+    }
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            if xIndex + 1 < width {
+                addSegment(top(xIndex, yIndex), top(xIndex + 1, yIndex))
+                addSegment(bottom(xIndex, yIndex), bottom(xIndex + 1, yIndex))
+            }
+
+            if yIndex + 1 < height {
+                addSegment(top(xIndex, yIndex), top(xIndex, yIndex + 1))
+                addSegment(bottom(xIndex, yIndex), bottom(xIndex, yIndex + 1))
+            }
+        }
+    }
+
+    for _, corner := range [4][2]uint32{
+        {0, 0}, {width - 1, 0}, {0, height - 1}, {width - 1, height - 1},
+    } {
+        addSegment(top(corner[0], corner[1]), bottom(corner[0], corner[1]))
+    }
+
+    /*  The result is not a grid, treat it as a single row of vertices.       */
+    self.NxPts = uint32(self.NumberOfPoints)
+    self.NyPts = 1
+    self.clearDerivedBuffers()
+
+    /*  Two triangles for each cell of the two grids, and for each segment of *
+     *  the boundary on the walls.                                            */
+    var cells uint32 = (width - 1) * (height - 1)
+    var boundary uint32 = 2 * (width - 1) + 2 * (height - 1)
+    self.Faces = resizeBuffer(self.Faces, int(6 * (2 * cells + boundary)))
+
+    /*  A reversed domain mirrors the grid, swap the winding to undo this.    */
+    var reversed bool = self.domainOrientation() < 0.0
+    var face int = 0
+
+    var addTriangle = func(a, b, c uint32) {
+        if reversed {
+            b, c = c, b
+        }
+
+        self.Faces[face] = a
+        self.Faces[face + 1] = b
+        self.Faces[face + 2] = c
+        face += 3
+    }
+
+    /*  The top faces point up and the bottom faces down, as seen from above  *
+     *  the top ones are counterclockwise and the bottom ones clockwise.      */
+    for yIndex = 0; yIndex + 1 < height; yIndex++ {
+        for xIndex = 0; xIndex + 1 < width; xIndex++ {
+            var x, y uint32 = xIndex, yIndex
+
+            addTriangle(top(x, y), top(x + 1, y), top(x + 1, y + 1))
+            addTriangle(top(x, y), top(x + 1, y + 1), top(x, y + 1))
+            addTriangle(bottom(x, y), bottom(x + 1, y + 1), bottom(x + 1, y))
+            addTriangle(bottom(x, y), bottom(x, y + 1), bottom(x + 1, y + 1))
+        }
+    }
+
+    /*  The boundary of the grid, counterclockwise as seen from above, so the *
+     *  outside of the solid is to the right of each step.                    */
+    var loop [][2]uint32 = make([][2]uint32, 0, boundary + 1)
+
+    for xIndex = 0; xIndex + 1 < width; xIndex++ {
+        loop = append(loop, [2]uint32{xIndex, 0})
+    }
+
+    for yIndex = 0; yIndex + 1 < height; yIndex++ {
+        loop = append(loop, [2]uint32{width - 1, yIndex})
+    }
+
+    for xIndex = width - 1; xIndex > 0; xIndex-- {
+        loop = append(loop, [2]uint32{xIndex, height - 1})
+    }
+
+    for yIndex = height - 1; yIndex > 0; yIndex-- {
+        loop = append(loop, [2]uint32{0, yIndex})
+    }
+
+    loop = append(loop, loop[0])
+
+    /*  Each step of the loop is a quad on the wall, counterclockwise as seen *
+     *  from outside.                                                         */
+    for step := 0; step + 1 < len(loop); step++ {
+        var p, q [2]uint32 = loop[step], loop[step + 1]
+
+        addTriangle(bottom(p[0], p[1]), bottom(q[0], q[1]), top(q[0], q[1]))
+        addTriangle(bottom(p[0], p[1]), top(q[0], q[1]), top(p[0], p[1]))
+    }
+
+    return nil
+}
+/*  End of GenerateRegionBetween.                                             */
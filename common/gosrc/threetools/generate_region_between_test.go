@@ -0,0 +1,108 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the closed solid between two surfaces.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+/*  The solid between f = 1 and g = 0 over the unit square is the unit cube.  *
+ *  A 3x3 grid gives 8 triangles on the top and on the bottom and 16 on the   *
+ *  walls. Every edge is shared by two triangles traversing it in opposite    *
+ *  directions, so the surface is closed and consistently wound, and the      *
+ *  signed volume is 1, so the faces point outwards.                          */
+func TestGenerateRegionBetweenUnitCube(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(3, 3), WithDomain(1.0, 1.0, 0.0, 0.0),
+    )
+
+    if err := canvas.GenerateRegionBetween(constantOne, Plane); err != nil {
+        t.Fatalf("GenerateRegionBetween: %v", err)
+    }
+
+    if canvas.FaceCount() != 32 {
+        t.Fatalf("%d triangles, want 32", canvas.FaceCount())
+    }
+
+    /*  The number of times each directed edge is traversed.                  */
+    var edges map[[2]uint32]int = map[[2]uint32]int{}
+
+    /*  Six times the signed volume, by the divergence theorem.               */
+    var volume float32 = 0.0
+
+    for face := 0; face < len(canvas.Faces); face += 3 {
+        var corners []uint32 = canvas.Faces[face:face + 3]
+
+        for side := 0; side < 3; side++ {
+            edges[[2]uint32{corners[side], corners[(side + 1) % 3]}]++
+        }
+
+        var a [3]float32 = canvas.point(int(corners[0]))
+        var b [3]float32 = canvas.point(int(corners[1]))
+        var c [3]float32 = canvas.point(int(corners[2]))
+        volume += dotProduct(a, crossProduct(b, c))
+    }
+
+    for edge, count := range edges {
+        var opposite [2]uint32 = [2]uint32{edge[1], edge[0]}
+
+        if (count != 1) || (edges[opposite] != 1) {
+            t.Fatalf("edge %v used %d times, reversed %d times",
+                     edge, count, edges[opposite])
+        }
+    }
+
+    if !closeTo(volume / 6.0, 1.0, 1.0E-5) {
+        t.Errorf("signed volume %g, want 1", volume / 6.0)
+    }
+}
+/*  End of TestGenerateRegionBetweenUnitCube.                                 */
+
+/*  A grid beyond MaxWidth is refused before the canvas is touched, including *
+ *  one so large that the number of points overflows.                         */
+func TestGenerateRegionBetweenTooLarge(t *testing.T) {
+    for _, size := range [][2]uint32{{MaxWidth + 1, 2}, {1 << 16, 1 << 16}} {
+        var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+        var original *Canvas = canvas.Clone()
+
+        canvas.NxPts, canvas.NyPts = size[0], size[1]
+        err := canvas.GenerateRegionBetween(constantOne, Plane)
+
+        if !errors.Is(err, ErrGridTooLarge) {
+            t.Errorf("%dx%d grid: got %v, want ErrGridTooLarge",
+                     size[0], size[1], err)
+        }
+
+        if (canvas.NumberOfPoints != original.NumberOfPoints) ||
+           !reflect.DeepEqual(canvas.Mesh, original.Mesh) ||
+           !reflect.DeepEqual(canvas.Indices, original.Indices) ||
+           !reflect.DeepEqual(canvas.Faces, original.Faces) {
+            t.Errorf("%dx%d grid: the canvas was changed", size[0], size[1])
+        }
+    }
+}
+/*  End of TestGenerateRegionBetweenTooLarge.                                 */
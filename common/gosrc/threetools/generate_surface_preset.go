@@ -0,0 +1,99 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the mesh and wireframe of a registered surface.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateSurfacePreset                                                 *
+ *  Purpose:                                                                  *
+ *      Looks up a surface in the registry, sets the domain and mesh type of  *
+ *      the canvas to its defaults, and computes the mesh and wireframe. The  *
+ *      number of points in the grid, and the wireframe options, are kept. A  *
+ *      family is drawn with the coefficients of the canvas, which are reset  *
+ *      when a different preset is drawn. Graphs are stored in the canvas so  *
+ *      that they may be regenerated. Time dependent surfaces are drawn at    *
+ *      t = 0, see AnimateSurface.                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation, with the grid size already set.     *
+ *      name (string):                                                        *
+ *          The name of the registered surface.                               *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrUnknownSurface if no surface has this name, or the error from  *
+ *          ReserveStorage, and the canvas is then unchanged. Otherwise the   *
+ *          error from generating the mesh or the wireframe, if any.          *
+ ******************************************************************************/
+func (self *Canvas) GenerateSurfacePreset(name string) error {
+    preset, found := SurfacePresets[name]
+
+    if !found {
+        return fmt.Errorf("%w: %q", ErrUnknownSurface, name)
+    }
+
+    /*  The grid is unchanged, but the storage may never have been grown.     */
+    if err := self.reserveGrid(self.NxPts, self.NyPts); err != nil {
+        return err
+    }
+
+    self.HorizontalStart = preset.HorizontalStart
+    self.Width = preset.Width
+    self.VerticalStart = preset.VerticalStart
+    self.Height = preset.Height
+    self.MeshType = preset.MeshType
+
+    /*  Coefficients belong to a family, start over for a new preset.         */
+    if self.Preset != name {
+        self.Coefficients = nil
+        self.Preset = name
+    }
+
+    /*  The mesh type changes the size of the index buffer.                   */
+    if err := self.Recompute(self.MeshStorage, self.IndexStorage); err != nil {
+        return err
+    }
+
+    /*  Parametric surfaces are not stored, there is nothing to regenerate.   */
+    if preset.Parametric != nil {
+        self.Surface = nil
+        self.GenerateMeshFromParametric(preset.Parametric)
+        return self.GenerateRectangularWireframe()
+    }
+
+    if f := self.presetTimeSurface(preset); f != nil {
+        self.Surface = surfaceAtTime(f, 0.0)
+    } else if preset.Family != nil {
+        self.Surface = preset.Family(self.presetCoefficients(preset))
+    } else {
+        self.Surface = preset.Graph
+    }
+
+    /*  The graph is drawn from the stored surface, as Regenerate does.       */
+    return self.Regenerate()
+}
+/*  End of GenerateSurfacePreset.                                             */
@@ -0,0 +1,62 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for drawing the registered surfaces.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  An unknown name is reported, and leaves the canvas unchanged.             */
+func TestGenerateSurfacePresetUnknown(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    var before [3]float32 = canvas.point(7)
+
+    err := canvas.GenerateSurfacePreset("no_such_surface")
+
+    if !errors.Is(err, ErrUnknownSurface) {
+        t.Fatalf("GenerateSurfacePreset = %v, want ErrUnknownSurface", err)
+    }
+
+    expectVector(t, "vertex", canvas.point(7), before, 0.0)
+}
+/*  End of TestGenerateSurfacePresetUnknown.                                  */
+
+/*  Every registered surface can be drawn on a small grid.                    */
+func TestGenerateSurfacePresetAll(t *testing.T) {
+    for name := range SurfacePresets {
+        var canvas *Canvas = newTestCanvas(t, WithGrid(9, 9))
+
+        if err := canvas.GenerateSurfacePreset(name); err != nil {
+            t.Errorf("GenerateSurfacePreset(%q) = %v", name, err)
+        }
+
+        if canvas.IndexSize == 0 {
+            t.Errorf("GenerateSurfacePreset(%q) drew no wireframe", name)
+        }
+    }
+}
+/*  End of TestGenerateSurfacePresetAll.                                      */
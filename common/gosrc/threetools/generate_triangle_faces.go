@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Generates the triangle faces for a rectangular grid.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateTriangleFaces                                                 *
+ *  Purpose:                                                                  *
+ *      Splits each cell of the grid into two triangles and stores their      *
+ *      vertex indices in the face buffer. The triangles are wound            *
+ *      counterclockwise when the grid is viewed with x increasing to the     *
+ *      right and y increasing upwards, meaning for a graph z = f(x, y) the   *
+ *      faces point up. If the domain is reversed, with a negative width or   *
+ *      height, the winding is reversed too so the faces still point up.      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose faces are being generated.                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateTriangleFaces() {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Variable for indexing over the array being written to.                */
+    var index int = 0
+
+    /*  The number of cells along each axis, including those across the       *
+     *  closed seams, see WrapU and WrapV. An empty grid has no cells.        */
+    cellsX, cellsY := self.gridCells()
+
+    /*  A reversed domain mirrors the grid, swap the winding to undo this.    */
+    var reversed bool = self.domainOrientation() < 0.0
+
+    /*  Two triangles per cell, three indices per triangle.                   */
+    self.Faces = resizeBuffer(self.Faces, int(6 * cellsX * cellsY))
+
+    /*  Loop over the bottom left corners of the cells, row by row.           */
+    for yIndex = 0; yIndex < cellsY; yIndex++ {
+        for xIndex = 0; xIndex < cellsX; xIndex++ {
+
+            /*  The four corners of the cell, the naming follows that of      *
+             *  GenerateRectangularWireframe.                                 */
+            var index00 uint32 = self.gridIndex(xIndex, yIndex)
+            index01, index10, index11 := self.gridNeighbors(xIndex, yIndex)
+
+            if reversed {
+                index01, index10 = index10, index01
+            }
+
+            /*  The lower right triangle, counterclockwise.                   */
+            self.Faces[index] = index00
+            self.Faces[index + 1] = index01
+            self.Faces[index + 2] = index11
+
+            /*  The upper left triangle, counterclockwise.                    */
+            self.Faces[index + 3] = index00
+            self.Faces[index + 4] = index11
+            self.Faces[index + 5] = index10
+
+            index += 6
+        }
+    }
+}
+/*  End of GenerateTriangleFaces.                                             */
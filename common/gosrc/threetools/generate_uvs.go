@@ -0,0 +1,85 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes texture coordinates for the vertices of a canvas.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateUVs                                                           *
+ *  Purpose:                                                                  *
+ *      Computes the texture coordinates for each vertex in the grid. The u   *
+ *      coordinate runs from 0 to 1 along the horizontal axis, and v from 0   *
+ *      to 1 along the vertical axis. For wrapped topologies the last column  *
+ *      (or row) is the seam, and its coordinate is exactly 1 so the texture  *
+ *      closes up.                                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose texture coordinates are being computed.          *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) GenerateUVs() {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Step sizes for the texture coordinates. A single row or column has    *
+     *  nothing to step over, and all of its coordinates are zero.            */
+    var du, dv float32 = 0.0, 0.0
+
+    if self.NxPts > 1 {
+        du = 1.0 / float32(self.NxPts - 1)
+    }
+
+    if self.NyPts > 1 {
+        dv = 1.0 / float32(self.NyPts - 1)
+    }
+
+    /*  Each vertex needs two floats, u and v.                                */
+    self.UVs = resizeBuffer(self.UVs, 2 * self.NumberOfPoints)
+
+    /*  The texture coordinates follow the layout of the mesh, see gridIndex. */
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            var index uint32 = 2 * self.gridIndex(xIndex, yIndex)
+            self.UVs[index] = float32(xIndex) * du
+            self.UVs[index + 1] = float32(yIndex) * dv
+        }
+    }
+
+    /*  The multiplication may be off from 1 by a rounding error at the last  *
+     *  column and row. Set the seams exactly so wrapped textures close up.   */
+    if self.NxPts > 1 {
+        for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+            self.UVs[2 * self.gridIndex(self.NxPts - 1, yIndex)] = 1.0
+        }
+    }
+
+    if self.NyPts > 1 {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            self.UVs[2 * self.gridIndex(xIndex, self.NyPts - 1) + 1] = 1.0
+        }
+    }
+}
+/*  End of GenerateUVs.                                                       */
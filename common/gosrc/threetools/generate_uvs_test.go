@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the texture coordinates of the grid.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On a 3x3 grid the corners get the texture coordinates (0, 0), (1, 0), (0, *
+ *  1), and (1, 1), and the center gets (1/2, 1/2).                           */
+func TestGenerateUVsCorners(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    canvas.GenerateUVs()
+
+    var cases = []struct {
+        xIndex, yIndex uint32
+        u, v float32
+    }{
+        {0, 0, 0.0, 0.0},
+        {2, 0, 1.0, 0.0},
+        {0, 2, 0.0, 1.0},
+        {2, 2, 1.0, 1.0},
+        {1, 1, 0.5, 0.5},
+    }
+
+    if len(canvas.UVs) != 2 * canvas.NumberOfPoints {
+        t.Fatalf("len(UVs) = %d, want %d",
+                 len(canvas.UVs), 2 * canvas.NumberOfPoints)
+    }
+
+    for _, c := range cases {
+        var index uint32 = 2 * canvas.gridIndex(c.xIndex, c.yIndex)
+        var u, v float32 = canvas.UVs[index], canvas.UVs[index + 1]
+
+        if (u != c.u) || (v != c.v) {
+            t.Errorf("UV at (%d, %d) = (%g, %g), want (%g, %g)",
+                     c.xIndex, c.yIndex, u, v, c.u, c.v)
+        }
+    }
+}
+/*  End of TestGenerateUVsCorners.                                            */
@@ -0,0 +1,201 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates the wireframe and the triangle faces in one pass.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns which of the grid lines the wireframe draws, the rows, columns,   *
+ *  and diagonals, from the wireframe direction and the mesh type. The stride *
+ *  applies to the horizontal and vertical lines, every diagonal is drawn in  *
+ *  the diagonal mode.                                                        */
+func (self *Canvas) wireframeLines() (bool, bool, bool) {
+    var direction uint = self.WireframeDirection
+    var drawRows bool = (direction == BothDirections) ||
+                        (direction == HorizontalDirection)
+    var drawColumns bool = (direction == BothDirections) ||
+                           (direction == VerticalDirection)
+    var drawDiagonals bool = (direction == DiagonalDirection) ||
+                             ((direction == BothDirections) &&
+                              isTriangleMeshType(self.MeshType))
+
+    return drawRows, drawColumns, drawDiagonals
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateWireframeAndFaces                                             *
+ *  Purpose:                                                                  *
+ *      Generates the line segments of the wireframe, as described in         *
+ *      GenerateRectangularWireframe, and if requested the triangle faces of  *
+ *      GenerateTriangleFaces, in a single traversal of the grid. This is for *
+ *      figures drawing a shaded solid with a wireframe on top. The segments  *
+ *      go in the index buffer, with IndexSize of them, and the triangles in  *
+ *      the face buffer, with FaceCount of them. The faces are the same as    *
+ *      those of GenerateTriangleFaces, the seams are not closed. If          *
+ *      LineStrips is set the lines are written by GenerateWireframeStrips    *
+ *      and the faces by GenerateTriangleFaces instead.                       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation. This contains geometry and buffers. *
+ *      withFaces (bool):                                                     *
+ *          Whether to generate the faces as well. If false the face buffer   *
+ *          is not changed.                                                   *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from ValidateBuffers if nothing was written, or from    *
+ *          GenerateWireframeStrips.                                          *
+ ******************************************************************************/
+func (self *Canvas) GenerateWireframeAndFaces(withFaces bool) error {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Variable for indexing over the array being written to.                */
+    var index uint32 = 0
+
+    /*  Variable for indexing over the face buffer.                           */
+    var face int = 0
+
+    /*  The number of cells along each axis, including those across the       *
+     *  closed seams, see WrapU and WrapV.                                    */
+    cellsX, cellsY := self.gridCells()
+
+    /*  A reversed domain mirrors the grid, the faces swap their winding to   *
+     *  still point up, as in GenerateTriangleFaces.                          */
+    var reversed bool = self.domainOrientation() < 0.0
+
+    /*  Only every stride-th row and column of the grid is drawn.             */
+    var stride uint32 = self.wireframeStride()
+
+    /*  The wireframe direction selects which of the lines are drawn.         */
+    drawRows, drawColumns, drawDiagonals := self.wireframeLines()
+
+    /*  Avoid writing beyond the bounds of the array that was allocated.      *
+     *  Check if the input sizes are too big or the slices too short.         */
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    /*  The strips are not pairs and are sized differently, see               *
+     *  ComputeIndexSize, so they are written by their own generator.         */
+    if self.LineStrips {
+        if err := self.GenerateWireframeStrips(); err != nil {
+            return err
+        }
+
+        if withFaces {
+            self.GenerateTriangleFaces()
+        }
+
+        return nil
+    }
+
+    /*  Two triangles per cell, three indices per triangle.                   */
+    if withFaces {
+        self.Faces = resizeBuffer(self.Faces, int(6 * cellsX * cellsY))
+    }
+
+    /*  We need to create the lines now. We do this by creating ordered       *
+     *  pairs of the indices for the vertices in the vertex array that we     *
+     *  want to connect. Each point will be connected to its four surrounding *
+     *  neighbors, except for the points on the boundary, which have fewer    *
+     *  neighbors. We handle these boundary points separately.                */
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+
+        /*  The vertical component is now fixed, loop through the horizontal. */
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+
+            /*  The indices are row-major, meaning index = y * width + x,     *
+             *  unless the canvas uses the column-major layout.               */
+            var index00 uint32 = self.gridIndex(xIndex, yIndex)
+
+            /*  The point directly after the current point, in the            *
+             *  horizontal, the point directly above it, in the vertical, and *
+             *  the point above and to the right. Across a closed seam these  *
+             *  wrap around to the first column or row.                       */
+            index01, index10, index11 := self.gridNeighbors(xIndex, yIndex)
+
+            /*  If we are not at the top edge or the right edge of the        *
+             *  rectangle, we may add an "L" shape to our mesh connecting the *
+             *  bottom left point to the bottom right point, and the bottom   *
+             *  left point to the upper left point. At the top of the         *
+             *  rectangle the upper left point goes beyond the bounds of the  *
+             *  parametrization, so we do not need to draw it. Check for this.*/
+            /*  The segment is part of the column for xIndex, which is only   *
+             *  drawn if it falls on the stride.                              */
+            if drawColumns && (yIndex < cellsY) &&
+               isWireframeLine(xIndex, self.NxPts, stride) {
+                self.Indices[index] = index00
+                self.Indices[index + 1] = index10
+                index += 2
+            }
+
+            /*  Similarly, at the right edge we have that the bottom right    *
+             *  point lies outside of the parametrization and do not need to  *
+             *  add it to our mesh. Check for this.                           */
+            /*  The segment is part of the row for yIndex, similarly.         */
+            if drawRows && (xIndex < cellsX) &&
+               isWireframeLine(yIndex, self.NyPts, stride) {
+                self.Indices[index] = index00
+                self.Indices[index + 1] = index01
+                index += 2
+            }
+
+            /*  The diagonal connects the current point to the point above    *
+             *  and to the right of it. This is only possible away from both  *
+             *  the top edge and the right edge, unless the seams are closed. */
+            if drawDiagonals && (xIndex < cellsX) && (yIndex < cellsY) {
+                self.Indices[index] = index00
+                self.Indices[index + 1] = index11
+                index += 2
+            }
+
+            /*  The two triangles of the cell, counterclockwise, in the same  *
+             *  order as GenerateTriangleFaces.                               */
+            if withFaces && (xIndex < cellsX) && (yIndex < cellsY) {
+                var corner01 uint32 = index01
+                var corner10 uint32 = index10
+
+                if reversed {
+                    corner01, corner10 = corner10, corner01
+                }
+
+                self.Faces[face] = index00
+                self.Faces[face + 1] = corner01
+                self.Faces[face + 2] = index11
+                self.Faces[face + 3] = index00
+                self.Faces[face + 4] = index11
+                self.Faces[face + 5] = corner10
+                face += 6
+            }
+        }
+        /*  End of horizontal for-loop.                                       */
+    }
+    /*  End of vertical for-loop.                                             */
+
+    /*  The number of indices written, this agrees with ComputeIndexSize.     */
+    self.IndexSize = int(index)
+    self.Indices = self.Indices[0:index]
+    return nil
+}
+/*  End of GenerateWireframeAndFaces.                                         */
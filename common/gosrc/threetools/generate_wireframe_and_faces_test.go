@@ -0,0 +1,78 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for generating the wireframe and the faces in one pass.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On a 4x4 grid the single pass writes the 24 segments of the square        *
+ *  wireframe and the 18 triangles of its cells, the same buffers that        *
+ *  GenerateRectangularWireframe and GenerateTriangleFaces give separately.   */
+func TestWireframeAndFacesConsistent(t *testing.T) {
+    var both *Canvas = newTestCanvas(t, WithGrid(4, 4))
+    var separate *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 4))
+    separate.GenerateTriangleFaces()
+
+    both.GenerateMeshFromParametrization(paraboloid)
+
+    if err := both.GenerateWireframeAndFaces(true); err != nil {
+        t.Fatalf("GenerateWireframeAndFaces: %v", err)
+    }
+
+    if (both.IndexSize != 2 * 24) || (both.FaceCount() != 18) {
+        t.Fatalf("IndexSize %d and %d faces, want 48 and 18",
+                 both.IndexSize, both.FaceCount())
+    }
+
+    if (both.IndexSize != separate.IndexSize) ||
+       (len(both.Faces) != len(separate.Faces)) {
+        t.Fatalf("sizes %d and %d, separately %d and %d", both.IndexSize,
+                 len(both.Faces), separate.IndexSize, len(separate.Faces))
+    }
+
+    for index := 0; index < both.IndexSize; index++ {
+        if both.Indices[index] != separate.Indices[index] {
+            t.Fatalf("index %d is %d, separately %d", index,
+                     both.Indices[index], separate.Indices[index])
+        }
+    }
+
+    for index := range both.Faces {
+        if both.Faces[index] != separate.Faces[index] {
+            t.Fatalf("face index %d is %d, separately %d", index,
+                     both.Faces[index], separate.Faces[index])
+        }
+    }
+
+    /*  Without faces the face buffer is left as it was.                      */
+    if err := both.GenerateWireframeAndFaces(false); err != nil {
+        t.Fatalf("GenerateWireframeAndFaces: %v", err)
+    }
+
+    if both.FaceCount() != 18 {
+        t.Errorf("%d faces after regenerating the wireframe alone, want 18",
+                 both.FaceCount())
+    }
+}
+/*  End of TestWireframeAndFacesConsistent.                                   */
@@ -0,0 +1,155 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates the wireframe of a rectangular grid as line strips.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Writes the line strips of the wireframe to the indices and returns how    *
+ *  many indices there are. If indices is nil nothing is written, and only    *
+ *  the count is computed, so the size may be checked before writing.         */
+func (self *Canvas) wireframeStrips(indices []uint32) int {
+
+    /*  Variables for indexing over the grid lines and along them.            */
+    var line, step uint32
+
+    /*  The number of indices, written or not.                                */
+    var count int = 0
+
+    /*  Adds a vertex to the current strip, starting a new strip if asked.    */
+    var emit = func(vertex uint32, restart bool) {
+        if restart && (count > 0) {
+            if indices != nil {
+                indices[count] = StripRestartIndex
+            }
+
+            count++
+        }
+
+        if indices != nil {
+            indices[count] = vertex
+        }
+
+        count++
+    }
+
+    /*  The number of cells along each axis, including those across the       *
+     *  closed seams, and the lines that are drawn.                           */
+    cellsX, cellsY := self.gridCells()
+    drawRows, drawColumns, drawDiagonals := self.wireframeLines()
+    var stride uint32 = self.wireframeStride()
+
+    /*  Each row is a strip through cellsX + 1 vertices. Across a closed seam *
+     *  the last vertex is the first vertex of the row again.                 */
+    if drawRows && (cellsX > 0) {
+        for line = 0; line < self.NyPts; line++ {
+            if !isWireframeLine(line, self.NyPts, stride) {
+                continue
+            }
+
+            for step = 0; step <= cellsX; step++ {
+                emit(self.gridIndex(step % self.NxPts, line), step == 0)
+            }
+        }
+    }
+
+    /*  The columns, similarly.                                               */
+    if drawColumns && (cellsY > 0) {
+        for line = 0; line < self.NxPts; line++ {
+            if !isWireframeLine(line, self.NxPts, stride) {
+                continue
+            }
+
+            for step = 0; step <= cellsY; step++ {
+                emit(self.gridIndex(line, step % self.NyPts), step == 0)
+            }
+        }
+    }
+
+    /*  The diagonals start at the cells along the bottom row and the left    *
+     *  column, and run up and to the right until they leave the grid, so     *
+     *  every cell is crossed by exactly one strip.                           */
+    if drawDiagonals && (cellsX > 0) && (cellsY > 0) {
+        for line = 0; line < cellsX + cellsY - 1; line++ {
+            var xStart, yStart uint32 = 0, 0
+
+            if line < cellsX {
+                xStart = cellsX - 1 - line
+            } else {
+                yStart = line - cellsX + 1
+            }
+
+            for step = 0; (xStart + step <= cellsX) &&
+                          (yStart + step <= cellsY); step++ {
+                var x uint32 = (xStart + step) % self.NxPts
+                var y uint32 = (yStart + step) % self.NyPts
+
+                emit(self.gridIndex(x, y), step == 0)
+            }
+        }
+    }
+
+    return count
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateWireframeStrips                                               *
+ *  Purpose:                                                                  *
+ *      Generates the same wireframe as GenerateWireframeAndFaces, but as     *
+ *      line strips for three.js LineStrip rather than disjoint segments.     *
+ *      Each drawn row, column, and diagonal of the grid is one strip listing *
+ *      its vertices in order, and consecutive strips are separated by        *
+ *      StripRestartIndex. A row of N vertices takes N indices rather than    *
+ *      2(N - 1), about halving the index buffer of a dense grid. IndexSize   *
+ *      is set to the number of indices written.                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation. This contains geometry and buffers. *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrBufferTooSmall or the error from ValidateBuffers.              *
+ ******************************************************************************/
+func (self *Canvas) GenerateWireframeStrips() error {
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    /*  A very thin grid has more restarts than it saves, check the storage.  */
+    var count int = self.wireframeStrips(nil)
+
+    if count > len(self.IndexStorage) {
+        return fmt.Errorf(
+            "%w: %d strip indices, storage holds %d",
+            ErrBufferTooSmall, count, len(self.IndexStorage),
+        )
+    }
+
+    self.Indices = self.IndexStorage[0:count]
+    self.IndexSize = count
+    self.wireframeStrips(self.Indices)
+    return nil
+}
+/*  End of GenerateWireframeStrips.                                           */
@@ -0,0 +1,98 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for writing the wireframe as line strips.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Generates the wireframe of a grid, as strips or as segments, and returns  *
+ *  the indices that were written.                                            */
+func wireframeIndices(t *testing.T, nxPts, nyPts uint32,
+                      strips bool) []uint32 {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(nxPts, nyPts), WithLineStrips(strips),
+    )
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    return canvas.Indices
+}
+/*  End of wireframeIndices.                                                  */
+
+/*  A single row of N vertices is one strip of N indices, listing the         *
+ *  vertices in order, while the segments take 2(N - 1) indices.              */
+func TestWireframeStripsRow(t *testing.T) {
+    const nxPts uint32 = 9
+
+    var strips []uint32 = wireframeIndices(t, nxPts, 1, true)
+    var segments []uint32 = wireframeIndices(t, nxPts, 1, false)
+
+    if len(strips) != int(nxPts) {
+        t.Errorf("%d strip indices, want %d", len(strips), nxPts)
+    }
+
+    if len(segments) != int(2 * (nxPts - 1)) {
+        t.Errorf("%d segment indices, want %d",
+                 len(segments), 2 * (nxPts - 1))
+    }
+
+    for index, vertex := range strips {
+        if vertex != uint32(index) {
+            t.Errorf("strip index %d is %d, want %d", index, vertex, index)
+        }
+    }
+}
+/*  End of TestWireframeStripsRow.                                            */
+
+/*  On a full grid the strips list every vertex once per row and column, with *
+ *  a restart between consecutive strips, fewer indices than the segments.    */
+func TestWireframeStripsGrid(t *testing.T) {
+    var strips []uint32 = wireframeIndices(t, 6, 4, true)
+    var segments []uint32 = wireframeIndices(t, 6, 4, false)
+
+    /*  The number of restarts between consecutive strips.                    */
+    var restarts int = 0
+
+    for _, vertex := range strips {
+        if vertex == StripRestartIndex {
+            restarts++
+        }
+    }
+
+    /*  Four strips of six vertices, six of four, and nine restarts.          */
+    if (len(strips) != 57) || (restarts != 9) {
+        t.Errorf("%d strip indices and %d restarts, want 57 and 9",
+                 len(strips), restarts)
+    }
+
+    /*  Four rows of five segments and six columns of three.                  */
+    if len(segments) != 76 {
+        t.Errorf("%d segment indices, want 76", len(segments))
+    }
+}
+/*  End of TestWireframeStripsGrid.                                           */
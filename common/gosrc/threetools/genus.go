@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the genus of a closed surface.                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Genus                                                                 *
+ *  Purpose:                                                                  *
+ *      Computes the genus, the number of handles, of a closed orientable     *
+ *      surface from its Euler characteristic, chi = 2 - 2g. A sphere has     *
+ *      genus 0 and a torus genus 1. The surface is assumed to be connected,  *
+ *      and like EulerCharacteristic the face buffer should cover it, with    *
+ *      the seams identified by welding coincident vertices. The mesh types   *
+ *      glued with a twist, like the Klein bottle and the projective plane,   *
+ *      are not orientable and have no genus in this sense. For these, and    *
+ *      for meshes whose characteristic is odd or larger than 2, like a disk  *
+ *      or a surface in several pieces, -1 is returned.                       *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being examined.                          *
+ *  Output:                                                                   *
+ *      genus (int):                                                          *
+ *          The genus of the surface, or -1 if it has none.                   *
+ ******************************************************************************/
+func Genus(canvas *Canvas) int {
+    _, twistX, _, twistY := canvas.seamTopology()
+
+    if twistX || twistY {
+        return -1
+    }
+
+    var chi int = EulerCharacteristic(canvas)
+
+    /*  Closed orientable surfaces have even characteristic, at most 2.       */
+    if (chi > 2) || (chi % 2 != 0) {
+        return -1
+    }
+
+    return (2 - chi) / 2
+}
+/*  End of Genus.                                                             */
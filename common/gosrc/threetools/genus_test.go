@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the genus of closed surfaces.                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The torus has genus 1 and the sphere genus 0. The Klein bottle and the    *
+ *  projective plane are not orientable and have no genus.                    */
+func TestGenusClosedSurfaces(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshType uint
+        f ParametricSurface
+        genus int
+    }{
+        {"torus", TorodialSquareWireframe, torus, 1},
+        {"triangulated torus", TorodialTriangleWireframe, torus, 1},
+        {"sphere", SquareWireframe, unitSphere, 0},
+        {"Klein bottle", KleinSquareWireframe, kleinBottle, -1},
+        {"projective plane", ProjectiveSquareWireframe, projectivePlane, -1},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newClosedSurface(t, c.meshType, 12, 9, c.f)
+
+        if genus := Genus(canvas); genus != c.genus {
+            t.Errorf("%s: genus %d, want %d", c.name, genus, c.genus)
+        }
+    }
+}
+/*  End of TestGenusClosedSurfaces.                                           */
@@ -0,0 +1,49 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Looks up a canvas in the canvas registry.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GetCanvas                                                             *
+ *  Purpose:                                                                  *
+ *      Returns the canvas with the given id.                                 *
+ *  Arguments:                                                                *
+ *      id (int):                                                             *
+ *          The id of the canvas, as returned by NewCanvas. The main canvas   *
+ *          has id 0.                                                         *
+ *  Output:                                                                   *
+ *      canvas (*Canvas):                                                     *
+ *          The requested canvas, or nil if the id is not registered.         *
+ ******************************************************************************/
+func GetCanvas(id int) *Canvas {
+
+    /*  Avoid indexing beyond the bounds of the registry.                     */
+    if (id < 0) || (id >= len(Canvases)) {
+        return nil
+    }
+
+    return Canvases[id]
+}
+/*  End of GetCanvas.                                                         */
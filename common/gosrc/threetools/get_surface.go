@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Looks up a surface in the registry.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GetSurface                                                            *
+ *  Purpose:                                                                  *
+ *      Returns the graph z = f(x, y) of a registered surface, for using the  *
+ *      presets from Go without drawing them. A family is evaluated with its  *
+ *      default coefficients, and a time dependent surface at t = 0.          *
+ *      Parametric surfaces are not graphs, and are not returned.             *
+ *  Arguments:                                                                *
+ *      name (string):                                                        *
+ *          The name of the registered surface.                               *
+ *  Output:                                                                   *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface, nil if not found.                                    *
+ *      found (bool):                                                         *
+ *          False if there is no graph with this name.                        *
+ ******************************************************************************/
+func GetSurface(name string) (SurfaceParametrization, bool) {
+    preset, found := SurfacePresets[name]
+
+    if !found {
+        return nil, false
+    }
+
+    if preset.Family != nil {
+        return preset.Family(preset.Coefficients), true
+    }
+
+    if preset.Time != nil {
+        return surfaceAtTime(preset.Time, 0.0), true
+    }
+
+    if preset.TimeFamily != nil {
+        return surfaceAtTime(preset.TimeFamily(preset.Coefficients), 0.0), true
+    }
+
+    return preset.Graph, preset.Graph != nil
+}
+/*  End of GetSurface.                                                        */
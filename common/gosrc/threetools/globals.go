@@ -24,7 +24,14 @@
  ******************************************************************************/
 package threetools
 
+/*  Mutex type provided here, used for guarding the canvases.                 */
+import "sync"
+
 const (
+    /*  Number of points the buffers of a canvas hold when created, enough    *
+     *  for a 32x32 grid. The buffers grow as needed, see ReserveStorage.     */
+    InitialLength uint32 = 32 * 32
+
     /*  Maximum number of points along the horizontal axis. 512 is overkill,  *
      *  a normal animation will have between 32 and 128 points. Still, the    *
      *  animations are allowed to use up to a 512x512 mesh.                   */
@@ -41,30 +48,99 @@ const (
      *  times the total number of points allowed in the mesh.                 */
     MaxMeshBufferSize uint32 = 3 * MaxLength
 
-    /*  The largest number of line segments in a mesh occurs when a           *
-     *  triangular grid is used. In this case every point that is not on the  *
-     *  boundary corresponds to three line segments: one horizontal, one      *
-     *  vertical, and one diagonal. The points along the boundary correspond  *
-     *  to only one line segment, hence the number of line segments is given  *
-     *  by 3 * width * height - 2 * weight - 2 * height. Each line segment is *
-     *  given by two vertices in the mesh. The max size for the index array   *
-     *  is hence given by the following.                                      */
-    MaxIndexBufferSize uint32 = 2 * (3 * MaxLength - 2 * (MaxWidth + MaxHeight))
+    /*  The largest number of line segments in a mesh occurs when a wrapped   *
+     *  triangular grid is used, like the torus. In this case every point     *
+     *  corresponds to three line segments: one horizontal, one vertical, and *
+     *  one diagonal, including the points on the boundary since these are    *
+     *  joined to the opposite edge. Each line segment is given by two        *
+     *  vertices in the mesh. The max size for the index array is hence given *
+     *  by the following.                                                     */
+    MaxIndexBufferSize uint32 = 2 * 3 * MaxLength
+
+    /*  Number of floats per vertex in the interleaved buffer. Three for the  *
+     *  position, three for the normal, and three for the color, leaving out  *
+     *  the opacity.                                                          */
+    InterleavedStride int = 9
+
+    /*  Vertices closer than this are considered the same point when the      *
+     *  seams of a wrapped mesh are welded together.                          */
+    WeldEpsilon float32 = 1.0E-5
+
+    /*  Version of the package, reported by Capabilities. This is increased   *
+     *  whenever features are added.                                          */
+    Version string = "1.1.0"
+
+    /*  Version of the binary format written by Serialize. This is the first  *
+     *  byte of the data and is increased whenever the layout changes.        */
+    SerializationVersion byte = 2
+
+    /*  Index separating the line strips of GenerateWireframeStrips. WebGL 2  *
+     *  always restarts a strip at the largest unsigned 32-bit index.         */
+    StripRestartIndex uint32 = 0xFFFFFFFF
+
+    /*  Angle, in radians, the mesh is turned by per pixel dragged.           */
+    DragRadiansPerPixel float32 = 0.01
 )
 
 var (
-    /*  Buffer for the vertices, used for both reading and writing.           */
-    MeshBuffer [MaxMeshBufferSize]float32
+    /*  Largest number of points the buffers of a canvas may grow to. This    *
+     *  may be lowered to limit the memory used, it is at most MaxLength.     */
+    MaxBufferLength uint32 = MaxLength
+
+    /*  Buffer for the vertices, used for both reading and writing. This is   *
+     *  the storage of the main canvas and is replaced when it grows.         */
+    MeshBuffer []float32 = make([]float32, 3 * InitialLength)
 
     /*  Buffer for the line segments, given by connecting vertices.           */
-    IndexBuffer [MaxIndexBufferSize]uint32
+    IndexBuffer []uint32 = make([]uint32, 6 * InitialLength)
 
     /*  Unit vector used for slowly rotating the mesh over time.              */
     RotationVector UnitVector
 
+    /*  Rate, in radians per second, at which StepByTime spins the mesh.      */
+    AngularSpeed float32
+
+    /*  Rates, in radians per second, at which StepByTime also turns the mesh *
+     *  about the x and y axes, see SetTumble.                                */
+    TumbleSpeed [2]float32
+
+    /*  Decay rate, per second, of the angular velocity of spinning meshes.   */
+    RotationDamping float32 = 1.0
+
+    /*  Amplitude, wavenumber, and angular frequency of the traveling wave    *
+     *  added by AnimateRipple.                                               */
+    RippleAmplitude float32 = 0.1
+    RippleWavenumber float32 = 4.0
+    RippleFrequency float32 = 3.0
+
+    /*  Rate, per second, at which the height scale of AnimateSurface relaxes *
+     *  back towards one after the surface shrinks, see SetHeightTarget.      */
+    HeightRelaxation float32 = 2.0
+
+    /*  Colors used by ColorByLevelSet for the band around the level set and  *
+     *  for the rest of the surface.                                          */
+    LevelSetColor [3]float32 = [3]float32{1.0, 0.2, 0.1}
+    NeutralColor [3]float32 = [3]float32{0.7, 0.7, 0.7}
+
     /*  The canvas for the animations, which contains geometry and slices for *
      *  the mesh and index buffers.                                           */
-    MainCanvas Canvas
+    MainCanvas Canvas = Canvas{
+        MeshStorage: MeshBuffer,
+        IndexStorage: IndexBuffer,
+    }
+
+    /*  Registry of all canvases, allowing a page to show several figures     *
+     *  using the same WebAssembly module. The canvas id is the index into    *
+     *  this slice. The main canvas is always registered with id 0.           */
+    Canvases []*Canvas = []*Canvas{&MainCanvas}
+
+    /*  Lock guarding the registered canvases, their buffers, and the         *
+     *  rotation vector. Anything that mutates these, like the JavaScript     *
+     *  bindings, should hold the lock while doing so.                        */
+    CanvasLock sync.Mutex
+
+    /*  Registry of the named surfaces, see RegisterSurface.                  */
+    SurfacePresets map[string]SurfacePreset = defaultSurfacePresets()
 )
 
 /*  Go does not have enum's, but it does have this iota concept. Use this to  *
@@ -83,3 +159,28 @@ const (
     ProjectiveSquareWireframe = iota
     ProjectiveTriangleWireframe = iota
 )
+
+/*  The directions of the grid lines drawn by the rectangular wireframe.      *
+ *  Horizontal lines have constant y, vertical lines have constant x, and the *
+ *  diagonals connect the bottom left corner of each cell to the top right.   */
+const (
+    BothDirections = iota
+    HorizontalDirection = iota
+    VerticalDirection = iota
+    DiagonalDirection = iota
+)
+
+/*  The kinds of critical points found by CriticalPoints. A monkey saddle,    *
+ *  with three ridges and three valleys, is a saddle as well.                 */
+const (
+    LocalMinimum = iota
+    LocalMaximum = iota
+    SaddlePoint = iota
+)
+
+/*  The coordinate axes a RotationTransform turns about.                      */
+const (
+    XAxis = iota
+    YAxis = iota
+    ZAxis = iota
+)
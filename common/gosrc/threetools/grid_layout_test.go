@@ -0,0 +1,329 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that the routines walking the grid follow its layout.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the torus.                   */
+import (
+    "math"
+    "testing"
+)
+
+/*  Creates the graph of the paraboloid on a 7x5 grid in both layouts, the    *
+ *  row-major canvas first.                                                   */
+func newLayoutPair(t *testing.T, options ...CanvasOption) (*Canvas, *Canvas) {
+    t.Helper()
+
+    var rowOptions []CanvasOption = append(
+        []CanvasOption{WithGrid(7, 5)}, options...,
+    )
+    var columnOptions []CanvasOption = append(
+        rowOptions[:len(rowOptions):len(rowOptions)], WithColumnMajor(true),
+    )
+
+    return newGraphCanvas(t, paraboloid, rowOptions...),
+           newGraphCanvas(t, paraboloid, columnOptions...)
+}
+/*  End of newLayoutPair.                                                     */
+
+/*  The same surface in the two layouts gives meshes that are transposes, the *
+ *  point in column x and row y is at y Nx + x in the row-major mesh and at x *
+ *  Ny + y in the column-major mesh.                                          */
+func TestColumnMajorIsTranspose(t *testing.T) {
+    rows, columns := newLayoutPair(t)
+
+    for yIndex := 0; yIndex < 5; yIndex++ {
+        for xIndex := 0; xIndex < 7; xIndex++ {
+            var rowPoint [3]float32 = rows.point(yIndex * 7 + xIndex)
+            var columnPoint [3]float32 = columns.point(xIndex * 5 + yIndex)
+
+            if rowPoint != columnPoint {
+                t.Fatalf("point (%d, %d) is %v row-major, %v column-major",
+                         xIndex, yIndex, rowPoint, columnPoint)
+            }
+        }
+    }
+}
+/*  End of TestColumnMajorIsTranspose.                                        */
+
+/*  The texture coordinates of a vertex are its grid coordinates, scaled to   *
+ *  the unit square, for either layout.                                       */
+func TestGenerateUVsColumnMajor(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 3), WithColumnMajor(true),
+    )
+
+    canvas.GenerateUVs()
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+            var index uint32 = 2 * canvas.gridIndex(xIndex, yIndex)
+            var u, v float32 = canvas.UVs[index], canvas.UVs[index + 1]
+            var wantU float32 = float32(xIndex) / 4.0
+            var wantV float32 = float32(yIndex) / 2.0
+
+            if !closeTo(u, wantU, 1.0E-6) || !closeTo(v, wantV, 1.0E-6) {
+                t.Errorf("UV at (%d, %d) = [%g %g], want [%g %g]",
+                         xIndex, yIndex, u, v, wantU, wantV)
+            }
+        }
+    }
+}
+/*  End of TestGenerateUVsColumnMajor.                                        */
+
+/*  UpdateRegion rewrites the vertices in the rectangle, and only those, for  *
+ *  the column-major layout.                                                  */
+func TestUpdateRegionColumnMajor(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(7, 5), WithColumnMajor(true),
+    )
+
+    canvas.UpdateRegion(func(x, y float32) float32 {
+        return 7.0
+    }, 1, 2, 4, 4)
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+            var p [3]float32 = canvas.point(
+                int(canvas.gridIndex(xIndex, yIndex)),
+            )
+            var want float32 = paraboloid(p[0], p[1])
+
+            if (1 <= xIndex) && (xIndex < 4) && (2 <= yIndex) && (yIndex < 4) {
+                want = 7.0
+            }
+
+            if !closeTo(p[2], want, 1.0E-6) {
+                t.Errorf("height at (%d, %d) = %g, want %g",
+                         xIndex, yIndex, p[2], want)
+            }
+        }
+    }
+}
+/*  End of TestUpdateRegionColumnMajor.                                       */
+
+/*  The measurements of a grid do not depend on how it is stored.             */
+func TestGridMeasurementsAgree(t *testing.T) {
+    rows, columns := newLayoutPair(t)
+
+    if a, b := VolumeUnder(rows), VolumeUnder(columns); !closeTo(a, b, 1.0E-5) {
+        t.Errorf("VolumeUnder = %g and %g for the two layouts", a, b)
+    }
+
+    for index := uint32(0); index < rows.NxPts; index++ {
+        a, errA := GridLineLength(rows, VerticalDirection, index)
+        b, errB := GridLineLength(columns, VerticalDirection, index)
+
+        if (errA != nil) || (errB != nil) || !closeTo(a, b, 1.0E-5) {
+            t.Errorf("column %d has length %g (%v) and %g (%v)",
+                     index, a, errA, b, errB)
+        }
+    }
+
+    for index := uint32(0); index < rows.NyPts; index++ {
+        a, errA := GridLineLength(rows, HorizontalDirection, index)
+        b, errB := GridLineLength(columns, HorizontalDirection, index)
+
+        if (errA != nil) || (errB != nil) || !closeTo(a, b, 1.0E-5) {
+            t.Errorf("row %d has length %g (%v) and %g (%v)",
+                     index, a, errA, b, errB)
+        }
+    }
+
+    for yIndex := uint32(0); yIndex + 1 < rows.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex + 1 < rows.NxPts; xIndex++ {
+            var a float32 = CellHeightChange(rows, xIndex, yIndex)
+            var b float32 = CellHeightChange(columns, xIndex, yIndex)
+
+            if !closeTo(a, b, 1.0E-6) {
+                t.Errorf("cell (%d, %d) rises %g and %g",
+                         xIndex, yIndex, a, b)
+            }
+        }
+    }
+}
+/*  End of TestGridMeasurementsAgree.                                         */
+
+/*  The per-vertex buffers hold the same values at the same grid points.      */
+func TestGridBuffersAgree(t *testing.T) {
+    rows, columns := newLayoutPair(t)
+    var red, blue [3]float32 = [3]float32{1, 0, 0}, [3]float32{0, 0, 1}
+
+    rows.ComputeGradient()
+    columns.ComputeGradient()
+    rows.ColorCheckerboard(red, blue)
+    columns.ColorCheckerboard(red, blue)
+
+    for yIndex := uint32(0); yIndex < rows.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < rows.NxPts; xIndex++ {
+            var a uint32 = rows.gridIndex(xIndex, yIndex)
+            var b uint32 = columns.gridIndex(xIndex, yIndex)
+
+            for k := uint32(0); k < 2; k++ {
+                var got float32 = columns.Gradients[2 * b + k]
+                var want float32 = rows.Gradients[2 * a + k]
+
+                if !closeTo(got, want, 1.0E-5) {
+                    t.Errorf("gradient at (%d, %d) = %g, want %g",
+                             xIndex, yIndex, got, want)
+                }
+            }
+
+            for k := uint32(0); k < 4; k++ {
+                if columns.Colors[4 * b + k] != rows.Colors[4 * a + k] {
+                    t.Errorf("color at (%d, %d) differs", xIndex, yIndex)
+                }
+            }
+        }
+    }
+}
+/*  End of TestGridBuffersAgree.                                              */
+
+/*  Picking returns the grid coordinates of the vertex, not its row-major     *
+ *  decomposition.                                                            */
+func TestPickNearestVertexColumnMajor(t *testing.T) {
+    _, columns := newLayoutPair(t)
+    var target [3]float32 = columns.point(int(columns.gridIndex(5, 1)))
+    var origin [3]float32 = vectorSum(target, [3]float32{0.0, 0.0, 10.0})
+
+    x, y, distance := PickNearestVertex(
+        columns, origin, [3]float32{0.0, 0.0, -1.0},
+    )
+
+    if (x != 5) || (y != 1) || (distance > 1.0E-6) {
+        t.Errorf("PickNearestVertex = (%d, %d, %g), want (5, 1, 0)",
+                 x, y, distance)
+    }
+}
+/*  End of TestPickNearestVertexColumnMajor.                                  */
+
+/*  Downsampling keeps the same grid points for either layout.                */
+func TestDownsampleColumnMajor(t *testing.T) {
+    rows, columns := newLayoutPair(t)
+
+    if err := rows.Downsample(2); err != nil {
+        t.Fatalf("Downsample: %v", err)
+    }
+
+    if err := columns.Downsample(2); err != nil {
+        t.Fatalf("Downsample: %v", err)
+    }
+
+    for yIndex := uint32(0); yIndex < rows.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < rows.NxPts; xIndex++ {
+            expectVector(
+                t, "downsampled vertex",
+                columns.point(int(columns.gridIndex(xIndex, yIndex))),
+                rows.point(int(rows.gridIndex(xIndex, yIndex))), 0.0,
+            )
+        }
+    }
+}
+/*  End of TestDownsampleColumnMajor.                                         */
+
+/*  Adaptive subdivision and level of detail blending give the same figure    *
+ *  for either layout.                                                        */
+func TestRefinementColumnMajor(t *testing.T) {
+    rows, columns := newLayoutPair(t)
+    coarseRows, coarseColumns := newLayoutPair(t, WithGrid(4, 3))
+
+    if err := rows.BeginLODBlend(coarseRows); err != nil {
+        t.Fatalf("BeginLODBlend: %v", err)
+    }
+
+    if err := columns.BeginLODBlend(coarseColumns); err != nil {
+        t.Fatalf("BeginLODBlend: %v", err)
+    }
+
+    rows.BlendLOD(0.0)
+    columns.BlendLOD(0.0)
+
+    for yIndex := uint32(0); yIndex < rows.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < rows.NxPts; xIndex++ {
+            expectVector(
+                t, "blended vertex",
+                columns.point(int(columns.gridIndex(xIndex, yIndex))),
+                rows.point(int(rows.gridIndex(xIndex, yIndex))), 1.0E-6,
+            )
+        }
+    }
+
+    a, errA := rows.SubdivideAdaptive(CellHeightChange, 0.5)
+    b, errB := columns.SubdivideAdaptive(CellHeightChange, 0.5)
+
+    if (errA != nil) || (errB != nil) || (a != b) || (a == 0) {
+        t.Fatalf("SubdivideAdaptive = %d (%v) and %d (%v)", a, errA, b, errB)
+    }
+
+    if !closeTo(wireframeLength(rows), wireframeLength(columns), 1.0E-4) {
+        t.Errorf("wireframe lengths %g and %g differ",
+                 wireframeLength(rows), wireframeLength(columns))
+    }
+}
+/*  End of TestRefinementColumnMajor.                                         */
+
+/*  Welding the seams of a torus whose edges are repeated removes the same    *
+ *  vertices for either layout.                                               */
+func TestWeldSeamsColumnMajor(t *testing.T) {
+    var removed [2]int
+
+    for index, columnMajor := range []bool{false, true} {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(8, 6), WithDomain(1.0, 1.0, 0.0, 0.0),
+            WithMeshType(TorodialSquareWireframe),
+            WithColumnMajor(columnMajor),
+        )
+
+        canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+            sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+            sinV, cosV := math.Sincos(2.0 * math.Pi * float64(v))
+            var r float64 = 2.0 + cosV
+            return [3]float32{
+                float32(r * cosU), float32(r * sinU), float32(sinV),
+            }
+        })
+
+        removed[index] = canvas.WeldSeams()
+    }
+
+    if (removed[0] != 8 + 6 - 1) || (removed[1] != removed[0]) {
+        t.Errorf("WeldSeams removed %d and %d vertices, want 13",
+                 removed[0], removed[1])
+    }
+}
+/*  End of TestWeldSeamsColumnMajor.                                          */
+
+/*  The total length of the line segments of the wireframe.                   */
+func wireframeLength(canvas *Canvas) float32 {
+    var length float32 = 0.0
+
+    for index := 0; index + 1 < canvas.IndexSize; index += 2 {
+        var a [3]float32 = canvas.point(int(canvas.Indices[index]))
+        var b [3]float32 = canvas.point(int(canvas.Indices[index + 1]))
+        length += vectorNorm(vectorDifference(a, b))
+    }
+
+    return length
+}
+/*  End of wireframeLength.                                                   */
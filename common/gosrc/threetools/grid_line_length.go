@@ -0,0 +1,96 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the arc length of a row or column of the mesh.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GridLineLength                                                        *
+ *  Purpose:                                                                  *
+ *      Computes the length of a single grid line of the mesh, the sum of the *
+ *      distances between consecutive vertices along it. This shows how the   *
+ *      surface stretches the lines of the domain, for a flat plane a row has *
+ *      length Width and a column has length Height. The direction is         *
+ *      HorizontalDirection for a row, a line of constant y, or               *
+ *      VerticalDirection for a column, a line of constant x.                 *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh.                                         *
+ *      direction (int):                                                      *
+ *          HorizontalDirection or VerticalDirection.                         *
+ *      index (uint32):                                                       *
+ *          The index of the row or column.                                   *
+ *  Output:                                                                   *
+ *      length (float32):                                                     *
+ *          The length of the grid line.                                      *
+ *      err (error):                                                          *
+ *          ErrInvalidDirection or ErrIndexOutOfRange on failure.             *
+ ******************************************************************************/
+func GridLineLength(canvas *Canvas,
+                    direction int, index uint32) (float32, error) {
+
+    /*  Variable for indexing over the vertices of the line.                  */
+    var step uint32
+
+    /*  The number of vertices on the line, and the index of each vertex.     */
+    var count uint32
+    var vertex func(step uint32) int
+
+    /*  The length is accumulated in double precision.                        */
+    var length float64 = 0.0
+
+    switch direction {
+        case HorizontalDirection:
+            if index >= canvas.NyPts {
+                return 0.0, ErrIndexOutOfRange
+            }
+
+            count = canvas.NxPts
+            vertex = func(step uint32) int {
+                return int(canvas.gridIndex(step, index))
+            }
+
+        case VerticalDirection:
+            if index >= canvas.NxPts {
+                return 0.0, ErrIndexOutOfRange
+            }
+
+            count = canvas.NyPts
+            vertex = func(step uint32) int {
+                return int(canvas.gridIndex(index, step))
+            }
+
+        default:
+            return 0.0, ErrInvalidDirection
+    }
+
+    for step = 1; step < count; step++ {
+        var previous [3]float32 = canvas.point(vertex(step - 1))
+        var current [3]float32 = canvas.point(vertex(step))
+        length += float64(vectorNorm(vectorDifference(current, previous)))
+    }
+
+    return float32(length), nil
+}
+/*  End of GridLineLength.                                                    */
@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the arc length of the grid lines.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is.                                       */
+import (
+    "errors"
+    "testing"
+)
+
+/*  On the flat plane every row has length Width and every column has length  *
+ *  Height. The steps are exact binary fractions, so the sums are exact.      */
+func TestGridLineLengthPlane(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, Plane, WithGrid(7, 5), WithDomain(3.0, 2.0, -1.0, 0.5),
+    )
+
+    for row := uint32(0); row < canvas.NyPts; row++ {
+        length, err := GridLineLength(canvas, HorizontalDirection, row)
+
+        if (err != nil) || (length != canvas.Width) {
+            t.Errorf("row %d has length %g (%v), want %g",
+                     row, length, err, canvas.Width)
+        }
+    }
+
+    for column := uint32(0); column < canvas.NxPts; column++ {
+        length, err := GridLineLength(canvas, VerticalDirection, column)
+
+        if (err != nil) || (length != canvas.Height) {
+            t.Errorf("column %d has length %g (%v), want %g",
+                     column, length, err, canvas.Height)
+        }
+    }
+
+    _, err := GridLineLength(canvas, HorizontalDirection, canvas.NyPts)
+
+    if !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("row past the grid: got %v, want ErrIndexOutOfRange", err)
+    }
+
+    _, err = GridLineLength(canvas, DiagonalDirection, 0)
+
+    if !errors.Is(err, ErrInvalidDirection) {
+        t.Errorf("diagonal: got %v, want ErrInvalidDirection", err)
+    }
+}
+/*  End of TestGridLineLengthPlane.                                           */
@@ -0,0 +1,45 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Estimates the tangent vectors of a mesh along its grid lines.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Estimates the tangents along the horizontal (u) and vertical (v) axes of  *
+ *  the grid at a vertex, using central differences of the neighboring points *
+ *  in the interior and one-sided differences on the boundary. The neighbors  *
+ *  are found by NeighborIndex, so closed seams are crossed. The vectors are  *
+ *  not scaled by the step size, only their directions are meaningful.        */
+func (self *Canvas) gridTangents(xIndex, yIndex int) ([3]float32, [3]float32) {
+    right, _ := self.NeighborIndex(xIndex, yIndex, 1, 0)
+    left, _ := self.NeighborIndex(xIndex, yIndex, -1, 0)
+    above, _ := self.NeighborIndex(xIndex, yIndex, 0, 1)
+    below, _ := self.NeighborIndex(xIndex, yIndex, 0, -1)
+
+    var uTangent [3]float32 =
+        vectorDifference(self.point(right), self.point(left))
+    var vTangent [3]float32 =
+        vectorDifference(self.point(above), self.point(below))
+
+    return uTangent, vTangent
+}
+/*  End of gridTangents.                                                      */
@@ -0,0 +1,92 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Determines which seams of the grid are closed.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns whether the wireframe and faces close the u seam, joining the     *
+ *  last column of the grid to the first, and the v seam, joining the last    *
+ *  row to the first. Only the plain square and triangle mesh types use the   *
+ *  WrapU and WrapV flags, the other types have their own seams. Closing a    *
+ *  seam needs at least three points across it.                               */
+func (self *Canvas) gridWraps() (bool, bool) {
+    var plain bool = (self.MeshType == SquareWireframe) ||
+                     (self.MeshType == TriangleWireframe)
+
+    var wrapU bool = plain && self.WrapU && (self.NxPts >= 3)
+    var wrapV bool = plain && self.WrapV && (self.NyPts >= 3)
+    return wrapU, wrapV
+}
+
+/*  Returns the number of cells along the horizontal and vertical axes,       *
+ *  counting the cells across the closed seams. A cell is given by its bottom *
+ *  left corner, its other corners follow with gridNeighbors.                 */
+func (self *Canvas) gridCells() (uint32, uint32) {
+    var cellsX, cellsY uint32 = 0, 0
+    wrapU, wrapV := self.gridWraps()
+
+    if self.NxPts > 1 {
+        cellsX = self.NxPts - 1
+    }
+
+    if self.NyPts > 1 {
+        cellsY = self.NyPts - 1
+    }
+
+    if wrapU {
+        cellsX++
+    }
+
+    if wrapV {
+        cellsY++
+    }
+
+    return cellsX, cellsY
+}
+
+/*  Returns the indices of the corners of the cell with bottom left corner    *
+ *  (xIndex, yIndex), the point to the right, the point above, and the point  *
+ *  above and to the right. Across a closed seam these are in the first       *
+ *  column or row of the grid.                                                */
+func (self *Canvas) gridNeighbors(
+    xIndex, yIndex uint32,
+) (uint32, uint32, uint32) {
+    var right uint32 = (xIndex + 1) % self.NxPts
+    var up uint32 = (yIndex + 1) % self.NyPts
+
+    return self.gridIndex(right, yIndex),
+           self.gridIndex(xIndex, up),
+           self.gridIndex(right, up)
+}
+/*  End of gridNeighbors.                                                     */
+
+/*  Returns the index of the vertex in column xIndex and row yIndex, in the   *
+ *  row-major layout, index = y * NxPts + x, or the column-major layout,      *
+ *  index = x * NyPts + y, if LayoutColumnMajor is set.                       */
+func (self *Canvas) gridIndex(xIndex, yIndex uint32) uint32 {
+    if self.LayoutColumnMajor {
+        return xIndex * self.NyPts + yIndex
+    }
+
+    return yIndex * self.NxPts + xIndex
+}
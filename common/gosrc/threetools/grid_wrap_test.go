@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for closing the seams of parametric meshes.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the cylinder.                */
+import (
+    "math"
+    "testing"
+)
+
+/*  The open cylinder of radius one, u goes around and v along the axis.      */
+func cylinder(u, v float32) [3]float32 {
+    sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+    return [3]float32{float32(cosU), float32(sinU), v}
+}
+/*  End of cylinder.                                                          */
+
+/*  With WrapU and not WrapV only the u seam closes: the last column is not a *
+ *  copy of the first, every row has a segment from the last column back to   *
+ *  the first, and no segment joins the top row to the bottom one. The faces  *
+ *  cover the cells across the seam as well.                                  */
+func TestWrapCylinderClosesOnlyU(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(8, 5), WithDomain(1.0, 1.0, 0.0, 0.0),
+        WithWrap(true, false),
+    )
+
+    canvas.GenerateMeshFromParametric(cylinder)
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    canvas.GenerateTriangleFaces()
+
+    var first [3]float32 = canvas.point(int(canvas.gridIndex(0, 0)))
+    var last [3]float32 = canvas.point(int(canvas.gridIndex(7, 0)))
+
+    if closeTo(vectorNorm(vectorDifference(first, last)), 0.0, 1.0E-6) {
+        t.Error("the last column repeats the first")
+    }
+
+    /*  The segments across each of the seams.                                */
+    var acrossU, acrossV int = 0, 0
+
+    for _, segment := range wireframeSegments(canvas) {
+        if (segment.y0 == segment.y1) &&
+           (segment.x0 + segment.x1 == 7) && (segment.x0 * segment.x1 == 0) {
+            acrossU++
+        }
+
+        if (segment.x0 == segment.x1) &&
+           (segment.y0 + segment.y1 == 4) && (segment.y0 * segment.y1 == 0) {
+            acrossV++
+        }
+    }
+
+    if (acrossU != 5) || (acrossV != 0) {
+        t.Errorf("%d segments across the u seam and %d across the v seam, " +
+                 "want 5 and 0", acrossU, acrossV)
+    }
+
+    /*  Eight cells around, four along the axis, two triangles each.          */
+    if canvas.FaceCount() != 64 {
+        t.Errorf("%d faces, want 64", canvas.FaceCount())
+    }
+}
+/*  End of TestWrapCylinderClosesOnlyU.                                       */
@@ -0,0 +1,84 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Finite difference derivatives of the heights of a graph mesh.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Estimates the partial derivatives of z with respect to x and y at a       *
+ *  vertex of the grid. Central differences are used in the interior, and     *
+ *  one-sided differences on the boundary, with the neighbors found by        *
+ *  NeighborIndex. The step sizes are those of the uniform grid, counted      *
+ *  backwards along flipped axes, so the mesh should be a graph z = f(x, y)   *
+ *  generated by GenerateMeshFromParametrization. Axes with a single point    *
+ *  have zero derivative.                                                     */
+func (self *Canvas) heightDerivatives(xIndex, yIndex int) (float32, float32) {
+
+    /*  The output, the partial derivatives.                                  */
+    var dzdx, dzdy float32
+
+    /*  Returns the difference quotient of z between the neighbors dx columns *
+     *  and dy rows ahead and behind, which are step apart in the domain. A   *
+     *  neighbor beyond the edge is the vertex itself, and the span shrinks.  */
+    var difference = func(dx, dy int, step float32) float32 {
+        ahead, hasAhead := self.NeighborIndex(xIndex, yIndex, dx, dy)
+        behind, hasBehind := self.NeighborIndex(xIndex, yIndex, -dx, -dy)
+        var span float32 = 0.0
+
+        if hasAhead {
+            span += step
+        }
+
+        if hasBehind {
+            span += step
+        }
+
+        if span == 0.0 {
+            return 0.0
+        }
+
+        return (self.Mesh[3 * ahead + 2] - self.Mesh[3 * behind + 2]) / span
+    }
+
+    if self.NxPts > 1 {
+        var dx float32 = self.Width / float32(self.NxPts - 1)
+
+        if self.FlipU {
+            dx = -dx
+        }
+
+        dzdx = difference(1, 0, dx)
+    }
+
+    if self.NyPts > 1 {
+        var dy float32 = self.Height / float32(self.NyPts - 1)
+
+        if self.FlipV {
+            dy = -dy
+        }
+
+        dzdy = difference(0, 1, dy)
+    }
+
+    return dzdx, dzdy
+}
+/*  End of heightDerivatives.                                                 */
@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the finite differences of the heights of a grid.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The derivatives of a plane are exact everywhere, boundary included, for   *
+ *  every layout and with the axes walked in either direction.                */
+func TestHeightDerivativesPlane(t *testing.T) {
+    var plane = func(x, y float32) float32 {
+        return x + 2.0 * y
+    }
+
+    for _, columnMajor := range []bool{false, true} {
+        for _, flip := range []bool{false, true} {
+            var canvas *Canvas = newGraphCanvas(
+                t, plane, WithGrid(6, 4), WithColumnMajor(columnMajor),
+                WithFlip(flip, flip),
+            )
+
+            for yIndex := 0; yIndex < 4; yIndex++ {
+                for xIndex := 0; xIndex < 6; xIndex++ {
+                    dzdx, dzdy := canvas.heightDerivatives(xIndex, yIndex)
+
+                    if !closeTo(dzdx, 1.0, 1.0E-5) ||
+                       !closeTo(dzdy, 2.0, 1.0E-5) {
+                        t.Errorf("column major %v, flip %v: gradient (%v, %v)"+
+                                 " at (%d, %d), want (1, 2)", columnMajor,
+                                 flip, dzdx, dzdy, xIndex, yIndex)
+                    }
+                }
+            }
+        }
+    }
+}
+/*  End of TestHeightDerivativesPlane.                                        */
@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Counts the heights of a mesh in equal-width bins.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      HeightHistogram                                                       *
+ *  Purpose:                                                                  *
+ *      Counts the active vertices whose heights fall into each of a number   *
+ *      of equal-width bins between the smallest and largest height, see      *
+ *      HeightRange. The largest height goes in the last bin. If every height *
+ *      is the same, all of the vertices are in the first bin. Vertices with  *
+ *      non-finite heights are not counted.                                   *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being examined.                          *
+ *      bins (int):                                                           *
+ *          The number of bins.                                               *
+ *  Output:                                                                   *
+ *      counts ([]int):                                                       *
+ *          The number of vertices in each bin, lowest heights first.         *
+ *      err (error):                                                          *
+ *          ErrInvalidCount if bins is not positive.                          *
+ ******************************************************************************/
+func HeightHistogram(canvas *Canvas, bins int) ([]int, error) {
+
+    /*  Variable for indexing over the z coordinates.                         */
+    var index int
+
+    if bins <= 0 {
+        return nil, fmt.Errorf("%w: %d bins", ErrInvalidCount, bins)
+    }
+
+    var counts []int = make([]int, bins)
+    zMin, zMax := canvas.HeightRange()
+
+    /*  Converts a height to a bin, zero if the range is empty.               */
+    var scale float32 = 0.0
+
+    if zMax > zMin {
+        scale = float32(bins) / (zMax - zMin)
+    }
+
+    for index = 2; index < canvas.MeshSize; index += 3 {
+        var z float32 = canvas.Mesh[index]
+
+        if !isFinite(z) {
+            continue
+        }
+
+        var bin int = int((z - zMin) * scale)
+
+        /*  The largest height lands exactly on the end of the last bin.      */
+        if bin >= bins {
+            bin = bins - 1
+        } else if bin < 0 {
+            bin = 0
+        }
+
+        counts[bin]++
+    }
+
+    return counts, nil
+}
+/*  End of HeightHistogram.                                                   */
@@ -0,0 +1,79 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the histogram of the heights of a mesh.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A linear ramp, the height grows with x alone.                             */
+func ramp(x, y float32) float32 {
+    return x
+}
+/*  End of ramp.                                                              */
+
+/*  The heights of a ramp are spread evenly, so every bin gets the same       *
+ *  number of vertices, and every vertex is counted.                          */
+func TestHeightHistogramRampIsUniform(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, ramp, WithGrid(8, 3))
+
+    counts, err := HeightHistogram(canvas, 4)
+
+    if err != nil {
+        t.Fatalf("HeightHistogram: %v", err)
+    }
+
+    if len(counts) != 4 {
+        t.Fatalf("%d bins, want 4", len(counts))
+    }
+
+    /*  Two columns of three vertices fall into each bin.                     */
+    for bin, count := range counts {
+        if count != 6 {
+            t.Errorf("bin %d has %d vertices, want 6", bin, count)
+        }
+    }
+}
+/*  End of TestHeightHistogramRampIsUniform.                                  */
+
+/*  A number of bins that is not positive is rejected.                        */
+func TestHeightHistogramInvalidBins(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, ramp, WithGrid(8, 3))
+
+    for _, bins := range []int{0, -3} {
+        counts, err := HeightHistogram(canvas, bins)
+
+        if !errors.Is(err, ErrInvalidCount) {
+            t.Errorf("%d bins: got %v, want ErrInvalidCount", bins, err)
+        }
+
+        if counts != nil {
+            t.Errorf("%d bins: got counts %v, want nil", bins, counts)
+        }
+    }
+}
+/*  End of TestHeightHistogramInvalidBins.                                    */
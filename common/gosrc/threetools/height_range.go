@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the smallest and largest heights of a mesh.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      HeightRange                                                           *
+ *  Purpose:                                                                  *
+ *      Scans the z coordinates of the active vertices and returns the        *
+ *      smallest and largest values. This gives the range for normalizing a   *
+ *      colormap without guessing. Both are zero for an empty mesh.           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being examined.                          *
+ *  Output:                                                                   *
+ *      zMin (float32):                                                       *
+ *          The smallest height.                                              *
+ *      zMax (float32):                                                       *
+ *          The largest height.                                               *
+ ******************************************************************************/
+func (self *Canvas) HeightRange() (float32, float32) {
+
+    /*  Variable for indexing over the z coordinates.                         */
+    var index int
+
+    /*  The output, the range of the heights.                                 */
+    var zMin, zMax float32
+
+    if self.NumberOfPoints == 0 {
+        return zMin, zMax
+    }
+
+    zMin, zMax = self.Mesh[2], self.Mesh[2]
+
+    for index = 5; index < self.MeshSize; index += 3 {
+        if self.Mesh[index] < zMin {
+            zMin = self.Mesh[index]
+        } else if self.Mesh[index] > zMax {
+            zMax = self.Mesh[index]
+        }
+    }
+
+    return zMin, zMax
+}
+/*  End of HeightRange.                                                       */
@@ -0,0 +1,55 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the range of heights of the mesh.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Over the square [-1, 1] x [-1, 1] the paraboloid ranges from 0 at the     *
+ *  center to 2 at the corners, and the saddle from -1 to 1 at the middles of *
+ *  the edges. Both extremes are grid points for an odd grid.                 */
+func TestHeightRangeKnownSurfaces(t *testing.T) {
+    var cases = []struct {
+        name string
+        f SurfaceParametrization
+        zMin, zMax float32
+    }{
+        {"paraboloid", paraboloid, 0.0, 2.0},
+        {"saddle", saddle, -1.0, 1.0},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newGraphCanvas(
+            t, c.f, WithGrid(7, 7), WithDomain(2.0, 2.0, -1.0, -1.0),
+        )
+
+        zMin, zMax := canvas.HeightRange()
+
+        if !closeTo(zMin, c.zMin, 1.0E-6) || !closeTo(zMax, c.zMax, 1.0E-6) {
+            t.Errorf("%s: heights from %g to %g, want %g to %g",
+                     c.name, zMin, zMax, c.zMin, c.zMax)
+        }
+    }
+}
+/*  End of TestHeightRangeKnownSurfaces.                                      */
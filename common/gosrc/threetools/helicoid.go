@@ -0,0 +1,85 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Parametrization of the helicoid, a minimal surface.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos is provided here.                                                  */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Helicoid                                                              *
+ *  Purpose:                                                                  *
+ *      Returns the parametrization (v cos(u), v sin(u), c u) of the helicoid *
+ *      with pitch c. The surface rises by 2 pi c with each turn of u, and v  *
+ *      is the signed distance from the central axis.                         *
+ *  Arguments:                                                                *
+ *      pitch (float32):                                                      *
+ *          The rise per radian of u, the constant c.                         *
+ *  Output:                                                                   *
+ *      f (ParametricSurface):                                                *
+ *          The parametrization of the helicoid.                              *
+ ******************************************************************************/
+func Helicoid(pitch float32) ParametricSurface {
+    return func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(float64(u))
+        return [3]float32{v * float32(cosU), v * float32(sinU), pitch * u}
+    }
+}
+/*  End of Helicoid.                                                          */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateHelicoid                                                      *
+ *  Purpose:                                                                  *
+ *      Computes the mesh and wireframe of a helicoid with u over [0, 2 pi    *
+ *      turns] and v over [innerRadius, outerRadius]. The domain of the       *
+ *      canvas is set to match, the number of points must already be set.     *
+ *      The helicoid is not a graph, so the stored surface is cleared.        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid size already set.     *
+ *      pitch (float32):                                                      *
+ *          The rise per radian of u.                                         *
+ *      turns (float32):                                                      *
+ *          The number of times the surface winds around the axis.            *
+ *      innerRadius (float32):                                                *
+ *          The start of the v range, negative values cross the axis.         *
+ *      outerRadius (float32):                                                *
+ *          The end of the v range.                                           *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from the wireframe, if any.                             *
+ ******************************************************************************/
+func GenerateHelicoid(canvas *Canvas,
+                      pitch, turns, innerRadius, outerRadius float32) error {
+    canvas.HorizontalStart = 0.0
+    canvas.Width = 2.0 * math.Pi * turns
+    canvas.VerticalStart = innerRadius
+    canvas.Height = outerRadius - innerRadius
+    canvas.Surface = nil
+    canvas.GenerateMeshFromParametric(Helicoid(pitch))
+    return canvas.GenerateRectangularWireframe()
+}
+/*  End of GenerateHelicoid.                                                  */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the helicoid.                                               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi is provided by math, used for the angle of the helicoid.               */
+import (
+    "math"
+    "testing"
+)
+
+/*  The helicoid replaces a stored graph, and its vertices lie on the surface *
+ *  with the wireframe rebuilt.                                               */
+func TestGenerateHelicoid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 5))
+    var pitch float32 = 0.5
+
+    /*  The segments of the rows and columns of the grid.                     */
+    var wantIndexSize int = 2 * (8 * 5 + 4 * 9)
+
+    if err := GenerateHelicoid(canvas, pitch, 2.0, -1.0, 1.0); err != nil {
+        t.Fatalf("GenerateHelicoid: %v", err)
+    }
+
+    if canvas.Surface != nil {
+        t.Fatalf("the paraboloid is still stored after GenerateHelicoid")
+    }
+
+    /*  Regenerating must not draw the old graph over the helicoid.           */
+    if err := canvas.Regenerate(); err != nil {
+        t.Fatalf("Regenerate: %v", err)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var angle float64 = float64(p[2] / pitch)
+        var radius float32 = float32(math.Hypot(float64(p[0]), float64(p[1])))
+
+        if (angle < -1.0E-5) || (angle > 4.0 * math.Pi + 1.0E-4) {
+            t.Fatalf("vertex %v is outside of two turns", p)
+        }
+
+        if radius > 1.0 + 1.0E-6 {
+            t.Fatalf("vertex %v is outside of the unit radius", p)
+        }
+    }
+
+    if canvas.IndexSize != wantIndexSize {
+        t.Errorf("IndexSize = %d, want %d", canvas.IndexSize, wantIndexSize)
+    }
+}
+/*  End of TestGenerateHelicoid.                                              */
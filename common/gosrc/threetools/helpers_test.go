@@ -0,0 +1,188 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Helpers shared by the tests of the threetools package.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Abs is provided by math, used for comparing floats. flag gives the        *
+ *  -update option, os and filepath read and write the golden files.          */
+import (
+    "flag"
+    "math"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+/*  Creates a canvas with storage of its own and the given options, failing   *
+ *  the test if it could not be configured. The canvas is not registered, so  *
+ *  it is freed once the test is done with it.                                */
+func newTestCanvas(t *testing.T, options ...CanvasOption) *Canvas {
+    t.Helper()
+
+    var canvas *Canvas = &Canvas{
+        MeshStorage: make([]float32, MaxMeshBufferSize),
+        IndexStorage: make([]uint32, MaxIndexBufferSize),
+    }
+
+    if err := canvas.Configure(NewCanvasConfig(options...)); err != nil {
+        t.Fatalf("Configure: %v", err)
+    }
+
+    return canvas
+}
+/*  End of newTestCanvas.                                                     */
+
+/*  Creates a canvas with the graph of f on the given grid and domain, and    *
+ *  its wireframe.                                                            */
+func newGraphCanvas(t *testing.T, f SurfaceParametrization,
+                    options ...CanvasOption) *Canvas {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(t, options...)
+
+    if err := canvas.GenerateMeshFromParametrization(f); err != nil {
+        t.Fatalf("GenerateMeshFromParametrization: %v", err)
+    }
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    canvas.Surface = f
+    return canvas
+}
+/*  End of newGraphCanvas.                                                    */
+
+/*  The paraboloid z = x^2 + y^2, used by many of the tests.                  */
+func paraboloid(x, y float32) float32 {
+    return x*x + y*y
+}
+/*  End of paraboloid.                                                        */
+
+/*  The saddle z = x^2 - y^2, which curves up along x and down along y.       */
+func saddle(x, y float32) float32 {
+    return x*x - y*y
+}
+/*  End of saddle.                                                            */
+
+/*  Determines if two floats agree to within the tolerance.                   */
+func closeTo(a, b, tolerance float32) bool {
+    return math.Abs(float64(a) - float64(b)) <= float64(tolerance)
+}
+/*  End of closeTo.                                                           */
+
+/*  Fails the test if two vectors differ by more than the tolerance in any    *
+ *  component.                                                                */
+func expectVector(t *testing.T, name string, got, want [3]float32,
+                  tolerance float32) {
+    t.Helper()
+
+    for index := 0; index < 3; index++ {
+        if !closeTo(got[index], want[index], tolerance) {
+            t.Errorf("%s = %v, want %v", name, got, want)
+            return
+        }
+    }
+}
+/*  End of expectVector.                                                      */
+
+/*  Rewrites the golden files with the current output, run with go test -run  *
+ *  TestDumpMeshGolden -update after an intended change.                      */
+var updateGolden = flag.Bool("update", false, "rewrite the golden files")
+
+/*  Compares a dump against testdata/name.golden, or rewrites the file if the *
+ *  -update flag is set.                                                      */
+func expectGolden(t *testing.T, name, dump string) {
+    t.Helper()
+
+    var path string = filepath.Join("testdata", name + ".golden")
+
+    if *updateGolden {
+        if err := os.WriteFile(path, []byte(dump), 0644); err != nil {
+            t.Fatalf("writing %s: %v", path, err)
+        }
+
+        return
+    }
+
+    want, err := os.ReadFile(path)
+
+    if err != nil {
+        t.Fatalf("reading %s: %v", path, err)
+    }
+
+    if dump != string(want) {
+        t.Errorf("%s differs from the golden file:\n%s\nwant:\n%s",
+                 name, dump, want)
+    }
+}
+/*  End of expectGolden.                                                      */
+
+/*  Creates a canvas whose mesh is the given points, as a single row of the   *
+ *  grid, with the wireframe of the row.                                      */
+func newPointCanvas(t *testing.T, points [][3]float32) *Canvas {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(t, WithGrid(uint32(len(points)), 1))
+
+    for index, point := range points {
+        copy(canvas.Mesh[3 * index:3 * index + 3], point[:])
+    }
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    return canvas
+}
+/*  End of newPointCanvas.                                                    */
+
+/*  The grid coordinates of the two ends of a wireframe segment.              */
+type gridSegment struct {
+    x0, y0, x1, y1 uint32
+}
+
+/*  Returns the segments of a wireframe written as pairs of indices, in grid  *
+ *  coordinates, inverting gridIndex.                                         */
+func wireframeSegments(canvas *Canvas) []gridSegment {
+    var segments []gridSegment
+
+    var coordinates = func(index uint32) (uint32, uint32) {
+        if canvas.LayoutColumnMajor {
+            return index / canvas.NyPts, index % canvas.NyPts
+        }
+
+        return index % canvas.NxPts, index / canvas.NxPts
+    }
+
+    for index := 0; index + 1 < canvas.IndexSize; index += 2 {
+        var segment gridSegment
+        segment.x0, segment.y0 = coordinates(canvas.Indices[index])
+        segment.x1, segment.y1 = coordinates(canvas.Indices[index + 1])
+        segments = append(segments, segment)
+    }
+
+    return segments
+}
+/*  End of wireframeSegments.                                                 */
@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Converts a hue to a fully saturated RGB color.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Converts a hue in [0, 1), where 0 is red, 1/3 is green, and 2/3 is blue,  *
+ *  to the RGB color with full saturation and brightness. Hues outside of [0, *
+ *  1) wrap around the color wheel.                                           */
+func hueToRGB(hue float32) [3]float32 {
+
+    /*  The wheel is split into six sectors, one per pair of primaries.       */
+    var sector float32 = 6.0 * (hue - float32(int(hue)))
+
+    if sector < 0.0 {
+        sector += 6.0
+    }
+
+    /*  The position within the sector, rising from 0 to 1.                   */
+    var rise float32 = sector - float32(int(sector))
+    var fall float32 = 1.0 - rise
+
+    switch int(sector) {
+        case 0:
+            return [3]float32{1.0, rise, 0.0}
+        case 1:
+            return [3]float32{fall, 1.0, 0.0}
+        case 2:
+            return [3]float32{0.0, 1.0, rise}
+        case 3:
+            return [3]float32{0.0, fall, 1.0}
+        case 4:
+            return [3]float32{rise, 0.0, 1.0}
+        default:
+            return [3]float32{1.0, 0.0, fall}
+    }
+}
+/*  End of hueToRGB.                                                          */
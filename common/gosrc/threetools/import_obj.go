@@ -0,0 +1,187 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reads a mesh from a file in the Wavefront OBJ format.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+)
+
+/*  Converts an OBJ vertex reference to a zero based index. References may be *
+ *  of the form "v/vt/vn", only the vertex is used. Negative references count *
+ *  backwards from the most recent vertex.                                    */
+func parseOBJIndex(field string, numberOfVertices int) (uint32, error) {
+    var vertex string = strings.SplitN(field, "/", 2)[0]
+    value, err := strconv.Atoi(vertex)
+
+    if err != nil {
+        return 0, fmt.Errorf("threetools: invalid OBJ index %q", field)
+    }
+
+    if value < 0 {
+        value += numberOfVertices + 1
+    }
+
+    if (value < 1) || (value > numberOfVertices) {
+        return 0, fmt.Errorf("threetools: OBJ index %d out of range", value)
+    }
+
+    return uint32(value - 1), nil
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ImportOBJ                                                             *
+ *  Purpose:                                                                  *
+ *      Reads a Wavefront OBJ file into a canvas. The "v" lines become the    *
+ *      vertices of the mesh, the "l" lines become line segments of the index *
+ *      buffer, with polylines split into consecutive segments, and "f" lines *
+ *      are fanned into triangles of the face buffer. Other lines are         *
+ *      ignored. The result is not a grid, the canvas is treated as a single  *
+ *      row of NxPts = NumberOfPoints vertices, and the normals, colors, and  *
+ *      other per-vertex buffers are cleared. The canvas is only modified if  *
+ *      the whole file is read without error.                                 *
+ *  Arguments:                                                                *
+ *      r (io.Reader):                                                        *
+ *          The source of the OBJ file.                                       *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas the mesh is stored in.                                 *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          Non-nil if the file is malformed or does not fit the canvas.      *
+ ******************************************************************************/
+func ImportOBJ(r io.Reader, canvas *Canvas) error {
+
+    /*  The data is collected first, and copied into the canvas once it has   *
+     *  been validated.                                                       */
+    var vertices []float32
+    var indices, faces []uint32
+
+    /*  Variables for indexing over the fields of a line.                     */
+    var index int
+
+    var scanner *bufio.Scanner = bufio.NewScanner(r)
+
+    for scanner.Scan() {
+        var fields []string = strings.Fields(scanner.Text())
+
+        if len(fields) == 0 {
+            continue
+        }
+
+        switch fields[0] {
+            case "v":
+                if len(fields) < 4 {
+                    return fmt.Errorf("threetools: OBJ vertex needs 3 values")
+                }
+
+                for index = 1; index < 4; index++ {
+                    value, err := strconv.ParseFloat(fields[index], 32)
+
+                    if err != nil {
+                        return fmt.Errorf(
+                            "threetools: invalid OBJ coordinate %q",
+                            fields[index],
+                        )
+                    }
+
+                    vertices = append(vertices, float32(value))
+                }
+
+                if len(vertices) > 3 * int(MaxLength) {
+                    return fmt.Errorf(
+                        "%w: OBJ file has more than %d vertices",
+                        ErrGridTooLarge, MaxLength,
+                    )
+                }
+
+            case "l", "f":
+                var references []uint32 = make([]uint32, len(fields) - 1)
+                var numberOfVertices int = len(vertices) / 3
+
+                for index = 1; index < len(fields); index++ {
+                    value, err := parseOBJIndex(fields[index], numberOfVertices)
+
+                    if err != nil {
+                        return err
+                    }
+
+                    references[index - 1] = value
+                }
+
+                if fields[0] == "l" {
+                    for index = 0; index + 1 < len(references); index++ {
+                        indices = append(
+                            indices, references[index], references[index + 1],
+                        )
+                    }
+                } else {
+                    for index = 1; index + 1 < len(references); index++ {
+                        faces = append(
+                            faces,
+                            references[0],
+                            references[index],
+                            references[index + 1],
+                        )
+                    }
+                }
+        }
+    }
+
+    if err := scanner.Err(); err != nil {
+        return err
+    }
+
+    /*  Grow the storage if allowed, a mesh that still does not fit is        *
+     *  rejected below.                                                       */
+    canvas.ReserveStorage(uint64(len(vertices)), uint64(len(indices)))
+
+    if (len(vertices) > len(canvas.MeshStorage)) ||
+       (len(indices) > len(canvas.IndexStorage)) {
+        return fmt.Errorf(
+            "%w: OBJ file does not fit in the canvas", ErrBufferTooSmall,
+        )
+    }
+
+    /*  Everything is valid, store the mesh in the canvas.                    */
+    canvas.NumberOfPoints = len(vertices) / 3
+    canvas.MeshSize = len(vertices)
+    canvas.IndexSize = len(indices)
+    canvas.NxPts = uint32(canvas.NumberOfPoints)
+    canvas.NyPts = 1
+
+    canvas.Mesh = canvas.MeshStorage[0:canvas.MeshSize]
+    canvas.Indices = canvas.IndexStorage[0:canvas.IndexSize]
+    copy(canvas.Mesh, vertices)
+    copy(canvas.Indices, indices)
+
+    canvas.clearDerivedBuffers()
+    canvas.Faces = faces
+    return nil
+}
+/*  End of ImportOBJ.                                                         */
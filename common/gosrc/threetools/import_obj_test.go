@@ -0,0 +1,94 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for reading meshes in the Wavefront OBJ format.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  strings wraps the OBJ text in a reader.                                   */
+import (
+    "strings"
+    "testing"
+)
+
+/*  Exporting a small wireframe with faces and importing it into another      *
+ *  canvas gives back the same vertices, segments, and triangles. The floats  *
+ *  are written in their shortest exact form, so they agree exactly.          */
+func TestImportOBJRoundTrip(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    canvas.GenerateTriangleFaces()
+
+    var imported *Canvas = newTestCanvas(t)
+    var obj string = ExportOBJ(canvas)
+
+    if err := ImportOBJ(strings.NewReader(obj), imported); err != nil {
+        t.Fatalf("ImportOBJ: %v", err)
+    }
+
+    if (imported.NumberOfPoints != canvas.NumberOfPoints) ||
+       (imported.IndexSize != canvas.IndexSize) ||
+       (len(imported.Faces) != len(canvas.Faces)) {
+        t.Fatalf("imported %d points, %d indices, %d face indices, " +
+                 "want %d, %d, %d", imported.NumberOfPoints,
+                 imported.IndexSize, len(imported.Faces),
+                 canvas.NumberOfPoints, canvas.IndexSize, len(canvas.Faces))
+    }
+
+    for index := 0; index < canvas.MeshSize; index++ {
+        if imported.Mesh[index] != canvas.Mesh[index] {
+            t.Fatalf("mesh entry %d is %g, want %g", index,
+                     imported.Mesh[index], canvas.Mesh[index])
+        }
+    }
+
+    for index := 0; index < canvas.IndexSize; index++ {
+        if imported.Indices[index] != canvas.Indices[index] {
+            t.Fatalf("index %d is %d, want %d", index,
+                     imported.Indices[index], canvas.Indices[index])
+        }
+    }
+
+    for index, face := range canvas.Faces {
+        if imported.Faces[index] != face {
+            t.Fatalf("face index %d is %d, want %d",
+                     index, imported.Faces[index], face)
+        }
+    }
+}
+/*  End of TestImportOBJRoundTrip.                                            */
+
+/*  A file referring to a vertex that does not exist is rejected, and the     *
+ *  canvas keeps its mesh.                                                    */
+func TestImportOBJInvalidIndex(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var dump string = DumpMesh(canvas)
+    var obj string = "v 0 0 0\nv 1 0 0\nl 1 3\n"
+
+    if err := ImportOBJ(strings.NewReader(obj), canvas); err == nil {
+        t.Error("ImportOBJ accepted a segment to a missing vertex")
+    }
+
+    if DumpMesh(canvas) != dump {
+        t.Error("the failed import changed the canvas")
+    }
+}
+/*  End of TestImportOBJInvalidIndex.                                         */
@@ -24,14 +24,13 @@
  ******************************************************************************/
 package threetools
 
-/*  The Pointer type is provided here, which gets an address from an array.   */
-import "unsafe"
-
 /******************************************************************************
  *  Function:                                                                 *
  *      IndexBufferAddress                                                    *
  *  Purpose:                                                                  *
- *      Returns the address of the global index buffer.                       *
+ *      Returns the address of the global index buffer. The buffer is         *
+ *      reallocated when the main canvas grows, see ReserveStorage, so this   *
+ *      should be called again after the resolution is increased.             *
  *  Arguments:                                                                *
  *      None.                                                                 *
  *  Output:                                                                   *
@@ -40,8 +39,7 @@ import "unsafe"
  ******************************************************************************/
 func IndexBufferAddress() uintptr {
 
-    /*  Get a pointer for the array and then convert this into an integer,    *
-     *  which is the address of the array.                                    */
-    return uintptr(unsafe.Pointer(&IndexBuffer))
+    /*  The address of the first element of the current backing array.        */
+    return SliceAddress(IndexBuffer)
 }
 /*  End of IndexBufferAddress.                                                */
@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Encodes the indices of a canvas as little-endian bytes.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for writing little-endian integers.              */
+import "encoding/binary"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      IndexBytes                                                            *
+ *  Purpose:                                                                  *
+ *      Encodes the active portion of the index buffer as raw bytes, each     *
+ *      index written as four little-endian bytes regardless of the byte      *
+ *      order of the host, the layout of a Uint32Array. See MeshBytes.        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose indices are being encoded.                       *
+ *  Output:                                                                   *
+ *      data ([]byte):                                                        *
+ *          The indices, four bytes per index.                                *
+ ******************************************************************************/
+func IndexBytes(canvas *Canvas) []byte {
+
+    /*  Variable for indexing over the indices.                               */
+    var index int
+
+    var data []byte = make([]byte, 4 * canvas.IndexSize)
+
+    for index = 0; index < canvas.IndexSize; index++ {
+        binary.LittleEndian.PutUint32(data[4 * index:], canvas.Indices[index])
+    }
+
+    return data
+}
+/*  End of IndexBytes.                                                        */
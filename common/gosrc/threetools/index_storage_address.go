@@ -0,0 +1,44 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Returns the address of the memory backing the indices of a canvas.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      IndexStorageAddress                                                   *
+ *  Purpose:                                                                  *
+ *      Returns the address of the index buffer of a canvas. For the main     *
+ *      canvas this is the same as IndexBufferAddress.                        *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose index buffer address is being computed.          *
+ *  Output:                                                                   *
+ *      address (uintptr):                                                    *
+ *          The address of the index buffer, or zero if the canvas has no     *
+ *          buffer.                                                           *
+ ******************************************************************************/
+func (self *Canvas) IndexStorageAddress() uintptr {
+    return SliceAddress(self.IndexStorage)
+}
+/*  End of IndexStorageAddress.                                               */
@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Numerically integrates a function over the domain of a canvas.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Integrate                                                             *
+ *  Purpose:                                                                  *
+ *      Approximates the double integral of g(x, y) over the domain of a      *
+ *      canvas with the composite trapezoidal rule, using the points of the   *
+ *      grid as the quadrature nodes, see integrateGrid. The error is O(dx^2  *
+ *      + dy^2) for smooth g, and functions that are linear in each variable  *
+ *      are integrated exactly up to rounding, so g = 1 gives the area Width  *
+ *      * Height.                                                             *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose domain and grid are used.                        *
+ *      g (SurfaceParametrization):                                           *
+ *          The function being integrated.                                    *
+ *  Output:                                                                   *
+ *      integral (float32):                                                   *
+ *          The approximate integral, zero for an empty grid.                 *
+ ******************************************************************************/
+func Integrate(canvas *Canvas, g SurfaceParametrization) float32 {
+
+    /*  Step sizes in the horizontal and vertical axes.                       */
+    var dx float32 = canvas.Width / float32(canvas.NxPts - 1)
+    var dy float32 = canvas.Height / float32(canvas.NyPts - 1)
+
+    /*  The nodes are the points of the grid, as in the mesh generators.      */
+    var sample = func(xIndex, yIndex uint32) float64 {
+        var xPt float32 = canvas.HorizontalStart + float32(xIndex) * dx
+        var yPt float32 = canvas.VerticalStart + float32(yIndex) * dy
+        return float64(g(xPt, yPt))
+    }
+
+    return float32(integrateGrid(canvas, sample))
+}
+/*  End of Integrate.                                                         */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Applies the trapezoidal rule to samples on the grid of a canvas.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      integrateGrid                                                         *
+ *  Purpose:                                                                  *
+ *      Sums samples on the grid of a canvas with the weights of the          *
+ *      composite trapezoidal rule. In each direction the endpoints get half  *
+ *      the weight of the interior points, and the weight of a node is the    *
+ *      product of its two one dimensional weights. The sum is in double      *
+ *      precision.                                                            *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose domain and grid are used.                        *
+ *      sample (func(xIndex, yIndex uint32) float64):                         *
+ *          The value at a node.                                              *
+ *  Output:                                                                   *
+ *      integral (float64):                                                   *
+ *          The weighted sum, zero for a degenerate grid.                     *
+ ******************************************************************************/
+func integrateGrid(canvas *Canvas,
+                   sample func(xIndex, yIndex uint32) float64) float64 {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  The sum of the weighted samples.                                      */
+    var sum float64 = 0.0
+
+    /*  The trapezoidal rule needs at least two points along each axis.       */
+    if (canvas.NxPts < 2) || (canvas.NyPts < 2) {
+        return 0.0
+    }
+
+    var dx float64 = float64(canvas.Width) / float64(canvas.NxPts - 1)
+    var dy float64 = float64(canvas.Height) / float64(canvas.NyPts - 1)
+
+    for yIndex = 0; yIndex < canvas.NyPts; yIndex++ {
+        var yWeight float64 = trapezoidWeight(yIndex, canvas.NyPts, dy)
+
+        for xIndex = 0; xIndex < canvas.NxPts; xIndex++ {
+            var xWeight float64 = trapezoidWeight(xIndex, canvas.NxPts, dx)
+            sum += xWeight * yWeight * sample(xIndex, yIndex)
+        }
+    }
+
+    return sum
+}
+/*  End of integrateGrid.                                                     */
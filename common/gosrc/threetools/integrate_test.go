@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the trapezoidal integration over the domain of a canvas.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The constant function one.                                                */
+func constantOne(x, y float32) float32 {
+    return 1.0
+}
+/*  End of constantOne.                                                       */
+
+/*  Integrating g = 1 gives the area of the domain, Width * Height, for any   *
+ *  grid and domain, including one that is not centered at the origin.        */
+func TestIntegrateOneGivesArea(t *testing.T) {
+    var cases = []struct {
+        nxPts, nyPts uint32
+        width, height, xStart, yStart float32
+    }{
+        {2, 2, 2.0, 2.0, -1.0, -1.0},
+        {17, 9, 2.0, 2.0, -1.0, -1.0},
+        {33, 12, 3.0, 0.5, 1.0, -4.0},
+        {64, 64, 10.0, 7.0, -2.0, 3.0},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(c.nxPts, c.nyPts),
+            WithDomain(c.width, c.height, c.xStart, c.yStart),
+        )
+
+        var area float32 = c.width * c.height
+        var got float32 = Integrate(canvas, constantOne)
+
+        if !closeTo(got, area, 1.0E-5 * area) {
+            t.Errorf("%dx%d grid on %gx%g: integral of 1 is %g, want %g",
+                     c.nxPts, c.nyPts, c.width, c.height, got, area)
+        }
+    }
+}
+/*  End of TestIntegrateOneGivesArea.                                         */
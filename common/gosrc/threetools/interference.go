@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the interference pattern of two point sources.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Hypot and Sin are provided here.                                          */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Interference                                                          *
+ *  Purpose:                                                                  *
+ *      Returns the surface z = sin(k d1) + sin(k d2), where d1 and d2 are    *
+ *      the distances from (x, y) to the two sources. This is the pattern of  *
+ *      two in-phase waves of wavenumber k. Along the perpendicular bisector  *
+ *      of the sources d1 = d2 and the waves always add, with crests of       *
+ *      height 2.                                                             *
+ *  Arguments:                                                                *
+ *      source1 ([2]float32):                                                 *
+ *          The location of the first source.                                 *
+ *      source2 ([2]float32):                                                 *
+ *          The location of the second source.                                *
+ *      k (float32):                                                          *
+ *          The wavenumber of the waves.                                      *
+ *  Output:                                                                   *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface.                                                      *
+ ******************************************************************************/
+func Interference(source1, source2 [2]float32,
+                  k float32) SurfaceParametrization {
+    return func(x, y float32) float32 {
+        var d1 float64 = math.Hypot(float64(x - source1[0]),
+                                    float64(y - source1[1]))
+        var d2 float64 = math.Hypot(float64(x - source2[0]),
+                                    float64(y - source2[1]))
+
+        return float32(math.Sin(float64(k) * d1) + math.Sin(float64(k) * d2))
+    }
+}
+/*  End of Interference.                                                      */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateInterference                                                  *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the interference pattern of two point        *
+ *      sources over the grid of the canvas, see Interference. The surface is *
+ *      stored in the canvas so Regenerate redraws it. The pattern is also in *
+ *      the registry as "interference", with coefficients for the wavenumber  *
+ *      and the two sources.                                                  *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid already set up.       *
+ *      source1 ([2]float32):                                                 *
+ *          The location of the first source.                                 *
+ *      source2 ([2]float32):                                                 *
+ *          The location of the second source.                                *
+ *      k (float32):                                                          *
+ *          The wavenumber of the waves.                                      *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from GenerateMeshFromParametrization, if any.           *
+ ******************************************************************************/
+func GenerateInterference(canvas *Canvas,
+                          source1, source2 [2]float32, k float32) error {
+    canvas.Surface = Interference(source1, source2, k)
+    return canvas.GenerateMeshFromParametrization(canvas.Surface)
+}
+/*  End of GenerateInterference.                                              */
@@ -0,0 +1,60 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the two-source interference surface.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi, Hypot, and Sin are provided by math.                                  */
+import (
+    "math"
+    "testing"
+)
+
+/*  With in-phase sources at (-1, 0) and (1, 0) the two waves are equal along *
+ *  the bisector x = 0, so the surface is twice a single wave there, with a   *
+ *  crest of height 2 at the origin for k = pi / 2. On the axis past a source *
+ *  the path difference is 2, half a wavelength, and the waves cancel.        */
+func TestInterferenceConstructiveOnBisector(t *testing.T) {
+    const k float32 = 0.5 * math.Pi
+    var f SurfaceParametrization = Interference(
+        [2]float32{-1.0, 0.0}, [2]float32{1.0, 0.0}, k,
+    )
+
+    if z := f(0.0, 0.0); !closeTo(z, 2.0, 1.0E-6) {
+        t.Errorf("f(0, 0) = %g, want the crest 2", z)
+    }
+
+    for _, y := range []float32{-2.5, -0.75, 0.3, 1.0, 4.0} {
+        var d float64 = math.Hypot(1.0, float64(y))
+        var want float32 = float32(2.0 * math.Sin(float64(k) * d))
+
+        if z := f(0.0, y); !closeTo(z, want, 1.0E-6) {
+            t.Errorf("f(0, %g) = %g, want twice one wave, %g", y, z, want)
+        }
+    }
+
+    if z := f(2.0, 0.0); !closeTo(z, 0.0, 1.0E-6) {
+        t.Errorf("f(2, 0) = %g, want the waves to cancel", z)
+    }
+}
+/*  End of TestInterferenceConstructiveOnBisector.                            */
@@ -0,0 +1,99 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the discrete Laplacian of the heights of a grid.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Laplacian                                                             *
+ *  Purpose:                                                                  *
+ *      Stores the Laplacian z_xx + z_yy of a graph mesh in the scalar field, *
+ *      one value per vertex, estimated with the five point stencil, the sum  *
+ *      of the four neighbors minus four times the vertex, with the step      *
+ *      sizes of the uniform grid. Positive values are where the surface is   *
+ *      locally convex, negative where it is concave, and z = x^2 + y^2 gives *
+ *      4 everywhere. The stencil needs a neighbor on each side, so vertices  *
+ *      on the boundary of the grid are set to zero, and an axis with fewer   *
+ *      than three points adds nothing. Nothing is done if the mesh is not a  *
+ *      full grid.                                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose Laplacian is being computed.                     *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) Laplacian() {
+
+    /*  Variables for indexing over the grid.                                 */
+    var xIndex, yIndex uint32
+
+    /*  The reciprocals of the squares of the step sizes, zero for an axis    *
+     *  without interior points.                                              */
+    var xScale, yScale float32
+
+    if int(self.NxPts * self.NyPts) != self.NumberOfPoints {
+        return
+    }
+
+    if self.NxPts > 2 {
+        var dx float32 = self.Width / float32(self.NxPts - 1)
+        xScale = 1.0 / (dx * dx)
+    }
+
+    if self.NyPts > 2 {
+        var dy float32 = self.Height / float32(self.NyPts - 1)
+        yScale = 1.0 / (dy * dy)
+    }
+
+    /*  Height of the vertex at the given grid point.                         */
+    var z = func(x, y uint32) float32 {
+        return self.Mesh[3 * self.gridIndex(x, y) + 2]
+    }
+
+    self.ScalarField = resizeBuffer(self.ScalarField, self.NumberOfPoints)
+
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            var index uint32 = self.gridIndex(xIndex, yIndex)
+            var value float32 = 0.0
+
+            self.ScalarField[index] = 0.0
+
+            if (xIndex == 0) || (xIndex + 1 == self.NxPts) ||
+               (yIndex == 0) || (yIndex + 1 == self.NyPts) {
+                continue
+            }
+
+            var center float32 = z(xIndex, yIndex)
+
+            value += xScale * (z(xIndex - 1, yIndex) - 2.0 * center +
+                               z(xIndex + 1, yIndex))
+            value += yScale * (z(xIndex, yIndex - 1) - 2.0 * center +
+                               z(xIndex, yIndex + 1))
+
+            self.ScalarField[index] = value
+        }
+    }
+}
+/*  End of Laplacian.                                                         */
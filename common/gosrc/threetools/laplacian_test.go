@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the five point Laplacian of the heights.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Checks the Laplacian of the graph of f on a 9x7 grid with unequal steps   *
+ *  along the axes. Interior vertices have the given value and boundary       *
+ *  vertices are zero.                                                        */
+func expectLaplacian(t *testing.T, name string,
+                     f SurfaceParametrization, want float32) {
+    t.Helper()
+
+    var canvas *Canvas = newGraphCanvas(
+        t, f, WithGrid(9, 7), WithDomain(3.0, 2.0, -1.5, -1.0),
+    )
+
+    canvas.Laplacian()
+
+    if len(canvas.ScalarField) != canvas.NumberOfPoints {
+        t.Fatalf("%s: %d values for %d vertices", name,
+                 len(canvas.ScalarField), canvas.NumberOfPoints)
+    }
+
+    for yIndex := uint32(0); yIndex < 7; yIndex++ {
+        for xIndex := uint32(0); xIndex < 9; xIndex++ {
+            var index uint32 = canvas.gridIndex(xIndex, yIndex)
+            var value float32 = canvas.ScalarField[index]
+            var expected float32 = want
+
+            if (xIndex == 0) || (xIndex == 8) ||
+               (yIndex == 0) || (yIndex == 6) {
+                expected = 0.0
+            }
+
+            if !closeTo(value, expected, 1.0E-3) {
+                t.Errorf("%s: Laplacian at (%d, %d) is %g, want %g",
+                         name, xIndex, yIndex, value, expected)
+            }
+        }
+    }
+}
+/*  End of expectLaplacian.                                                   */
+
+/*  The second differences of a quadratic are exact, so the paraboloid has    *
+ *  Laplacian 4 across the whole interior, and the saddle has 0.              */
+func TestLaplacianQuadratics(t *testing.T) {
+    expectLaplacian(t, "paraboloid", paraboloid, 4.0)
+    expectLaplacian(t, "saddle", saddle, 0.0)
+}
+/*  End of TestLaplacianQuadratics.                                           */
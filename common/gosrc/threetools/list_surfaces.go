@@ -0,0 +1,52 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Lists the surfaces in the registry.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package used for listing the surfaces in a fixed order.  */
+import "sort"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ListSurfaces                                                          *
+ *  Purpose:                                                                  *
+ *      Returns the names of the registered surfaces, sorted, including any   *
+ *      added by RegisterSurface. Each may be drawn by GenerateSurfacePreset. *
+ *  Arguments:                                                                *
+ *      None.                                                                 *
+ *  Output:                                                                   *
+ *      names ([]string):                                                     *
+ *          The names of the surfaces.                                        *
+ ******************************************************************************/
+func ListSurfaces() []string {
+    var names []string = make([]string, 0, len(SurfacePresets))
+
+    for name := range SurfacePresets {
+        names = append(names, name)
+    }
+
+    sort.Strings(names)
+    return names
+}
+/*  End of ListSurfaces.                                                      */
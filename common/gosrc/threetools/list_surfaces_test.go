@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for listing and looking up the registered surfaces.             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  StringsAreSorted checks the order of the names.                           */
+import (
+    "sort"
+    "testing"
+)
+
+/*  Every listed surface, in sorted order, draws a mesh with no NaN or        *
+ *  infinity, so the whole catalog is checked at once.                        */
+func TestListSurfacesAllFinite(t *testing.T) {
+    var names []string = ListSurfaces()
+
+    if (len(names) != len(SurfacePresets)) || !sort.StringsAreSorted(names) {
+        t.Fatalf("ListSurfaces = %v, want the %d presets sorted",
+                 names, len(SurfacePresets))
+    }
+
+    for _, name := range names {
+        var canvas *Canvas = newTestCanvas(t, WithGrid(9, 9))
+
+        if err := canvas.GenerateSurfacePreset(name); err != nil {
+            t.Errorf("GenerateSurfacePreset(%q) = %v", name, err)
+            continue
+        }
+
+        for index := 0; index < canvas.MeshSize; index++ {
+            if !isFinite(canvas.Mesh[index]) {
+                t.Errorf("%q: mesh entry %d is %g",
+                         name, index, canvas.Mesh[index])
+                break
+            }
+        }
+    }
+}
+/*  End of TestListSurfacesAllFinite.                                         */
+
+/*  A graph is found by name and can be evaluated, while an unknown name is   *
+ *  not found.                                                                */
+func TestGetSurface(t *testing.T) {
+    f, found := GetSurface("plane")
+
+    if !found || (f == nil) || (f(0.3, -0.7) != 0.0) {
+        t.Errorf("plane: found %t, want the surface z = 0", found)
+    }
+
+    if f, found = GetSurface("no_such_surface"); found || (f != nil) {
+        t.Errorf("unknown surface found")
+    }
+}
+/*  End of TestGetSurface.                                                    */
@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Restores the mesh of a canvas from a base64 string.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "encoding/base64"
+    "encoding/binary"
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      LoadMeshBase64                                                        *
+ *  Purpose:                                                                  *
+ *      Decodes a mesh created by MeshBase64 and copies it into the mesh      *
+ *      buffer. The snapshot only holds the vertices, so the canvas must      *
+ *      already be set up with the same number of points, for example by the  *
+ *      same setupMesh call, for the index buffer to remain valid. The mesh   *
+ *      is left unchanged if the string is invalid or the sizes do not match. *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose mesh is being restored.                          *
+ *      encoded (string):                                                     *
+ *          The base64 encoding of the mesh.                                  *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          Non-nil if the string could not be loaded.                        *
+ ******************************************************************************/
+func (self *Canvas) LoadMeshBase64(encoded string) error {
+
+    /*  Variable for indexing over the floats of the mesh.                    */
+    var index int
+
+    raw, err := base64.StdEncoding.DecodeString(encoded)
+
+    if err != nil {
+        return err
+    }
+
+    if len(raw) != 4 * self.MeshSize {
+        return fmt.Errorf(
+            "%w: mesh has %d bytes, the canvas needs %d",
+            ErrSizeMismatch, len(raw), 4 * self.MeshSize,
+        )
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
+    for index = 0; index < self.MeshSize; index++ {
+        var bits uint32 = binary.LittleEndian.Uint32(raw[4 * index:])
+        self.Mesh[index] = math.Float32frombits(bits)
+    }
+
+    return nil
+}
+/*  End of LoadMeshBase64.                                                    */
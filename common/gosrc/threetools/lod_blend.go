@@ -0,0 +1,175 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Blends between two levels of detail of a mesh.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the point of a coarse grid at the fractional grid coordinates     *
+ *  (s, t), interpolating bilinearly between the four surrounding vertices.   */
+func coarseGridPoint(coarse *Canvas, s, t float32) [3]float32 {
+    var point [3]float32
+    var xIndex uint32 = uint32(s)
+    var yIndex uint32 = uint32(t)
+
+    /*  The last row and column have no cell above or to the right.           */
+    if xIndex > coarse.NxPts - 2 {
+        xIndex = coarse.NxPts - 2
+    }
+
+    if yIndex > coarse.NyPts - 2 {
+        yIndex = coarse.NyPts - 2
+    }
+
+    var a float32 = s - float32(xIndex)
+    var b float32 = t - float32(yIndex)
+    var index00 uint32 = coarse.gridIndex(xIndex, yIndex)
+    index10, index01, index11 := coarse.gridNeighbors(xIndex, yIndex)
+
+    var p00 [3]float32 = coarse.point(int(index00))
+    var p10 [3]float32 = coarse.point(int(index10))
+    var p01 [3]float32 = coarse.point(int(index01))
+    var p11 [3]float32 = coarse.point(int(index11))
+
+    for axis := 0; axis < 3; axis++ {
+        var bottom float32 = p00[axis] + a * (p10[axis] - p00[axis])
+        var top float32 = p01[axis] + a * (p11[axis] - p01[axis])
+        point[axis] = bottom + b * (top - bottom)
+    }
+
+    return point
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BeginLODBlend                                                         *
+ *  Purpose:                                                                  *
+ *      Starts a smooth change between a coarse and a fine level of detail,   *
+ *      for example between a mesh and its Downsample. The canvas holds the   *
+ *      fine mesh, and the coarse canvas a coarser grid over the same domain. *
+ *      Each fine vertex is given a start position on the coarse mesh, found  *
+ *      by interpolating the coarse grid, so vertices shared by both grids    *
+ *      start where the coarse mesh has them and the rest start on the coarse *
+ *      cells. Both positions are kept until BlendLOD finishes the blend. The *
+ *      fine wireframe is used throughout, so the vertex count does not       *
+ *      change until the blend is over.                                       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the fine mesh.                                    *
+ *      coarse (*Canvas):                                                     *
+ *          The canvas with the coarse mesh.                                  *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooSmall or the error from ValidateBuffers.                *
+ ******************************************************************************/
+func (self *Canvas) BeginLODBlend(coarse *Canvas) error {
+
+    /*  Variables for indexing over the fine grid.                            */
+    var xIndex, yIndex uint32
+
+    /*  Both grids need a cell to interpolate over.                           */
+    if (self.NxPts < 2) || (self.NyPts < 2) ||
+       (coarse.NxPts < 2) || (coarse.NyPts < 2) {
+        return ErrGridTooSmall
+    }
+
+    if err := self.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    if err := coarse.ValidateBuffers(); err != nil {
+        return err
+    }
+
+    /*  The ratio of the grid spacings along each axis.                       */
+    var xScale float32 = float32(coarse.NxPts - 1) / float32(self.NxPts - 1)
+    var yScale float32 = float32(coarse.NyPts - 1) / float32(self.NyPts - 1)
+
+    self.LODStart = resizeBuffer(self.LODStart, self.MeshSize)
+    self.LODEnd = resizeBuffer(self.LODEnd, self.MeshSize)
+    copy(self.LODEnd, self.Mesh)
+
+    for yIndex = 0; yIndex < self.NyPts; yIndex++ {
+        for xIndex = 0; xIndex < self.NxPts; xIndex++ {
+            var s float32 = float32(xIndex) * xScale
+            var t float32 = float32(yIndex) * yScale
+            var point [3]float32 = coarseGridPoint(coarse, s, t)
+            var index uint32 = 3 * self.gridIndex(xIndex, yIndex)
+
+            copy(self.LODStart[index:index + 3], point[:])
+        }
+    }
+
+    return nil
+}
+/*  End of BeginLODBlend.                                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      BlendLOD                                                              *
+ *  Purpose:                                                                  *
+ *      Moves the vertices between their coarse and fine positions, see       *
+ *      BeginLODBlend, using smoothstep of t so the motion starts and ends at *
+ *      rest. A time of 0 gives the coarse mesh and 1 the fine mesh. Once t   *
+ *      reaches 1 the blend is committed, the fine mesh is restored exactly   *
+ *      and the stored positions are released. To go from fine to coarse, run *
+ *      t from 1 down to 0 and then call Downsample. Nothing is done if no    *
+ *      blend was started.                                                    *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being blended.                                         *
+ *      t (float32):                                                          *
+ *          The progress of the blend, clamped to [0, 1].                     *
+ *  Output:                                                                   *
+ *      done (bool):                                                          *
+ *          True if the blend was committed.                                  *
+ ******************************************************************************/
+func (self *Canvas) BlendLOD(t float32) bool {
+
+    /*  Variable for indexing over the mesh.                                  */
+    var index int
+
+    if (len(self.LODStart) != self.MeshSize) ||
+       (len(self.LODEnd) != self.MeshSize) {
+        return false
+    }
+
+    /*  The base orientation is a copy of the previous mesh.                  */
+    self.invalidateBaseOrientation()
+
+    if t >= 1.0 {
+        copy(self.Mesh, self.LODEnd)
+        self.LODStart = self.LODStart[:0]
+        self.LODEnd = self.LODEnd[:0]
+        return true
+    }
+
+    var weight float32 = EaseSmoothstep(clampUnit(t))
+
+    for index = 0; index < self.MeshSize; index++ {
+        var start float32 = self.LODStart[index]
+        self.Mesh[index] = start + weight * (self.LODEnd[index] - start)
+    }
+
+    return false
+}
+/*  End of BlendLOD.                                                          */
@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the smooth change between levels of detail.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Blending at t = 0 gives the coarse mesh, the vertices shared by both      *
+ *  grids are where the coarse mesh has them, and at t = 1 the fine mesh is   *
+ *  restored exactly and the blend is committed.                              */
+func TestBlendLODEndpoints(t *testing.T) {
+    var fine *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 7))
+    var coarse *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+    var original []float32 = append([]float32(nil), fine.Mesh...)
+
+    if err := fine.BeginLODBlend(coarse); err != nil {
+        t.Fatalf("BeginLODBlend: %v", err)
+    }
+
+    if fine.BlendLOD(0.0) {
+        t.Fatalf("blend committed at t = 0")
+    }
+
+    for yIndex := uint32(0); yIndex < coarse.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < coarse.NxPts; xIndex++ {
+            var fineIndex uint32 = fine.gridIndex(2 * xIndex, 2 * yIndex)
+            var coarseIndex uint32 = coarse.gridIndex(xIndex, yIndex)
+
+            expectVector(t, "shared vertex", fine.point(int(fineIndex)),
+                         coarse.point(int(coarseIndex)), 1.0E-6)
+        }
+    }
+
+    if !fine.BlendLOD(1.0) {
+        t.Fatalf("blend not committed at t = 1")
+    }
+
+    for index := range original {
+        if fine.Mesh[index] != original[index] {
+            t.Fatalf("mesh[%d] = %g after the blend, want %g",
+                     index, fine.Mesh[index], original[index])
+        }
+    }
+
+    if (len(fine.LODStart) != 0) || (len(fine.LODEnd) != 0) {
+        t.Errorf("the stored positions were not released")
+    }
+
+    if fine.BlendLOD(0.5) {
+        t.Errorf("a committed blend was blended again")
+    }
+}
+/*  End of TestBlendLODEndpoints.                                             */
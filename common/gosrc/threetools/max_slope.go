@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the steepest slope of a graph mesh.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt is provided here.                                                    */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MaxSlope                                                              *
+ *  Purpose:                                                                  *
+ *      Computes the largest gradient magnitude, sqrt(z_x^2 + z_y^2), over    *
+ *      the vertices of a graph mesh. The partial derivatives are estimated   *
+ *      with finite differences between neighboring grid points, central in   *
+ *      the interior and one-sided on the boundary. Zero is returned if the   *
+ *      mesh is not a full grid.                                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being examined.                          *
+ *  Output:                                                                   *
+ *      slope (float32):                                                      *
+ *          The largest slope of the surface.                                 *
+ ******************************************************************************/
+func (self *Canvas) MaxSlope() float32 {
+
+    /*  Variables for indexing over the grid.                                 */
+    var xIndex, yIndex int
+
+    /*  The largest squared slope seen so far.                                */
+    var largest float32 = 0.0
+
+    if int(self.NxPts * self.NyPts) != self.NumberOfPoints {
+        return 0.0
+    }
+
+    for yIndex = 0; yIndex < int(self.NyPts); yIndex++ {
+        for xIndex = 0; xIndex < int(self.NxPts); xIndex++ {
+            dzdx, dzdy := self.heightDerivatives(xIndex, yIndex)
+            var slope float32 = dzdx * dzdx + dzdy * dzdy
+
+            if slope > largest {
+                largest = slope
+            }
+        }
+    }
+
+    return float32(math.Sqrt(float64(largest)))
+}
+/*  End of MaxSlope.                                                          */
@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the largest slope of the surface.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The plane z = 3x + 4y has slope 5 everywhere, exactly, since finite       *
+ *  differences of a linear function have no error.                           */
+func TestMaxSlopePlane(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, func(x, y float32) float32 {
+        return 3.0 * x + 4.0 * y
+    }, WithGrid(6, 5))
+
+    if slope := canvas.MaxSlope(); !closeTo(slope, 5.0, 1.0E-5) {
+        t.Errorf("MaxSlope = %g, want 5", slope)
+    }
+}
+/*  End of TestMaxSlopePlane.                                                 */
+
+/*  The slope of the paraboloid is 2r, largest at the corners, so it grows    *
+ *  with the size of the domain.                                              */
+func TestMaxSlopeParaboloidGrows(t *testing.T) {
+    var previous float32 = 0.0
+
+    for _, size := range []float32{1.0, 2.0, 4.0} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(9, 9),
+            WithDomain(size, size, -0.5 * size, -0.5 * size),
+        )
+
+        var slope float32 = canvas.MaxSlope()
+
+        if slope <= previous {
+            t.Errorf("MaxSlope = %g on a domain of size %g, not above %g",
+                     slope, size, previous)
+        }
+
+        previous = slope
+    }
+}
+/*  End of TestMaxSlopeParaboloidGrows.                                       */
@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reports the memory used by the buffers of a canvas.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MemoryUsage                                                           *
+ *  Purpose:                                                                  *
+ *      Computes the number of bytes used by the buffers of a canvas. The     *
+ *      mesh and index buffers report both the active portion and the size of *
+ *      their storage. The auxiliary buffers, like normals, colors, and UVs,  *
+ *      are summed, and are zero until they have been computed. Every element *
+ *      of every buffer is four bytes.                                        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose buffers are being measured.                      *
+ *  Output:                                                                   *
+ *      report (MemoryReport):                                                *
+ *          The sizes of the buffers, in bytes.                               *
+ ******************************************************************************/
+func MemoryUsage(canvas *Canvas) MemoryReport {
+
+    /*  The optional per-vertex and per-face buffers, empty if not computed.  */
+    var auxiliary int = len(canvas.Normals) + len(canvas.Colors) +
+                        len(canvas.UVs) + len(canvas.Gradients) +
+                        len(canvas.Tangents) + len(canvas.Faces) +
+                        len(canvas.FaceNormals) + len(canvas.FaceColors) +
+                        len(canvas.QuadFaces) + len(canvas.LineQuadMesh) +
+                        len(canvas.LineQuadFaces) + len(canvas.ReferenceMesh) +
+                        len(canvas.ReferenceIndices) + len(canvas.Interleaved) +
+                        len(canvas.BaseMesh) + len(canvas.BaseNormals) +
+                        len(canvas.LODStart) + len(canvas.LODEnd) +
+                        len(canvas.ScalarField)
+
+    return MemoryReport{
+        MeshBytes: 4 * canvas.MeshSize,
+        MaxMeshBytes: 4 * len(canvas.MeshStorage),
+        IndexBytes: 4 * canvas.IndexSize,
+        MaxIndexBytes: 4 * len(canvas.IndexStorage),
+        AuxiliaryBytes: 4 * auxiliary,
+    }
+}
+/*  End of MemoryUsage.                                                       */
@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the memory usage report of a canvas.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The active bytes are four per float of the mesh and per index of the      *
+ *  wireframe, the auxiliary bytes count the normals once they exist.         */
+func TestMemoryUsageActiveBytes(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(6, 5))
+    var report MemoryReport = MemoryUsage(canvas)
+    var active int = report.MeshBytes + report.IndexBytes
+
+    if active != 4 * canvas.MeshSize + 4 * canvas.IndexSize {
+        t.Errorf("active bytes %d, want MeshSize*4 + IndexSize*4 = %d",
+                 active, 4 * canvas.MeshSize + 4 * canvas.IndexSize)
+    }
+
+    if (report.MaxMeshBytes < report.MeshBytes) ||
+       (report.MaxIndexBytes < report.IndexBytes) {
+        t.Errorf("storage %d and %d bytes is smaller than the active %d " +
+                 "and %d bytes", report.MaxMeshBytes, report.MaxIndexBytes,
+                 report.MeshBytes, report.IndexBytes)
+    }
+
+    if report.AuxiliaryBytes != 0 {
+        t.Errorf("auxiliary bytes %d before anything was computed",
+                 report.AuxiliaryBytes)
+    }
+
+    canvas.ComputeNormals()
+    report = MemoryUsage(canvas)
+
+    if report.AuxiliaryBytes != 4 * len(canvas.Normals) {
+        t.Errorf("auxiliary bytes %d with normals, want %d",
+                 report.AuxiliaryBytes, 4 * len(canvas.Normals))
+    }
+}
+/*  End of TestMemoryUsageActiveBytes.                                        */
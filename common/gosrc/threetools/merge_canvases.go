@@ -0,0 +1,111 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Combines the meshes of two canvases into one.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MergeCanvases                                                         *
+ *  Purpose:                                                                  *
+ *      Stores the vertices of a followed by those of b in the mesh of dst,   *
+ *      and the indices of a followed by those of b, shifted by the number of *
+ *      vertices of a so they still refer to the vertices of b. The two       *
+ *      meshes may then be drawn together with a single draw call, like a     *
+ *      surface and its reference plane. Both must use the same index         *
+ *      format, segments or line strips, and dst is given that format. The    *
+ *      strips of b start after a restart, so they are not joined to those of *
+ *      a. The destination may be a or b. Like ImportOBJ, the result is not a *
+ *      grid, dst is treated as a single row of vertices and its per-vertex   *
+ *      buffers are cleared. The storage of dst grows if needed.              *
+ *  Arguments:                                                                *
+ *      dst (*Canvas):                                                        *
+ *          The canvas the combined mesh is stored in.                        *
+ *      a (*Canvas):                                                          *
+ *          The first mesh.                                                   *
+ *      b (*Canvas):                                                          *
+ *          The second mesh.                                                  *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge if the merged mesh does not fit, or               *
+ *          ErrIncompatibleFormat if only one of a and b uses line strips.    *
+ ******************************************************************************/
+func MergeCanvases(dst, a, b *Canvas) error {
+
+    /*  Variable for indexing over the indices of b.                          */
+    var index int
+
+    /*  The indices of b are shifted past the vertices of a.                  */
+    var offset uint32 = uint32(a.NumberOfPoints)
+
+    /*  Pairs and strips can not be mixed in a single index buffer.           */
+    if a.LineStrips != b.LineStrips {
+        return ErrIncompatibleFormat
+    }
+
+    /*  The combined data is built first, since dst may be one of the inputs. */
+    var vertices []float32 = make([]float32, 0, a.MeshSize + b.MeshSize)
+    var indices []uint32 = make([]uint32, 0, a.IndexSize + b.IndexSize + 1)
+
+    vertices = append(vertices, a.Mesh[0:a.MeshSize]...)
+    vertices = append(vertices, b.Mesh[0:b.MeshSize]...)
+    indices = append(indices, a.Indices[0:a.IndexSize]...)
+
+    /*  The last strip of a would otherwise run into the first strip of b.    */
+    if a.LineStrips && (a.IndexSize > 0) && (b.IndexSize > 0) {
+        indices = append(indices, StripRestartIndex)
+    }
+
+    for index = 0; index < b.IndexSize; index++ {
+        var vertex uint32 = b.Indices[index]
+
+        if vertex != StripRestartIndex {
+            vertex += offset
+        }
+
+        indices = append(indices, vertex)
+    }
+
+    /*  The storage is grown if needed, up to the maximum size.               */
+    err := dst.ReserveStorage(uint64(len(vertices)), uint64(len(indices)))
+
+    if err != nil {
+        return err
+    }
+
+    dst.NumberOfPoints = len(vertices) / 3
+    dst.MeshSize = len(vertices)
+    dst.IndexSize = len(indices)
+    dst.NxPts = uint32(dst.NumberOfPoints)
+    dst.NyPts = 1
+    dst.LineStrips = a.LineStrips
+
+    dst.Mesh = dst.MeshStorage[0:dst.MeshSize]
+    dst.Indices = dst.IndexStorage[0:dst.IndexSize]
+    copy(dst.Mesh, vertices)
+    copy(dst.Indices, indices)
+
+    dst.clearDerivedBuffers()
+    return nil
+}
+/*  End of MergeCanvases.                                                     */
@@ -0,0 +1,80 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for combining two meshes into one buffer.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Checks that every index of the merged canvas, from the given offset on,   *
+ *  refers to the same vertex as the matching index of the source canvas.     */
+func expectMergedIndices(t *testing.T, name string,
+                         merged, source *Canvas, start int) {
+    t.Helper()
+
+    for index := 0; index < source.IndexSize; index++ {
+        var got uint32 = merged.Indices[start + index]
+        var want uint32 = source.Indices[index]
+
+        if merged.point(int(got)) != source.point(int(want)) {
+            t.Fatalf("%s: index %d refers to %v, want %v", name, index,
+                     merged.point(int(got)), source.point(int(want)))
+        }
+    }
+}
+/*  End of expectMergedIndices.                                               */
+
+/*  The merged mesh holds the vertices of both canvases, and the indices of   *
+ *  the second are shifted to refer to its own vertices, also when the        *
+ *  destination is the first canvas.                                          */
+func TestMergeCanvasesOffsetsIndices(t *testing.T) {
+    var b *Canvas = newGraphCanvas(
+        t, saddle, WithGrid(4, 2), WithDomain(1.0, 1.0, 3.0, 3.0),
+    )
+
+    for _, inPlace := range []bool{false, true} {
+        var a *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+        var original *Canvas = a.Clone()
+        var dst *Canvas = newTestCanvas(t)
+
+        if inPlace {
+            dst = a
+        }
+
+        if err := MergeCanvases(dst, a, b); err != nil {
+            t.Fatalf("MergeCanvases: %v", err)
+        }
+
+        var points int = original.NumberOfPoints + b.NumberOfPoints
+        var indices int = original.IndexSize + b.IndexSize
+
+        if (dst.NumberOfPoints != points) || (dst.IndexSize != indices) {
+            t.Fatalf("%d points and %d indices, want %d and %d",
+                     dst.NumberOfPoints, dst.IndexSize, points, indices)
+        }
+
+        expectMergedIndices(t, "first mesh", dst, original, 0)
+        expectMergedIndices(t, "second mesh", dst, b, original.IndexSize)
+    }
+}
+/*  End of TestMergeCanvasesOffsetsIndices.                                   */
@@ -0,0 +1,48 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Encodes the mesh of a canvas as a base64 string.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for the base64 encoding.                         */
+import "encoding/base64"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MeshBase64                                                            *
+ *  Purpose:                                                                  *
+ *      Encodes the active portion of the mesh buffer as base64. The bytes    *
+ *      are those of MeshBytes, the same layout as the WebAssembly memory,    *
+ *      so decoding into a Float32Array gives the mesh. This is convenient    *
+ *      for saving snapshots, for example in localStorage.                    *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose mesh is being encoded.                           *
+ *  Output:                                                                   *
+ *      encoded (string):                                                     *
+ *          The base64 encoding of the mesh.                                  *
+ ******************************************************************************/
+func (self *Canvas) MeshBase64() string {
+    return base64.StdEncoding.EncodeToString(MeshBytes(self))
+}
+/*  End of MeshBase64.                                                        */
@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for saving and restoring the mesh as base64.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  bytes compares the raw meshes, errors checks the kind of error.           */
+import (
+    "bytes"
+    "errors"
+    "testing"
+)
+
+/*  Encoding the mesh, changing it, and loading the encoding back restores    *
+ *  the mesh byte for byte.                                                   */
+func TestMeshBase64RoundTrip(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var original []byte = append([]byte(nil), MeshBytes(canvas)...)
+    var encoded string = canvas.MeshBase64()
+
+    canvas.RotateMesh(UnitVectorFromAngle(1.0))
+
+    if err := canvas.LoadMeshBase64(encoded); err != nil {
+        t.Fatalf("LoadMeshBase64: %v", err)
+    }
+
+    if !bytes.Equal(MeshBytes(canvas), original) {
+        t.Error("the restored mesh differs from the encoded one")
+    }
+}
+/*  End of TestMeshBase64RoundTrip.                                           */
+
+/*  An encoding of a mesh of another size is rejected with ErrSizeMismatch,   *
+ *  and an invalid string is rejected too, both leaving the mesh alone.       */
+func TestLoadMeshBase64Rejects(t *testing.T) {
+    var small *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var original []byte = append([]byte(nil), MeshBytes(canvas)...)
+
+    var err error = canvas.LoadMeshBase64(small.MeshBase64())
+
+    if !errors.Is(err, ErrSizeMismatch) {
+        t.Errorf("loading a smaller mesh gave %v, want ErrSizeMismatch", err)
+    }
+
+    if canvas.LoadMeshBase64("not base64!") == nil {
+        t.Error("LoadMeshBase64 accepted an invalid string")
+    }
+
+    if !bytes.Equal(MeshBytes(canvas), original) {
+        t.Error("a rejected snapshot changed the mesh")
+    }
+}
+/*  End of TestLoadMeshBase64Rejects.                                         */
@@ -24,14 +24,13 @@
  ******************************************************************************/
 package threetools
 
-/*  The Pointer type is provided here, which gets an address from an array.   */
-import "unsafe"
-
 /******************************************************************************
  *  Function:                                                                 *
  *      MeshBufferAddress                                                     *
  *  Purpose:                                                                  *
- *      Returns the address of the global mesh buffer.                        *
+ *      Returns the address of the global mesh buffer. The buffer is          *
+ *      reallocated when the main canvas grows, see ReserveStorage, so this   *
+ *      should be called again after the resolution is increased.             *
  *  Arguments:                                                                *
  *      None.                                                                 *
  *  Output:                                                                   *
@@ -40,8 +39,7 @@ import "unsafe"
  ******************************************************************************/
 func MeshBufferAddress() uintptr {
 
-    /*  Get a pointer for the array and then convert this into an integer,    *
-     *  which is the address of the array.                                    */
-    return uintptr(unsafe.Pointer(&MeshBuffer))
+    /*  The address of the first element of the current backing array.        */
+    return SliceAddress(MeshBuffer)
 }
 /*  End of MeshBufferAddress.                                                 */
@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Encodes the mesh of a canvas as little-endian bytes.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "encoding/binary"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MeshBytes                                                             *
+ *  Purpose:                                                                  *
+ *      Encodes the active portion of the mesh buffer as raw bytes, each      *
+ *      float written as four little-endian bytes regardless of the byte      *
+ *      order of the host. This is the layout of a Float32Array in            *
+ *      WebAssembly memory, and gives portable files for tools outside of the *
+ *      browser.                                                              *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose mesh is being encoded.                           *
+ *  Output:                                                                   *
+ *      data ([]byte):                                                        *
+ *          The mesh, four bytes per float.                                   *
+ ******************************************************************************/
+func MeshBytes(canvas *Canvas) []byte {
+
+    /*  Variable for indexing over the floats of the mesh.                    */
+    var index int
+
+    var data []byte = make([]byte, 4 * canvas.MeshSize)
+
+    for index = 0; index < canvas.MeshSize; index++ {
+        binary.LittleEndian.PutUint32(
+            data[4 * index:], math.Float32bits(canvas.Mesh[index]),
+        )
+    }
+
+    return data
+}
+/*  End of MeshBytes.                                                         */
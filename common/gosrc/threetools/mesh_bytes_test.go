@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the little-endian byte encodings of the buffers.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  The bytes are decoded independently of the encoders.                      */
+import (
+    "encoding/binary"
+    "math"
+    "testing"
+)
+
+/*  Decoding the bytes of the mesh four at a time, little-endian, gives back  *
+ *  every float of the mesh bit for bit.                                      */
+func TestMeshBytesDecode(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(6, 5))
+    var data []byte = MeshBytes(canvas)
+
+    if len(data) != 4 * canvas.MeshSize {
+        t.Fatalf("%d bytes, want %d", len(data), 4 * canvas.MeshSize)
+    }
+
+    for index := 0; index < canvas.MeshSize; index++ {
+        var bits uint32 = binary.LittleEndian.Uint32(data[4 * index:])
+
+        if math.Float32frombits(bits) != canvas.Mesh[index] {
+            t.Fatalf("float %d decodes to %g, want %g", index,
+                     math.Float32frombits(bits), canvas.Mesh[index])
+        }
+    }
+}
+/*  End of TestMeshBytesDecode.                                               */
+
+/*  The low byte comes first whatever the byte order of the host, so 1.0,     *
+ *  0x3F800000, is written as 00 00 80 3F.                                    */
+func TestMeshBytesByteOrder(t *testing.T) {
+    var canvas *Canvas = newPointCanvas(t, [][3]float32{{1.0, 0.0, 0.0}})
+    var data []byte = MeshBytes(canvas)
+    var want []byte = []byte{0x00, 0x00, 0x80, 0x3F}
+
+    for index, value := range want {
+        if data[index] != value {
+            t.Errorf("byte %d is %#x, want %#x", index, data[index], value)
+        }
+    }
+}
+/*  End of TestMeshBytesByteOrder.                                            */
+
+/*  Decoding the bytes of the indices gives back the active indices.          */
+func TestIndexBytesDecode(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(6, 5))
+    var data []byte = IndexBytes(canvas)
+
+    if len(data) != 4 * canvas.IndexSize {
+        t.Fatalf("%d bytes, want %d", len(data), 4 * canvas.IndexSize)
+    }
+
+    for index := 0; index < canvas.IndexSize; index++ {
+        var value uint32 = binary.LittleEndian.Uint32(data[4 * index:])
+
+        if value != canvas.Indices[index] {
+            t.Fatalf("index %d decodes to %d, want %d",
+                     index, value, canvas.Indices[index])
+        }
+    }
+}
+/*  End of TestIndexBytesDecode.                                              */
@@ -0,0 +1,79 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reflects a mesh across one of the coordinate planes.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Mirror                                                                *
+ *  Purpose:                                                                  *
+ *      Negates the chosen coordinate of every active vertex, reflecting the  *
+ *      figure across the plane perpendicular to that axis. The normals, if   *
+ *      computed, are reflected too. A reflection reverses orientation, so    *
+ *      the triangles keep their winding but now appear clockwise from the    *
+ *      outside. Set rewind to reverse the winding with ReverseWinding,       *
+ *      restoring counterclockwise winding so normals computed from the faces *
+ *      stay outward.                                                         *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being reflected.                 *
+ *      axis (int):                                                           *
+ *          The coordinate that is negated, 0 for x, 1 for y, and 2 for z.    *
+ *      rewind (bool):                                                        *
+ *          Whether to reverse the winding of the triangle faces.             *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) Mirror(axis int, rewind bool) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    if (axis < 0) || (axis > 2) {
+        return
+    }
+
+    for index = axis; index < self.MeshSize; index += 3 {
+        self.Mesh[index] = -self.Mesh[index]
+    }
+
+    /*  Normals are reflected the same way as the vertices.                   */
+    if len(self.Normals) >= self.MeshSize {
+        for index = axis; index < self.MeshSize; index += 3 {
+            self.Normals[index] = -self.Normals[index]
+        }
+    }
+
+    for index = axis; index < len(self.FaceNormals); index += 3 {
+        self.FaceNormals[index] = -self.FaceNormals[index]
+    }
+
+    if rewind {
+        self.ReverseWinding()
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of Mirror.                                                            */
@@ -0,0 +1,62 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for reflecting the mesh across a coordinate plane.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Mirroring the paraboloid across x negates the x coordinates and keeps the *
+ *  others. The graph still faces up, so the triangles only stay              *
+ *  counterclockwise from above if they are rewound, and appear clockwise     *
+ *  otherwise.                                                                */
+func TestMirrorNegatesAndRewinds(t *testing.T) {
+    for _, rewind := range []bool{false, true} {
+        var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 4))
+        canvas.GenerateTriangleFaces()
+
+        var original *Canvas = canvas.Clone()
+        canvas.Mirror(0, rewind)
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            var p [3]float32 = original.point(index)
+            var want [3]float32 = [3]float32{-p[0], p[1], p[2]}
+            expectVector(t, "mirrored vertex", canvas.point(index), want, 0.0)
+        }
+
+        for index := 0; index + 2 < len(canvas.Faces); index += 3 {
+            var a [3]float32 = canvas.point(int(canvas.Faces[index]))
+            var b [3]float32 = canvas.point(int(canvas.Faces[index + 1]))
+            var c [3]float32 = canvas.point(int(canvas.Faces[index + 2]))
+            var normal [3]float32 = crossProduct(
+                vectorDifference(b, a), vectorDifference(c, a),
+            )
+
+            if (normal[2] > 0.0) != rewind {
+                t.Errorf("rewind %v: face %d has normal %v",
+                         rewind, index / 3, normal)
+            }
+        }
+    }
+}
+/*  End of TestMirrorNegatesAndRewinds.                                       */
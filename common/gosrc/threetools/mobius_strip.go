@@ -0,0 +1,103 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the Mobius strip, a surface with a half twisted seam.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi and Sincos are provided here.                                          */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      MobiusStrip                                                           *
+ *  Purpose:                                                                  *
+ *      Parametrization ((1 + v cos(u/2)) cos(u), (1 + v cos(u/2)) sin(u), v  *
+ *      sin(u/2)) of the Mobius strip about the unit circle. The segment      *
+ *      across the strip at angle u turns by u/2, so after a full turn it has *
+ *      turned by pi, and the point (2 pi, v) is the point (0, -v).           *
+ *  Arguments:                                                                *
+ *      u (float32):                                                          *
+ *          The angle about the z axis.                                       *
+ *      v (float32):                                                          *
+ *          The signed distance from the center circle.                       *
+ *  Output:                                                                   *
+ *      point ([3]float32):                                                   *
+ *          The point on the Mobius strip.                                    *
+ ******************************************************************************/
+func MobiusStrip(u, v float32) [3]float32 {
+    sinU, cosU := math.Sincos(float64(u))
+    sinHalf, cosHalf := math.Sincos(0.5 * float64(u))
+    var radius float64 = 1.0 + float64(v) * cosHalf
+
+    return [3]float32{
+        float32(radius * cosU),
+        float32(radius * sinU),
+        float32(float64(v) * sinHalf),
+    }
+}
+/*  End of MobiusStrip.                                                       */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateMobiusStrip                                                   *
+ *  Purpose:                                                                  *
+ *      Computes the mesh and wireframe of a Mobius strip of the given width, *
+ *      u over [0, 2 pi] and v over [-width/2, width/2]. Since the grid in v  *
+ *      is symmetric about zero, the last column of the grid is the first     *
+ *      column in reverse, vertex (NxPts - 1, y) is at vertex (0, NyPts - 1 - *
+ *      y), the same half twist that seamTopology gives the Mobius mesh       *
+ *      types, so WeldSeams and NeighborIndex join the seam where the         *
+ *      vertices meet. The mesh type is set to MobiusSquareWireframe unless   *
+ *      it is already a Mobius type, and the domain is set to match. The      *
+ *      number of points must already be set.                                 *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid set.                  *
+ *      width (float32):                                                      *
+ *          The width of the strip.                                           *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from Recompute or the wireframe, if any.                *
+ ******************************************************************************/
+func GenerateMobiusStrip(canvas *Canvas, width float32) error {
+    if (canvas.MeshType != MobiusSquareWireframe) &&
+       (canvas.MeshType != MobiusTriangleWireframe) {
+        canvas.MeshType = MobiusSquareWireframe
+    }
+
+    /*  The mesh type changes the size of the index buffer.                   */
+    err := canvas.Recompute(canvas.MeshStorage, canvas.IndexStorage)
+
+    if err != nil {
+        return err
+    }
+
+    canvas.HorizontalStart = 0.0
+    canvas.Width = 2.0 * math.Pi
+    canvas.VerticalStart = -0.5 * width
+    canvas.Height = width
+    canvas.Surface = nil
+    canvas.GenerateMeshFromParametric(MobiusStrip)
+    return canvas.GenerateRectangularWireframe()
+}
+/*  End of GenerateMobiusStrip.                                               */
@@ -0,0 +1,77 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the Mobius strip and the half twist of its seam.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Walks the given number of steps to the right from the vertex in the first *
+ *  column and the given row, following NeighborIndex across the seam, and    *
+ *  returns the point reached.                                                */
+func walkRight(canvas *Canvas, yIndex, steps int) [3]float32 {
+    var x, y int = 0, yIndex
+
+    for step := 0; step < steps; step++ {
+        index, _ := canvas.NeighborIndex(x, y, 1, 0)
+        x, y = index % int(canvas.NxPts), index / int(canvas.NxPts)
+    }
+
+    return canvas.point(int(canvas.gridIndex(uint32(x), uint32(y))))
+}
+/*  End of walkRight.                                                         */
+
+/*  Once around the strip, a path starting off the center line comes back on  *
+ *  the opposite side, at the mirrored row of the first column, and twice     *
+ *  around it returns to where it started.                                    */
+func TestMobiusStripSeamTwist(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(9, 5))
+
+    if err := GenerateMobiusStrip(canvas, 0.5); err != nil {
+        t.Fatalf("GenerateMobiusStrip: %v", err)
+    }
+
+    var lap int = int(canvas.NxPts) - 1
+
+    for yIndex := 0; yIndex < int(canvas.NyPts); yIndex++ {
+        var mirrored uint32 = canvas.NyPts - 1 - uint32(yIndex)
+        var start [3]float32 = canvas.point(
+            int(canvas.gridIndex(0, uint32(yIndex))),
+        )
+        var opposite [3]float32 = canvas.point(
+            int(canvas.gridIndex(0, mirrored)),
+        )
+
+        expectVector(t, "once around", walkRight(canvas, yIndex, lap),
+                     opposite, 1.0E-5)
+        expectVector(t, "twice around", walkRight(canvas, yIndex, 2 * lap),
+                     start, 1.0E-5)
+
+        /*  Off the center line the two sides are different points.           */
+        if (2 * yIndex + 1 != int(canvas.NyPts)) &&
+           (vectorNorm(vectorDifference(start, opposite)) < 0.1) {
+            t.Errorf("row %d: the sides of the strip meet", yIndex)
+        }
+    }
+}
+/*  End of TestMobiusStripSeamTwist.                                          */
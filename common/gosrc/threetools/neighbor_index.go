@@ -0,0 +1,137 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Finds the neighbors of a vertex in the grid.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Moves a coordinate that left the grid back into [0, count - 1] across a   *
+ *  closed seam. Adjacent seams, see WrapU, join the last point to the first, *
+ *  and glued seams, see seamTopology, make the last point the same as the    *
+ *  first. Returns the new coordinate and the number of times the seam was    *
+ *  crossed, which decides whether a twist flips the other coordinate.        */
+func wrapCoordinate(value, count int, adjacent bool) (int, int) {
+    var period int = count
+
+    if !adjacent {
+        period = count - 1
+    }
+
+    var crossings int = value / period
+
+    /*  Integer division rounds towards zero, floor it for negatives.         */
+    if (value < 0) && (value % period != 0) {
+        crossings--
+    }
+
+    return value - crossings * period, crossings
+}
+
+/*  Clamps a coordinate to [0, count - 1].                                    */
+func clampCoordinate(value, count int) int {
+    if value < 0 {
+        return 0
+    } else if value >= count {
+        return count - 1
+    }
+
+    return value
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NeighborIndex                                                         *
+ *  Purpose:                                                                  *
+ *      Returns the index of the vertex dx columns and dy rows away from the  *
+ *      vertex at (xIndex, yIndex), following the topology of the canvas.     *
+ *      Closed seams, from WrapU and WrapV or from the mesh type, are         *
+ *      crossed, with the twisted seams of the Mobius, Klein, and projective  *
+ *      types flipping the other coordinate. Elsewhere a neighbor beyond the  *
+ *      edge is clamped to the edge and reported as out of bounds, so         *
+ *      stencils get a usable index either way. The index is of the vertex,   *
+ *      the mesh holds its x coordinate at three times this.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the grid.                                         *
+ *      xIndex (int):                                                         *
+ *          The column of the vertex.                                         *
+ *      yIndex (int):                                                         *
+ *          The row of the vertex.                                            *
+ *      dx (int):                                                             *
+ *          The number of columns to move, positive to the right.             *
+ *      dy (int):                                                             *
+ *          The number of rows to move, positive upwards.                     *
+ *  Output:                                                                   *
+ *      index (int):                                                          *
+ *          The index of the neighbor, -1 if the vertex is not in the grid.   *
+ *      inBounds (bool):                                                      *
+ *          False if the neighbor was clamped to the edge.                    *
+ ******************************************************************************/
+func (self *Canvas) NeighborIndex(xIndex, yIndex, dx, dy int) (int, bool) {
+    var width, height int = int(self.NxPts), int(self.NyPts)
+    var inBounds bool = true
+
+    if (xIndex < 0) || (xIndex >= width) ||
+       (yIndex < 0) || (yIndex >= height) {
+        return -1, false
+    }
+
+    wrapX, twistX, wrapY, twistY := self.seamTopology()
+    wrapU, wrapV := self.gridWraps()
+
+    var x int = xIndex + dx
+    var y int = yIndex + dy
+
+    /*  Across the left or right edge, an odd number of twisted crossings     *
+     *  turns the grid upside down.                                           */
+    if (x < 0) || (x >= width) {
+        if wrapU || (wrapX && (width > 1)) {
+            var crossings int
+            x, crossings = wrapCoordinate(x, width, wrapU)
+
+            if twistX && (crossings % 2 != 0) {
+                y = height - 1 - y
+            }
+        } else {
+            x = clampCoordinate(x, width)
+            inBounds = false
+        }
+    }
+
+    /*  The top and bottom edges, similarly.                                  */
+    if (y < 0) || (y >= height) {
+        if wrapV || (wrapY && (height > 1)) {
+            var crossings int
+            y, crossings = wrapCoordinate(y, height, wrapV)
+
+            if twistY && (crossings % 2 != 0) {
+                x = width - 1 - x
+            }
+        } else {
+            y = clampCoordinate(y, height)
+            inBounds = false
+        }
+    }
+
+    return int(self.gridIndex(uint32(x), uint32(y))), inBounds
+}
+/*  End of NeighborIndex.                                                     */
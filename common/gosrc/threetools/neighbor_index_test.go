@@ -0,0 +1,82 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for finding the neighbors of a vertex across the seams.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The neighbors of the bottom left corner of a 5x4 grid for each topology.  *
+ *  Plain meshes clamp, or wrap adjacently with WrapU and WrapV, the closed   *
+ *  mesh types glue the last point to the first, and the twisted seams flip   *
+ *  the other coordinate.                                                     */
+func TestNeighborIndexCorner(t *testing.T) {
+    var cases = []struct {
+        name string
+        meshType uint
+        wrap bool
+        dx, dy int
+        x, y uint32
+        inBounds bool
+    }{
+        {"plain", SquareWireframe, false, -1, -1, 0, 0, false},
+        {"wrapped", SquareWireframe, true, -1, -1, 4, 3, true},
+        {"cylinder", CylindricalSquareWireframe, false, -1, -1, 3, 0, false},
+        {"mobius", MobiusSquareWireframe, false, -1, 0, 3, 3, true},
+        {"torus", TorodialSquareWireframe, false, -1, -1, 3, 2, true},
+        {"klein", KleinSquareWireframe, false, -1, -1, 1, 2, true},
+        {"projective", ProjectiveSquareWireframe, false, -1, -1, 1, 1, true},
+        {"inside", TorodialSquareWireframe, false, 2, 1, 2, 1, true},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(5, 4), WithMeshType(c.meshType),
+            WithWrap(c.wrap, c.wrap),
+        )
+
+        index, inBounds := canvas.NeighborIndex(0, 0, c.dx, c.dy)
+        var want int = int(canvas.gridIndex(c.x, c.y))
+
+        if (index != want) || (inBounds != c.inBounds) {
+            t.Errorf("%s: got (%d, %t), want (%d, %t)",
+                     c.name, index, inBounds, want, c.inBounds)
+        }
+    }
+}
+/*  End of TestNeighborIndexCorner.                                           */
+
+/*  A vertex outside of the grid has no neighbors.                            */
+func TestNeighborIndexOutsideGrid(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(5, 4))
+
+    for _, vertex := range [][2]int{{-1, 0}, {5, 0}, {0, 4}} {
+        index, inBounds := canvas.NeighborIndex(vertex[0], vertex[1], 0, 0)
+
+        if (index != -1) || inBounds {
+            t.Errorf("vertex %v: got (%d, %t), want (-1, false)",
+                     vertex, index, inBounds)
+        }
+    }
+}
+/*  End of TestNeighborIndexOutsideGrid.                                      */
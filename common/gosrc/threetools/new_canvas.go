@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a new canvas and adds it to the canvas registry.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NewCanvas                                                             *
+ *  Purpose:                                                                  *
+ *      Allocates a new canvas, with its own mesh and index buffers, and      *
+ *      registers it so that it may be referred to by its id. If any options  *
+ *      are given, the canvas is configured with them, see NewCanvasConfig.   *
+ *  Arguments:                                                                *
+ *      options (...CanvasOption):                                            *
+ *          Optional geometry for the canvas, for example WithGrid(nx, ny).   *
+ *  Output:                                                                   *
+ *      id (int):                                                             *
+ *          The id of the new canvas, its index in the registry.              *
+ *      err (error):                                                          *
+ *          The error from Configure if the options are invalid, in which     *
+ *          case the canvas is not registered and the id is -1.               *
+ ******************************************************************************/
+func NewCanvas(options ...CanvasOption) (int, error) {
+
+    /*  The new canvas starts with small buffers, like the main canvas, and   *
+     *  these grow as the resolution is increased.                            */
+    var canvas *Canvas = &Canvas{
+        MeshStorage: make([]float32, 3 * InitialLength),
+        IndexStorage: make([]uint32, 6 * InitialLength),
+    }
+
+    /*  A canvas that could not be configured is never registered, so a bad   *
+     *  id can not be handed out for it.                                      */
+    if len(options) > 0 {
+        if err := canvas.Configure(NewCanvasConfig(options...)); err != nil {
+            return -1, err
+        }
+    }
+
+    /*  The id is the location of the canvas in the registry.                 */
+    Canvases = append(Canvases, canvas)
+    return len(Canvases) - 1, nil
+}
+/*  End of NewCanvas.                                                         */
@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Builds a canvas configuration from functional options.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NewCanvasConfig                                                       *
+ *  Purpose:                                                                  *
+ *      Creates a canvas configuration from a list of options. Anything not   *
+ *      set by an option gets a default, a 64x64 square wireframe on the      *
+ *      square [-1, 1] x [-1, 1] with every grid line drawn. Options are      *
+ *      applied in order, so later options override earlier ones. With        *
+ *      WithSquareCells the vertical resolution is computed last.             *
+ *  Arguments:                                                                *
+ *      options (...CanvasOption):                                            *
+ *          The options, for example WithGrid(nx, ny).                        *
+ *  Output:                                                                   *
+ *      config (CanvasConfig):                                                *
+ *          The configuration with the options applied.                       *
+ ******************************************************************************/
+func NewCanvasConfig(options ...CanvasOption) CanvasConfig {
+
+    /*  The defaults used for anything the caller omits.                      */
+    var config CanvasConfig = CanvasConfig{
+        NxPts: 64,
+        NyPts: 64,
+        Width: 2.0,
+        Height: 2.0,
+        HorizontalStart: -1.0,
+        VerticalStart: -1.0,
+        MeshType: SquareWireframe,
+        WireframeStride: 1,
+        WireframeDirection: BothDirections,
+    }
+
+    for _, option := range options {
+        option(&config)
+    }
+
+    /*  This depends on the grid and the domain, which may be set by options  *
+     *  in any order, so it is applied after all of them.                     */
+    if config.SquareCells {
+        config.NyPts = SquareCellPoints(
+            config.NxPts, config.Width, config.Height,
+        )
+    }
+
+    return config
+}
+/*  End of NewCanvasConfig.                                                   */
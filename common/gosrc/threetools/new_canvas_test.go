@@ -0,0 +1,115 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for creating several independent canvases.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  errors compares the errors, testing does the rest.                        */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Removes the canvases registered by a test once it is done, so that the    *
+ *  registry does not keep their storage alive.                               */
+func restoreCanvases(t *testing.T) {
+    var count int = len(Canvases)
+
+    t.Cleanup(func() {
+        Canvases = Canvases[0:count]
+    })
+}
+/*  End of restoreCanvases.                                                   */
+
+/*  A canvas made with valid options is registered and configured with them.  */
+func TestNewCanvasRegisters(t *testing.T) {
+    restoreCanvases(t)
+
+    var count int = len(Canvases)
+    id, err := NewCanvas(WithGrid(5, 4))
+
+    if err != nil {
+        t.Fatalf("NewCanvas: %v", err)
+    }
+
+    if (id != count) || (len(Canvases) != count + 1) {
+        t.Fatalf("id %d with %d canvases, want %d with %d",
+                 id, len(Canvases), count, count + 1)
+    }
+
+    var canvas *Canvas = GetCanvas(id)
+
+    if (canvas.NxPts != 5) || (canvas.NyPts != 4) {
+        t.Errorf("grid is %d x %d, want 5 x 4", canvas.NxPts, canvas.NyPts)
+    }
+}
+/*  End of TestNewCanvasRegisters.                                            */
+
+/*  Options that Configure rejects give its error, and no canvas is added.    */
+func TestNewCanvasInvalidOptions(t *testing.T) {
+    restoreCanvases(t)
+
+    var count int = len(Canvases)
+    id, err := NewCanvas(WithGrid(MaxWidth + 1, 4))
+
+    if !errors.Is(err, ErrGridTooLarge) {
+        t.Fatalf("got %v, want ErrGridTooLarge", err)
+    }
+
+    if (id != -1) || (len(Canvases) != count) {
+        t.Errorf("id %d with %d canvases, want -1 with %d",
+                 id, len(Canvases), count)
+    }
+}
+/*  End of TestNewCanvasInvalidOptions.                                       */
+
+/*  Two canvases with the same surface get distinct ids and buffers, and      *
+ *  rotating one of them leaves the other untouched.                          */
+func TestNewCanvasIsolation(t *testing.T) {
+    var first *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    var second *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    var before *Canvas = second.Clone()
+
+    if first == second {
+        t.Fatal("NewCanvas returned the same canvas twice")
+    }
+
+    if &first.MeshStorage[0] == &second.MeshStorage[0] {
+        t.Fatal("the canvases share their mesh storage")
+    }
+
+    first.RotateMesh(UnitVectorFromAngle(0.5))
+
+    for index := 0; index < second.NumberOfPoints; index++ {
+        if second.point(index) != before.point(index) {
+            t.Fatalf("point %d of the second canvas moved from %v to %v",
+                     index, before.point(index), second.point(index))
+        }
+    }
+
+    /*  The rotated canvas did move, so the comparison above is meaningful.   */
+    if first.point(0) == second.point(0) {
+        t.Error("rotating the first canvas did not change it")
+    }
+}
+/*  End of TestNewCanvasIsolation.                                            */
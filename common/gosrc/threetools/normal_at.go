@@ -0,0 +1,54 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the normal of a surface at any point of the plane.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NormalAt                                                              *
+ *  Purpose:                                                                  *
+ *      Computes the unit normal of the surface z = f(x, y) at the point (x,  *
+ *      y), which need not be a vertex of the mesh, for placing labels and    *
+ *      probes on the surface. The partial derivatives are approximated by    *
+ *      central differences with step eps, and the normal is the normalized   *
+ *      vector (-f_x, -f_y, 1), pointing upwards.                             *
+ *  Arguments:                                                                *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface, z = f(x, y).                                         *
+ *      x (float32):                                                          *
+ *          The x coordinate of the point.                                    *
+ *      y (float32):                                                          *
+ *          The y coordinate of the point.                                    *
+ *      eps (float32):                                                        *
+ *          The step size for the differences, positive.                      *
+ *  Output:                                                                   *
+ *      normal ([3]float32):                                                  *
+ *          The unit normal at the point.                                     *
+ ******************************************************************************/
+func NormalAt(f SurfaceParametrization, x, y, eps float32) [3]float32 {
+
+    _, fx, fy := ProbeSurface(f, x, y, eps)
+    return normalizeVector([3]float32{-fx, -fy, 1.0})
+}
+/*  End of NormalAt.                                                          */
@@ -0,0 +1,52 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the normal of a graph at an arbitrary point.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt2 is provided here.                                                   */
+import (
+    "math"
+    "testing"
+)
+
+/*  The paraboloid z = x^2 + y^2 has the normal (-2x, -2y, 1), normalized. At *
+ *  the origin this is straight up.                                           */
+func TestNormalAtParaboloid(t *testing.T) {
+    const halfRoot2 float32 = 0.5 * math.Sqrt2
+
+    var cases = []struct {
+        x, y float32
+        want [3]float32
+    }{
+        {0.0, 0.0, [3]float32{0.0, 0.0, 1.0}},
+        {0.5, 0.0, [3]float32{-halfRoot2, 0.0, halfRoot2}},
+        {0.0, -0.5, [3]float32{0.0, halfRoot2, halfRoot2}},
+    }
+
+    for _, c := range cases {
+        var normal [3]float32 = NormalAt(paraboloid, c.x, c.y, 1.0E-3)
+        expectVector(t, "normal", normal, c.want, 1.0E-4)
+    }
+}
+/*  End of TestNormalAtParaboloid.                                            */
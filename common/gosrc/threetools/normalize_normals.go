@@ -0,0 +1,64 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rescales the normals of a mesh to unit length.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NormalizeNormals                                                      *
+ *  Purpose:                                                                  *
+ *      Rescales each normal vector of a canvas to have unit length. Zero     *
+ *      vectors, which occur for degenerate parts of a mesh, are left as is.  *
+ *      If the normals have not been computed nothing is done.                *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose normals are being normalized.                    *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) NormalizeNormals() {
+
+    /*  Variable for indexing over the normals.                               */
+    var index int
+
+    /*  Avoid reading beyond the buffer if the normals were never computed.   */
+    if len(self.Normals) < self.MeshSize {
+        return
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        var normal [3]float32 = normalizeVector(
+            [3]float32{
+                self.Normals[index],
+                self.Normals[index + 1],
+                self.Normals[index + 2],
+            },
+        )
+
+        self.Normals[index] = normal[0]
+        self.Normals[index + 1] = normal[1]
+        self.Normals[index + 2] = normal[2]
+    }
+}
+/*  End of NormalizeNormals.                                                  */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Moves the vertices of a mesh along their normals.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      OffsetAlongNormals                                                    *
+ *  Purpose:                                                                  *
+ *      Moves each active vertex by the given distance along its normal,      *
+ *      giving the parallel surface used for shells and outlines. A negative  *
+ *      distance moves against the normals. The normals are read as they are, *
+ *      they should be unit length, see ComputeNormals. The offset surface    *
+ *      has the same normals where it is smooth, so these are kept.           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose vertices are being moved.                        *
+ *      distance (float32):                                                   *
+ *          The distance each vertex is moved.                                *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrSizeMismatch if the normals have not been computed.            *
+ ******************************************************************************/
+func (self *Canvas) OffsetAlongNormals(distance float32) error {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    if len(self.Normals) < self.MeshSize {
+        return fmt.Errorf(
+            "%w: %d normal components for %d mesh components",
+            ErrSizeMismatch, len(self.Normals), self.MeshSize,
+        )
+    }
+
+    for index = 0; index < self.MeshSize; index++ {
+        self.Mesh[index] += distance * self.Normals[index]
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+
+    return nil
+}
+/*  End of OffsetAlongNormals.                                                */
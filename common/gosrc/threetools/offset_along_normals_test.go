@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for moving the vertices along their normals.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Offsetting the flat plane by d raises every vertex by exactly d and       *
+ *  leaves x and y alone, since every normal is (0, 0, 1).                    */
+func TestOffsetAlongNormalsPlane(t *testing.T) {
+    const distance float32 = 0.375
+
+    var canvas *Canvas = newGraphCanvas(t, Plane, WithGrid(6, 5))
+    var original []float32 = append([]float32(nil), canvas.Mesh...)
+    canvas.ComputeNormals()
+
+    if err := canvas.OffsetAlongNormals(distance); err != nil {
+        t.Fatalf("OffsetAlongNormals: %v", err)
+    }
+
+    for index := 0; index < canvas.MeshSize; index++ {
+        var want float32 = original[index]
+
+        if index % 3 == 2 {
+            want += distance
+        }
+
+        if canvas.Mesh[index] != want {
+            t.Fatalf("mesh[%d] = %g, want %g", index, canvas.Mesh[index], want)
+        }
+    }
+}
+/*  End of TestOffsetAlongNormalsPlane.                                       */
+
+/*  Without normals there is nothing to move along, this is reported.         */
+func TestOffsetAlongNormalsMissingNormals(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, Plane, WithGrid(6, 5))
+
+    if err := canvas.OffsetAlongNormals(1.0); !errors.Is(err, ErrSizeMismatch) {
+        t.Errorf("got %v, want ErrSizeMismatch", err)
+    }
+}
+/*  End of TestOffsetAlongNormalsMissingNormals.                              */
@@ -0,0 +1,78 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Flips the normals of a closed mesh to point outwards.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      OrientNormalsOutward                                                  *
+ *  Purpose:                                                                  *
+ *      Flips the normals of a closed surface so that they point away from    *
+ *      the centroid of the mesh. Each normal is compared with the vector     *
+ *      from the centroid to its vertex, and is negated if the two point in   *
+ *      opposite directions. This is exact for star-shaped surfaces like      *
+ *      spheres and ellipsoids, and a good heuristic for other closed         *
+ *      surfaces.                                                             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose normals are being oriented.                      *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) OrientNormalsOutward() {
+
+    /*  Variable for indexing over the vertices and normals.                  */
+    var index int
+
+    /*  The center of the mesh, the mean of the vertices.                     */
+    var center [3]float32
+
+    /*  Avoid reading beyond the buffer if the normals were never computed.   */
+    if (len(self.Normals) < self.MeshSize) || (self.NumberOfPoints == 0) {
+        return
+    }
+
+    center = self.Centroid()
+
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var normalIndex int = 3 * index
+
+        /*  The direction from the center to the vertex is "outwards".        */
+        var outward [3]float32 = vectorDifference(self.point(index), center)
+
+        var normal [3]float32 = [3]float32{
+            self.Normals[normalIndex],
+            self.Normals[normalIndex + 1],
+            self.Normals[normalIndex + 2],
+        }
+
+        /*  Negate normals that point inwards.                                */
+        if dotProduct(normal, outward) < 0.0 {
+            self.Normals[normalIndex] = -normal[0]
+            self.Normals[normalIndex + 1] = -normal[1]
+            self.Normals[normalIndex + 2] = -normal[2]
+        }
+    }
+}
+/*  End of OrientNormalsOutward.                                              */
@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for normalizing the normals and orienting them consistently.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Scaling every other normal of a saddle by -3 makes them the wrong length  *
+ *  and flips them over. Normalizing and then orienting them towards +z       *
+ *  restores the normals computed from the surface.                           */
+func TestOrientNormalsSaddle(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(7, 7))
+    canvas.ComputeNormals()
+
+    var original []float32 = append([]float32(nil), canvas.Normals...)
+
+    for index := 3; index < canvas.MeshSize; index += 6 {
+        for component := 0; component < 3; component++ {
+            canvas.Normals[index + component] *= -3.0
+        }
+    }
+
+    canvas.NormalizeNormals()
+    canvas.OrientNormals([3]float32{0.0, 0.0, 1.0})
+
+    for index := 0; index < canvas.MeshSize; index += 3 {
+        var got [3]float32 = [3]float32{
+            canvas.Normals[index],
+            canvas.Normals[index + 1],
+            canvas.Normals[index + 2],
+        }
+        var want [3]float32 = [3]float32{
+            original[index], original[index + 1], original[index + 2],
+        }
+
+        if !closeTo(dotProduct(got, got), 1.0, 1.0E-5) || (got[2] <= 0.0) {
+            t.Errorf("normal %d is %v, not a unit vector facing +z",
+                     index / 3, got)
+        }
+
+        expectVector(t, "oriented normal", got, want, 1.0E-5)
+    }
+}
+/*  End of TestOrientNormalsSaddle.                                           */
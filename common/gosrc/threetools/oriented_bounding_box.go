@@ -0,0 +1,98 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the oriented bounding box of a mesh.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      OrientedBoundingBox                                                   *
+ *  Purpose:                                                                  *
+ *      Computes a box around the active vertices aligned with their          *
+ *      principal axes, the directions of largest to smallest variance, as    *
+ *      in AlignPrincipalAxes, rather than with the coordinate axes. The      *
+ *      vertices are projected onto each axis, and the box spans              *
+ *      the smallest to the largest projection, so it fits tightly around     *
+ *      tilted figures. For a mesh already aligned with the coordinate axes   *
+ *      this is the axis-aligned BoundingBox. The box is a point at the       *
+ *      origin for an empty mesh.                                             *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose bounding box is being computed.                  *
+ *  Output:                                                                   *
+ *      center ([3]float32):                                                  *
+ *          The center of the box.                                            *
+ *      axes ([3][3]float32):                                                 *
+ *          The directions of the edges of the box, largest variance first.   *
+ *      extents ([3]float32):                                                 *
+ *          Half of the length of the box along each axis.                    *
+ ******************************************************************************/
+func OrientedBoundingBox(
+    canvas *Canvas,
+) ([3]float32, [3][3]float32, [3]float32) {
+
+    /*  Variables for indexing over the vertices and the axes.                */
+    var index, axis int
+
+    /*  The smallest and largest projections onto each axis.                  */
+    var lower, upper [3]float32
+
+    /*  The output, the box.                                                  */
+    var center, extents [3]float32
+
+    mean, axes, _ := canvas.principalAxes()
+
+    if canvas.NumberOfPoints == 0 {
+        return center, axes, extents
+    }
+
+    /*  The projections are measured from the mean, which is inside the box.  */
+    for index = 0; index < canvas.MeshSize; index += 3 {
+        var p [3]float32 = vectorDifference(canvas.point(index / 3), mean)
+
+        for axis = 0; axis < 3; axis++ {
+            var projection float32 = dotProduct(axes[axis], p)
+
+            if (index == 0) || (projection < lower[axis]) {
+                lower[axis] = projection
+            }
+
+            if (index == 0) || (projection > upper[axis]) {
+                upper[axis] = projection
+            }
+        }
+    }
+
+    /*  The center is the middle of the projections, back in coordinates.     */
+    center = mean
+
+    for axis = 0; axis < 3; axis++ {
+        var middle float32 = 0.5 * (lower[axis] + upper[axis])
+
+        center = vectorSum(center, scaleVector(axes[axis], middle))
+        extents[axis] = 0.5 * (upper[axis] - lower[axis])
+    }
+
+    return center, axes, extents
+}
+/*  End of OrientedBoundingBox.                                               */
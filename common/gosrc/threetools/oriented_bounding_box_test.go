@@ -0,0 +1,91 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the bounding box aligned with the principal axes.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Abs, Cos, and Sin are provided here.                                      */
+import (
+    "math"
+    "testing"
+)
+
+/*  Returns the corners of a box with the given center and half lengths,      *
+ *  turned by an angle about the z axis.                                      */
+func boxCorners(center, extents [3]float32, angle float64) [][3]float32 {
+    var corners [][3]float32
+    var cosine float32 = float32(math.Cos(angle))
+    var sine float32 = float32(math.Sin(angle))
+
+    for corner := 0; corner < 8; corner++ {
+        var p [3]float32
+
+        for axis := 0; axis < 3; axis++ {
+            p[axis] = extents[axis]
+
+            if corner & (1 << axis) != 0 {
+                p[axis] = -p[axis]
+            }
+        }
+
+        var x float32 = cosine * p[0] - sine * p[1]
+        var y float32 = sine * p[0] + cosine * p[1]
+        var turned [3]float32 = [3]float32{x, y, p[2]}
+        corners = append(corners, vectorSum(turned, center))
+    }
+
+    return corners
+}
+/*  End of boxCorners.                                                        */
+
+/*  The corners of a box, axis-aligned or turned about z, give back its       *
+ *  center, its half lengths, and its edge directions, up to sign.            */
+func TestOrientedBoundingBoxRecoversBox(t *testing.T) {
+    var center [3]float32 = [3]float32{1.0, -1.0, 2.0}
+    var extents [3]float32 = [3]float32{3.0, 2.0, 1.0}
+
+    for _, angle := range []float64{0.0, 0.5} {
+        var cosine float32 = float32(math.Cos(angle))
+        var sine float32 = float32(math.Sin(angle))
+        var want [3][3]float32 = [3][3]float32{
+            {cosine, sine, 0.0}, {-sine, cosine, 0.0}, {0.0, 0.0, 1.0},
+        }
+
+        var corners [][3]float32 = boxCorners(center, extents, angle)
+        var canvas *Canvas = newPointCanvas(t, corners)
+        gotCenter, axes, gotExtents := OrientedBoundingBox(canvas)
+
+        expectVector(t, "center", gotCenter, center, 1.0E-5)
+        expectVector(t, "extents", gotExtents, extents, 1.0E-5)
+
+        for axis := 0; axis < 3; axis++ {
+            var alignment float32 = dotProduct(axes[axis], want[axis])
+
+            if !closeTo(float32(math.Abs(float64(alignment))), 1.0, 1.0E-5) {
+                t.Errorf("angle %g: axis %d is %v, want ±%v",
+                         angle, axis, axes[axis], want[axis])
+            }
+        }
+    }
+}
+/*  End of TestOrientedBoundingBoxRecoversBox.                                */
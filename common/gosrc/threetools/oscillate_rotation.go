@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rocks a mesh back and forth about the z axis.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sin is provided here.                                                     */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      OscillateRotation                                                     *
+ *  Purpose:                                                                  *
+ *      Sets the rotation about the z axis to amplitude sin(2 pi t / period)  *
+ *      and applies it to the base mesh, see CaptureBaseOrientation. The      *
+ *      figure rocks back and forth within [-amplitude, amplitude] instead of *
+ *      spinning. Since the angle is computed from the time, not accumulated, *
+ *      there is no drift. The other drag and spin angles are kept. A non-    *
+ *      positive period leaves the canvas unchanged.                          *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being rotated.                           *
+ *      amplitude (float32):                                                  *
+ *          The largest angle of the rotation, in radians.                    *
+ *      period (float32):                                                     *
+ *          The time for one full oscillation, in seconds.                    *
+ *      t (float32):                                                          *
+ *          The current time, in seconds.                                     *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func OscillateRotation(canvas *Canvas, amplitude, period, t float32) {
+    if period <= 0.0 {
+        return
+    }
+
+    var phase float64 = 2.0 * math.Pi * float64(t / period)
+
+    canvas.ensureBaseOrientation()
+    canvas.Roll = amplitude * float32(math.Sin(phase))
+    canvas.applyBaseOrientation()
+}
+/*  End of OscillateRotation.                                                 */
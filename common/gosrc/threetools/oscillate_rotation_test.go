@@ -0,0 +1,65 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the back and forth rotation of the mesh.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sin and Pi are provided by math, used for the expected angle.             */
+import (
+    "math"
+    "testing"
+)
+
+/*  The roll follows amplitude sin(2 pi t / period). It is zero at t = 0,     *
+ *  leaving the mesh as it was, the full amplitude a quarter period later,    *
+ *  and back to zero after half a period. The mesh is the original turned     *
+ *  about z by that angle every time.                                         */
+func TestOscillateRotationFollowsSine(t *testing.T) {
+    const amplitude, period float32 = 0.6, 2.0
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 4))
+    var original *Canvas = canvas.Clone()
+
+    for _, time := range []float32{0.0, 0.25 * period, 0.3, 0.5 * period} {
+        var phase float64 = 2.0 * math.Pi * float64(time / period)
+        var angle float32 = amplitude * float32(math.Sin(phase))
+        var expected *Canvas = original.Clone()
+
+        OscillateRotation(canvas, amplitude, period, time)
+        expected.RotateMesh(UnitVectorFromAngle(angle))
+
+        if !closeTo(canvas.Roll, angle, 1.0E-6) {
+            t.Errorf("roll %g at time %g, want %g", canvas.Roll, time, angle)
+        }
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            expectVector(t, "vertex", canvas.point(index),
+                         expected.point(index), 1.0E-5)
+        }
+    }
+
+    if !closeTo(canvas.Roll, 0.0, 1.0E-6) {
+        t.Errorf("roll %g after half a period, want 0", canvas.Roll)
+    }
+}
+/*  End of TestOscillateRotationFollowsSine.                                  */
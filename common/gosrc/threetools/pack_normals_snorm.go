@@ -0,0 +1,112 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Packs the normals into signed bytes for compact uploads.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Round is provided here.                                                   */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      PackNormalsSNorm                                                      *
+ *  Purpose:                                                                  *
+ *      Converts the normals to normalized signed bytes, as used by glTF for  *
+ *      compact normal data. Each component c in [-1, 1] becomes the byte     *
+ *      round(127 c), and each normal is followed by a zero byte so that      *
+ *      every vertex takes four bytes, as glTF requires, a quarter of the     *
+ *      twelve bytes of three floats. The normals are computed if they are    *
+ *      not already. Components outside of [-1, 1] are clamped.               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose normals are being packed.                        *
+ *  Output:                                                                   *
+ *      packed ([]int8):                                                      *
+ *          Four bytes per vertex, x, y, z, and padding.                      *
+ ******************************************************************************/
+func PackNormalsSNorm(canvas *Canvas) []int8 {
+
+    /*  Variables for indexing over the vertices and their components.        */
+    var index, axis int
+
+    var packed []int8 = make([]int8, 4 * canvas.NumberOfPoints)
+
+    if len(canvas.Normals) < canvas.MeshSize {
+        canvas.ComputeNormals()
+    }
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        for axis = 0; axis < 3; axis++ {
+            var value float64 = float64(canvas.Normals[3 * index + axis])
+
+            if value > 1.0 {
+                value = 1.0
+            } else if value < -1.0 {
+                value = -1.0
+            }
+
+            packed[4 * index + axis] = int8(math.Round(127.0 * value))
+        }
+    }
+
+    return packed
+}
+/*  End of PackNormalsSNorm.                                                  */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      UnpackNormalsSNorm                                                    *
+ *  Purpose:                                                                  *
+ *      Converts normals packed by PackNormalsSNorm back to three floats per  *
+ *      vertex, using the glTF rule c = max(b / 127, -1) for each byte b. The *
+ *      padding bytes are skipped. The results are not normalized again.      *
+ *  Arguments:                                                                *
+ *      packed ([]int8):                                                      *
+ *          Four bytes per vertex, x, y, z, and padding.                      *
+ *  Output:                                                                   *
+ *      normals ([]float32):                                                  *
+ *          Three floats per vertex.                                          *
+ ******************************************************************************/
+func UnpackNormalsSNorm(packed []int8) []float32 {
+
+    /*  Variables for indexing over the vertices and their components.        */
+    var index, axis int
+
+    var count int = len(packed) / 4
+    var normals []float32 = make([]float32, 3 * count)
+
+    for index = 0; index < count; index++ {
+        for axis = 0; axis < 3; axis++ {
+            var value float32 = float32(packed[4 * index + axis]) / 127.0
+
+            if value < -1.0 {
+                value = -1.0
+            }
+
+            normals[3 * index + axis] = value
+        }
+    }
+
+    return normals
+}
+/*  End of UnpackNormalsSNorm.                                                */
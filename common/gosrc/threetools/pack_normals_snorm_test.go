@@ -0,0 +1,66 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for packing the normals into signed bytes.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Acos, Min, and Pi are provided here.                                      */
+import (
+    "math"
+    "testing"
+)
+
+/*  Packing the unit normals of a saddle into signed bytes and unpacking them *
+ *  turns each by less than a degree, and the padding byte of each vertex is  *
+ *  zero.                                                                     */
+func TestPackNormalsSNormRoundTrip(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(9, 9))
+    canvas.ComputeNormals()
+
+    var packed []int8 = PackNormalsSNorm(canvas)
+    var normals []float32 = UnpackNormalsSNorm(packed)
+
+    if len(normals) != canvas.MeshSize {
+        t.Fatalf("%d unpacked floats, want %d", len(normals), canvas.MeshSize)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var original [3]float32
+        var unpacked [3]float32
+        copy(original[:], canvas.Normals[3 * index:3 * index + 3])
+        copy(unpacked[:], normals[3 * index:3 * index + 3])
+
+        var cosine float32 = dotProduct(original, normalizeVector(unpacked))
+        var degrees float64 = math.Acos(math.Min(float64(cosine), 1.0)) *
+                              180.0 / math.Pi
+
+        if degrees >= 1.0 {
+            t.Errorf("normal %d turned by %g degrees", index, degrees)
+        }
+
+        if packed[4 * index + 3] != 0 {
+            t.Errorf("padding of vertex %d is %d", index, packed[4 * index + 3])
+        }
+    }
+}
+/*  End of TestPackNormalsSNormRoundTrip.                                     */
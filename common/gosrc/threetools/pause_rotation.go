@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Pauses and resumes the rotation of a canvas.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      PauseRotation                                                         *
+ *  Purpose:                                                                  *
+ *      Holds the mesh still. StepByTime and StepRotation leave the angles    *
+ *      and the angular velocity alone until ResumeRotation is called, so the *
+ *      front end may keep calling them every frame. Rotations that set the   *
+ *      angles directly, like DragRotate and OscillateRotation, still apply.  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose rotation is paused.                              *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) PauseRotation() {
+    self.RotationPaused = true
+}
+/*  End of PauseRotation.                                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ResumeRotation                                                        *
+ *  Purpose:                                                                  *
+ *      Lets StepByTime and StepRotation turn the mesh again after            *
+ *      PauseRotation. The angles were frozen and the time spent paused is    *
+ *      never added, so the rotation continues from the paused orientation    *
+ *      without a jump.                                                       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose rotation is resumed.                             *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ResumeRotation() {
+    self.RotationPaused = false
+}
+/*  End of ResumeRotation.                                                    */
@@ -0,0 +1,103 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Finds the vertex of a mesh nearest to a ray.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Inf is provided here, the distance when there are no vertices.            */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      PickNearestVertex                                                     *
+ *  Purpose:                                                                  *
+ *      Finds the active vertex closest to a ray, measured by the             *
+ *      perpendicular distance from the vertex to the ray. Vertices behind    *
+ *      the origin are measured to the origin itself. With the ray through    *
+ *      the camera and the mouse this turns a click into a point of the       *
+ *      surface. The grid indices follow the layout of the canvas, see        *
+ *      gridIndex. If several vertices are equally close the first is         *
+ *      returned. A zero direction measures the distance to the origin.       *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being picked from.                       *
+ *      origin ([3]float32):                                                  *
+ *          The start of the ray.                                             *
+ *      dir ([3]float32):                                                     *
+ *          The direction of the ray, need not be normalized.                 *
+ *  Output:                                                                   *
+ *      xIndex (uint32):                                                      *
+ *          The column of the nearest vertex.                                 *
+ *      yIndex (uint32):                                                      *
+ *          The row of the nearest vertex.                                    *
+ *      dist (float32):                                                       *
+ *          The distance to the ray, infinite if there are no vertices.       *
+ ******************************************************************************/
+func PickNearestVertex(canvas *Canvas,
+                       origin, dir [3]float32) (uint32, uint32, float32) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    /*  The nearest vertex found so far and its distance to the ray.          */
+    var nearest int = 0
+    var nearestDistance float32 = float32(math.Inf(1))
+
+    /*  Distances along the ray are measured with a unit direction.           */
+    var direction [3]float32 = normalizeVector(dir)
+
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+        var offset [3]float32 = vectorDifference(canvas.point(index), origin)
+        var t float32 = dotProduct(offset, direction)
+
+        /*  The closest point of the ray to vertices behind it is the origin. */
+        if t < 0.0 {
+            t = 0.0
+        }
+
+        var distance float32 =
+            vectorNorm(vectorDifference(offset, scaleVector(direction, t)))
+
+        if distance < nearestDistance {
+            nearest = index
+            nearestDistance = distance
+        }
+    }
+
+    /*  A canvas without rows or columns has no vertices, avoid dividing by   *
+     *  zero.                                                                 */
+    if (canvas.NxPts == 0) || (canvas.NyPts == 0) {
+        return 0, 0, nearestDistance
+    }
+
+    /*  Invert gridIndex for the layout of the canvas.                        */
+    if canvas.LayoutColumnMajor {
+        var height uint32 = canvas.NyPts
+        return uint32(nearest) / height, uint32(nearest) % height,
+               nearestDistance
+    }
+
+    var width uint32 = canvas.NxPts
+    return uint32(nearest) % width, uint32(nearest) / width, nearestDistance
+}
+/*  End of PickNearestVertex.                                                 */
@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for picking the vertex nearest to a ray.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A ray from a point above the surface aimed straight at a vertex picks     *
+ *  that vertex, at distance zero, for either layout of the grid.             */
+func TestPickNearestVertexAimedRay(t *testing.T) {
+    var targets = [][2]uint32{{0, 0}, {3, 2}, {6, 4}, {1, 3}}
+
+    for _, columnMajor := range []bool{false, true} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(7, 5), WithColumnMajor(columnMajor),
+        )
+
+        for _, target := range targets {
+            var index uint32 = canvas.gridIndex(target[0], target[1])
+            var p [3]float32 = canvas.point(int(index))
+            var origin [3]float32 = vectorSum(p, [3]float32{0.3, -0.2, 5.0})
+            var direction [3]float32 = vectorDifference(p, origin)
+
+            xIndex, yIndex, distance := PickNearestVertex(
+                canvas, origin, direction,
+            )
+
+            if (xIndex != target[0]) || (yIndex != target[1]) {
+                t.Errorf("column major %v: picked (%d, %d), want %v",
+                         columnMajor, xIndex, yIndex, target)
+            }
+
+            if !closeTo(distance, 0.0, 1.0E-5) {
+                t.Errorf("column major %v: distance %g to %v, want 0",
+                         columnMajor, distance, target)
+            }
+        }
+    }
+}
+/*  End of TestPickNearestVertexAimedRay.                                     */
@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the flat plane z = 0.                                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  The plane z = 0, the flat sheet surfaces are morphed up from.             */
+func Plane(x, y float32) float32 {
+    return 0.0
+}
+/*  End of Plane.                                                             */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GeneratePlane                                                         *
+ *  Purpose:                                                                  *
+ *      Computes the vertices of the plane z = 0 over the domain of the       *
+ *      canvas, for any mesh type. This is a reference surface, and the flat  *
+ *      start of animations that raise a surface from a sheet. The plane is   *
+ *      stored in the canvas so Regenerate redraws it, and is also in the     *
+ *      registry as "plane".                                                  *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid already set up.       *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from GenerateMeshFromParametrization, if any.           *
+ ******************************************************************************/
+func GeneratePlane(canvas *Canvas) error {
+    canvas.Surface = Plane
+    return canvas.GenerateMeshFromParametrization(canvas.Surface)
+}
+/*  End of GeneratePlane.                                                     */
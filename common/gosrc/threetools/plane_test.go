@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the flat baseline surface.                                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Checks that every vertex of a canvas lies on the plane z = 0.             */
+func expectFlat(t *testing.T, name string, canvas *Canvas) {
+    t.Helper()
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        if z := canvas.Mesh[3 * index + 2]; z != 0.0 {
+            t.Fatalf("%s: vertex %d has z = %g, want exactly 0",
+                     name, index, z)
+        }
+    }
+}
+/*  End of expectFlat.                                                        */
+
+/*  Replacing the paraboloid with the plane gives z exactly 0 at every        *
+ *  vertex, for every mesh type, and so does the "plane" preset.              */
+func TestPlaneIsFlat(t *testing.T) {
+    for meshType := uint(0); isValidMeshType(meshType); meshType++ {
+        var canvas *Canvas = newTestCanvas(
+            t, WithGrid(6, 5), WithMeshType(meshType),
+        )
+
+        /*  Start from a mesh that is not flat, so the plane must overwrite   *
+         *  every height.                                                     */
+        for index := range canvas.Mesh {
+            canvas.Mesh[index] = 1.0
+        }
+
+        var err error = GeneratePlane(canvas)
+
+        /*  The plane is a graph, it can not close the seams of the wrapped   *
+         *  mesh types, and these are rejected.                               */
+        if errors.Is(err, ErrIncompatibleGenerator) {
+            continue
+        }
+
+        if err != nil {
+            t.Fatalf("mesh type %d: GeneratePlane: %v", meshType, err)
+        }
+
+        expectFlat(t, "GeneratePlane", canvas)
+    }
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(6, 5))
+
+    if err := canvas.GenerateSurfacePreset("plane"); err != nil {
+        t.Fatalf("GenerateSurfacePreset: %v", err)
+    }
+
+    expectFlat(t, "plane preset", canvas)
+}
+/*  End of TestPlaneIsFlat.                                                   */
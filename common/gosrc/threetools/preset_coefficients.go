@@ -0,0 +1,45 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Merges the coefficients of a canvas with the defaults of a preset.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the coefficients used to draw a family of surfaces, the defaults  *
+ *  of the preset with any values set on the canvas taking their place. Only  *
+ *  the names listed by the preset are used.                                  */
+func (self *Canvas) presetCoefficients(
+    preset SurfacePreset,
+) map[string]float32 {
+    var merged map[string]float32 = make(map[string]float32)
+
+    for name, value := range preset.Coefficients {
+        merged[name] = value
+
+        if custom, found := self.Coefficients[name]; found {
+            merged[name] = custom
+        }
+    }
+
+    return merged
+}
+/*  End of presetCoefficients.                                                */
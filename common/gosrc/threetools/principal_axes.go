@@ -0,0 +1,104 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the principal axes of the vertices of a mesh.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      principalAxes                                                         *
+ *  Purpose:                                                                  *
+ *      Computes the mean and the covariance matrix of the active vertices of *
+ *      a canvas, and the eigenvectors of the covariance matrix. These are    *
+ *      the principal axes, the directions of largest to smallest variance.   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose principal axes are being computed.               *
+ *  Output:                                                                   *
+ *      center ([3]float32):                                                  *
+ *          The mean of the active vertices.                                  *
+ *      axes ([3][3]float32):                                                 *
+ *          The principal axes, largest variance first, forming a right-      *
+ *          handed orthonormal frame.                                         *
+ *      variances ([3]float32):                                               *
+ *          The variance of the vertices along each axis.                     *
+ ******************************************************************************/
+func (self *Canvas) principalAxes() ([3]float32, [3][3]float32, [3]float32) {
+
+    /*  Variables for indexing over the vertices and the matrix entries.      */
+    var index, row, column int
+
+    /*  The mean and covariance are accumulated in double precision.          */
+    var mean [3]float64
+    var covariance [3][3]float64
+
+    /*  The output, converted back to single precision.                       */
+    var center [3]float32
+    var axes [3][3]float32
+    var variances [3]float32
+
+    if self.NumberOfPoints == 0 {
+        axes = [3][3]float32{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+        return center, axes, variances
+    }
+
+    center = self.Centroid()
+
+    for row = 0; row < 3; row++ {
+        mean[row] = float64(center[row])
+    }
+
+    /*  The covariance is the mean of the outer products of the centered      *
+     *  vertices with themselves.                                             */
+    for index = 0; index < self.MeshSize; index += 3 {
+        for row = 0; row < 3; row++ {
+            var a float64 = float64(self.Mesh[index + row]) - mean[row]
+
+            for column = 0; column < 3; column++ {
+                var b float64 = float64(self.Mesh[index + column]) -
+                                mean[column]
+                covariance[row][column] += a * b
+            }
+        }
+    }
+
+    for row = 0; row < 3; row++ {
+        for column = 0; column < 3; column++ {
+            covariance[row][column] /= float64(self.NumberOfPoints)
+        }
+    }
+
+    /*  The principal axes are the eigenvectors of the covariance matrix.     */
+    values, vectors := symmetricEigen(covariance)
+
+    for row = 0; row < 3; row++ {
+        variances[row] = float32(values[row])
+
+        for column = 0; column < 3; column++ {
+            axes[row][column] = float32(vectors[row][column])
+        }
+    }
+
+    return center, axes, variances
+}
+/*  End of principalAxes.                                                     */
@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Evaluates a surface and its slope at a point.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ProbeSurface                                                          *
+ *  Purpose:                                                                  *
+ *      Evaluates the surface z = f(x, y) and its partial derivatives at the  *
+ *      point (x, y), which need not be a vertex of the mesh, for showing the *
+ *      value and slope under the cursor. The derivatives are approximated by *
+ *      central differences with step eps, as in NormalAt.                    *
+ *  Arguments:                                                                *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface, z = f(x, y).                                         *
+ *      x (float32):                                                          *
+ *          The x coordinate of the point.                                    *
+ *      y (float32):                                                          *
+ *          The y coordinate of the point.                                    *
+ *      eps (float32):                                                        *
+ *          The step size for the differences, positive.                      *
+ *  Output:                                                                   *
+ *      z (float32):                                                          *
+ *          The height of the surface at the point.                           *
+ *      dzdx (float32):                                                       *
+ *          The partial derivative with respect to x.                         *
+ *      dzdy (float32):                                                       *
+ *          The partial derivative with respect to y.                         *
+ ******************************************************************************/
+func ProbeSurface(f SurfaceParametrization,
+                  x, y, eps float32) (float32, float32, float32) {
+
+    /*  The differences are divided by twice the step size.                   */
+    var rcpr float32 = 0.5 / eps
+
+    var dzdx float32 = (f(x + eps, y) - f(x - eps, y)) * rcpr
+    var dzdy float32 = (f(x, y + eps) - f(x, y - eps)) * rcpr
+
+    return f(x, y), dzdx, dzdy
+}
+/*  End of ProbeSurface.                                                      */
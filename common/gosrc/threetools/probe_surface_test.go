@@ -0,0 +1,46 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for probing the height and slope of a surface.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On z = x^2 + y^2 the probe at (1, 0) gives the height 1 and the gradient  *
+ *  (2, 0), central differences are exact for a quadratic up to rounding.     */
+func TestProbeSurfaceParaboloid(t *testing.T) {
+    z, dzdx, dzdy := ProbeSurface(paraboloid, 1.0, 0.0, 1.0E-3)
+
+    if !closeTo(z, 1.0, 1.0E-6) {
+        t.Errorf("z = %g, want 1", z)
+    }
+
+    if !closeTo(dzdx, 2.0, 1.0E-3) {
+        t.Errorf("dzdx = %g, want 2", dzdx)
+    }
+
+    if !closeTo(dzdy, 0.0, 1.0E-3) {
+        t.Errorf("dzdy = %g, want 0", dzdy)
+    }
+}
+/*  End of TestProbeSurfaceParaboloid.                                        */
@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Projects the vertices of a mesh onto the plane perpendicular to a     *
+ *      viewing direction.                                                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ProjectOrthographic                                                   *
+ *  Purpose:                                                                  *
+ *      Computes the orthographic projection of the active vertices of a      *
+ *      canvas as seen by a camera looking along the given direction.         *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh that is being projected.                 *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, pointing from the viewer into the scene.   *
+ *  Output:                                                                   *
+ *      projected ([]float32):                                                *
+ *          The 2D screen coordinates, two floats per vertex, in the same     *
+ *          order as the mesh.                                                *
+ ******************************************************************************/
+func ProjectOrthographic(canvas *Canvas, direction [3]float32) []float32 {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    /*  The screen coordinates are found by projecting onto the right and up  *
+     *  vectors of the camera. The forward vector is not needed.              */
+    right, up, _ := viewBasis(direction)
+
+    /*  Each vertex is projected to a point in the plane, two floats.         */
+    var projected []float32 = make([]float32, 2 * canvas.NumberOfPoints)
+
+    /*  Loop through the active vertices of the mesh.                         */
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+
+        /*  A vertex is given by three consecutive floats in the mesh.        */
+        var point [3]float32 = [3]float32{
+            canvas.Mesh[3 * index],
+            canvas.Mesh[3 * index + 1],
+            canvas.Mesh[3 * index + 2],
+        }
+
+        /*  The screen coordinates are the components along right and up.     */
+        projected[2 * index] = dotProduct(point, right)
+        projected[2 * index + 1] = dotProduct(point, up)
+    }
+
+    return projected
+}
+/*  End of ProjectOrthographic.                                               */
@@ -0,0 +1,97 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Projects the vertices of a mesh onto the screen of a pinhole camera.  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  tan is provided here, used for computing the focal length of the camera.  */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ProjectPerspective                                                    *
+ *  Purpose:                                                                  *
+ *      Computes the perspective projection of the active vertices of a       *
+ *      canvas as seen by a pinhole camera located at the eye and looking     *
+ *      towards the origin. Nearer parts of the surface appear larger. The    *
+ *      depth of each vertex is returned as well, and vertices with non-      *
+ *      positive depth lie behind the camera. These are clipped, their screen *
+ *      coordinates are set to zero and should not be drawn.                  *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh that is being projected.                 *
+ *      eye ([3]float32):                                                     *
+ *          The location of the camera. The camera looks towards the origin.  *
+ *      fov (float32):                                                        *
+ *          The vertical field of view of the camera, in radians.             *
+ *  Output:                                                                   *
+ *      projected ([]float32):                                                *
+ *          Three floats per vertex, the x and y screen coordinates and the   *
+ *          depth along the line of sight. The screen coordinates are         *
+ *          normalized so that the field of view spans [-1, 1] vertically.    *
+ ******************************************************************************/
+func ProjectPerspective(canvas *Canvas, eye [3]float32, fov float32) []float32 {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    /*  The camera looks from the eye towards the origin.                     */
+    right, up, forward := viewBasis([3]float32{-eye[0], -eye[1], -eye[2]})
+
+    /*  Points at the top edge of the field of view should map to y = 1. This *
+     *  is achieved by scaling by the reciprocal of tan(fov / 2).             */
+    var focal float32 = float32(1.0 / math.Tan(0.5 * float64(fov)))
+
+    /*  Each vertex is projected to a point on the screen plus its depth.     */
+    var projected []float32 = make([]float32, 3 * canvas.NumberOfPoints)
+
+    /*  Loop through the active vertices of the mesh.                         */
+    for index = 0; index < canvas.NumberOfPoints; index++ {
+
+        /*  The position of the vertex relative to the camera.                */
+        var point [3]float32 = [3]float32{
+            canvas.Mesh[3 * index] - eye[0],
+            canvas.Mesh[3 * index + 1] - eye[1],
+            canvas.Mesh[3 * index + 2] - eye[2],
+        }
+
+        /*  The depth is the distance along the line of sight.                */
+        var depth float32 = dotProduct(point, forward)
+        projected[3 * index + 2] = depth
+
+        /*  Points on or behind the eye plane can not be seen. Clip them.     */
+        if depth <= 0.0 {
+            projected[3 * index] = 0.0
+            projected[3 * index + 1] = 0.0
+            continue
+        }
+
+        /*  Pinhole camera model, divide by the depth and scale by the focal  *
+         *  length. Nearer points have smaller depth and appear larger.       */
+        projected[3 * index] = focal * dotProduct(point, right) / depth
+        projected[3 * index + 1] = focal * dotProduct(point, up) / depth
+    }
+
+    return projected
+}
+/*  End of ProjectPerspective.                                                */
@@ -0,0 +1,59 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the perspective projection.                                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi is provided by math, for the field of view.                            */
+import (
+    "math"
+    "testing"
+)
+
+/*  A corner of the unit cube seen from (5, 0, 0) with a right angle field of *
+ *  view is at depth 4 and lands at (1/4, 1/4). A point behind the camera is  *
+ *  clipped to the origin of the screen.                                      */
+func TestProjectPerspectiveCubeCorner(t *testing.T) {
+    var canvas *Canvas = newPointCanvas(t, [][3]float32{
+        {1.0, 1.0, 1.0}, {1.0, -1.0, -1.0}, {6.0, 0.0, 0.0},
+    })
+
+    var projected []float32 = ProjectPerspective(
+        canvas, [3]float32{5.0, 0.0, 0.0}, 0.5 * math.Pi,
+    )
+
+    var want [][3]float32 = [][3]float32{
+        {0.25, 0.25, 4.0}, {-0.25, -0.25, 4.0}, {0.0, 0.0, -1.0},
+    }
+
+    for index, point := range want {
+        var got [3]float32 = [3]float32{
+            projected[3 * index],
+            projected[3 * index + 1],
+            projected[3 * index + 2],
+        }
+
+        expectVector(t, "projected point", got, point, 1.0E-6)
+    }
+}
+/*  End of TestProjectPerspectiveCubeCorner.                                  */
@@ -0,0 +1,96 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Stores the vertices of a mesh as integers to save space.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library packages for rounding and formatting the errors.         */
+import (
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      QuantizePositions                                                     *
+ *  Purpose:                                                                  *
+ *      Compresses the vertices of the active mesh for sending them over the  *
+ *      network, like the glTF mesh quantization extension. Each coordinate   *
+ *      is mapped from the bounding box of the mesh to an integer with the    *
+ *      given number of bits, rounding to the nearest level, so the error is  *
+ *      at most half of the size of the box over 2^bits - 1 along each axis.  *
+ *      The box is stored with the integers, see DequantizePositions. An axis *
+ *      along which the box is flat is stored as zero.                        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas whose mesh is being compressed.                        *
+ *      bits (int):                                                           *
+ *          The number of bits per coordinate, from 1 to 16.                  *
+ *  Output:                                                                   *
+ *      quantized (QuantizedMesh):                                            *
+ *          The integers and the box.                                         *
+ *      err (error):                                                          *
+ *          ErrInvalidPrecision if bits is out of range.                      *
+ ******************************************************************************/
+func QuantizePositions(canvas *Canvas, bits int) (QuantizedMesh, error) {
+
+    /*  Variables for indexing over the coordinates.                          */
+    var index, axis int
+
+    /*  The factors converting from the box to the integer levels.            */
+    var scale [3]float32
+
+    var quantized QuantizedMesh
+
+    if (bits < 1) || (bits > 16) {
+        return quantized, fmt.Errorf(
+            "%w: %d bits, must be between 1 and 16", ErrInvalidPrecision, bits,
+        )
+    }
+
+    /*  The largest integer with the given number of bits.                    */
+    var levels float32 = float32(uint32(1) << uint(bits) - 1)
+
+    quantized.Bits = bits
+    quantized.Lower, quantized.Upper = canvas.BoundingBox()
+    quantized.Positions = make([]uint16, canvas.MeshSize)
+
+    for axis = 0; axis < 3; axis++ {
+        var size float32 = quantized.Upper[axis] - quantized.Lower[axis]
+
+        if size > 0.0 {
+            scale[axis] = levels / size
+        }
+    }
+
+    for index = 0; index < canvas.MeshSize; index++ {
+        axis = index % 3
+
+        var offset float32 = canvas.Mesh[index] - quantized.Lower[axis]
+        var level float64 = math.Round(float64(offset * scale[axis]))
+        quantized.Positions[index] = uint16(level)
+    }
+
+    return quantized, nil
+}
+/*  End of QuantizePositions.                                                 */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for compressing the vertices to integers.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Quantizing to 16 bits and back moves each coordinate by at most half of a *
+ *  level, the size of the box over 2^16 - 1, allowing for the rounding of    *
+ *  the floats.                                                               */
+func TestQuantizePositionsRoundTrip(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(9, 7))
+
+    quantized, err := QuantizePositions(canvas, 16)
+
+    if err != nil {
+        t.Fatalf("QuantizePositions: %v", err)
+    }
+
+    var positions []float32 = DequantizePositions(quantized)
+
+    if len(positions) != canvas.MeshSize {
+        t.Fatalf("%d coordinates, want %d", len(positions), canvas.MeshSize)
+    }
+
+    for index, position := range positions {
+        var axis int = index % 3
+        var size float32 = quantized.Upper[axis] - quantized.Lower[axis]
+        var tolerance float32 = 0.5 * size / 65535.0 + 1.0E-6
+
+        if !closeTo(position, canvas.Mesh[index], tolerance) {
+            t.Fatalf("coordinate %d is %g after the round trip, want %g",
+                     index, position, canvas.Mesh[index])
+        }
+    }
+}
+/*  End of TestQuantizePositionsRoundTrip.                                    */
+
+/*  Precisions outside of 1 to 16 bits are rejected.                          */
+func TestQuantizePositionsInvalidBits(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(9, 7))
+
+    for _, bits := range []int{0, 17} {
+        _, err := QuantizePositions(canvas, bits)
+
+        if !errors.Is(err, ErrInvalidPrecision) {
+            t.Errorf("%d bits: got %v, want ErrInvalidPrecision", bits, err)
+        }
+    }
+}
+/*  End of TestQuantizePositionsInvalidBits.                                  */
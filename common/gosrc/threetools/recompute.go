@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Recomputes the sizes derived from the grid.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Recompute                                                             *
+ *  Purpose:                                                                  *
+ *      Updates everything derived from NxPts, NyPts, and MeshType after      *
+ *      these fields were changed directly: NumberOfPoints and MeshSize, the  *
+ *      mesh slice, IndexSize, and the index slice, in this order, the same   *
+ *      steps as ResetMeshBuffer, ComputeIndexSize, and ResetIndexBuffer. If  *
+ *      the grid or mesh type is invalid, or either buffer is too small, none *
+ *      of the derived fields are changed, so the canvas never has sizes from *
+ *      two different grids. The mesh itself is not generated.                *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose fields were changed.                             *
+ *      meshBuffer ([]float32):                                               *
+ *          The memory for the mesh, like MeshStorage.                        *
+ *      indexBuffer ([]uint32):                                               *
+ *          The memory for the indices.                                       *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge, ErrInvalidMeshType, or ErrBufferTooSmall.        *
+ ******************************************************************************/
+func (self *Canvas) Recompute(meshBuffer []float32,
+                              indexBuffer []uint32) error {
+
+    /*  The derived fields, restored if the index buffer is too small.        */
+    var mesh []float32 = self.Mesh
+    var numberOfPoints, meshSize int = self.NumberOfPoints, self.MeshSize
+
+    if err := checkGrid(self.NxPts, self.NyPts, self.MeshType); err != nil {
+        return err
+    }
+
+    if err := self.ResetMeshBuffer(meshBuffer); err != nil {
+        return err
+    }
+
+    if err := self.ResetIndexBuffer(indexBuffer); err != nil {
+        self.Mesh = mesh
+        self.NumberOfPoints, self.MeshSize = numberOfPoints, meshSize
+        return err
+    }
+
+    return nil
+}
+/*  End of Recompute.                                                         */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for updating the sizes derived from the grid.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  After the dimensions are changed directly, Recompute gives the same       *
+ *  derived sizes as a canvas configured with the new grid from the start.    */
+func TestRecomputeAfterResize(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(5, 4))
+    var fresh *Canvas = newTestCanvas(t, WithGrid(7, 6))
+
+    canvas.NxPts, canvas.NyPts = 7, 6
+    err := canvas.Recompute(canvas.MeshStorage, canvas.IndexStorage)
+
+    if err != nil {
+        t.Fatalf("Recompute: %v", err)
+    }
+
+    if (canvas.NumberOfPoints != 42) || (canvas.MeshSize != 126) ||
+       (len(canvas.Mesh) != 126) || (canvas.IndexSize != fresh.IndexSize) ||
+       (len(canvas.Indices) != fresh.IndexSize) {
+        t.Errorf("%d points, mesh %d of %d, indices %d of %d, want 42, " +
+                 "126, and %d", canvas.NumberOfPoints, canvas.MeshSize,
+                 len(canvas.Mesh), canvas.IndexSize, len(canvas.Indices),
+                 fresh.IndexSize)
+    }
+}
+/*  End of TestRecomputeAfterResize.                                          */
+
+/*  If the index buffer is too small none of the derived sizes change, not    *
+ *  even those of the mesh, which would fit.                                  */
+func TestRecomputeShortIndexBuffer(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(5, 4))
+    var short []uint32 = make([]uint32, 4)
+
+    canvas.NxPts, canvas.NyPts = 7, 6
+    err := canvas.Recompute(canvas.MeshStorage, short)
+
+    if !errors.Is(err, ErrBufferTooSmall) {
+        t.Fatalf("got %v, want ErrBufferTooSmall", err)
+    }
+
+    if (canvas.NumberOfPoints != 20) || (canvas.MeshSize != 60) ||
+       (len(canvas.Mesh) != 60) {
+        t.Errorf("%d points and mesh %d of %d, want the 5x4 sizes",
+                 canvas.NumberOfPoints, canvas.MeshSize, len(canvas.Mesh))
+    }
+}
+/*  End of TestRecomputeShortIndexBuffer.                                     */
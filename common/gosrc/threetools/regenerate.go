@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Recomputes the mesh and wireframe of a canvas from its surface.       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for comparing errors that may be wrapped.        */
+import "errors"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Regenerate                                                            *
+ *  Purpose:                                                                  *
+ *      Recomputes the mesh from the surface stored in the canvas, and then   *
+ *      the wireframe, using the current grid and domain. This is used after  *
+ *      a setting that changes the surface, like the domain, has been         *
+ *      updated. Nothing is done if no surface has been stored. The dirty     *
+ *      flag is cleared.                                                      *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being regenerated.                                     *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The error from generating the mesh or the wireframe, if any.      *
+ ******************************************************************************/
+func (self *Canvas) Regenerate() error {
+    self.Dirty = false
+
+    if self.Surface == nil {
+        return nil
+    }
+
+    /*  A surface with a few non-finite points still has a usable mesh, draw  *
+     *  the wireframe anyway and report the error afterwards.                 */
+    err := self.GenerateMeshFromParametrization(self.Surface)
+
+    if (err != nil) && !errors.Is(err, ErrNonFinite) {
+        return err
+    }
+
+    var wireframeErr error = self.GenerateRectangularWireframe()
+
+    if wireframeErr != nil {
+        return wireframeErr
+    }
+
+    return err
+}
+/*  End of Regenerate.                                                        */
@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes a tolerance that scales with the size of the mesh.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RelativeEpsilon                                                       *
+ *  Purpose:                                                                  *
+ *      Returns the given fraction of the length of the diagonal of the       *
+ *      bounding box of the mesh, a tolerance for comparing vertices that     *
+ *      works the same for tiny and for huge figures, unlike the fixed        *
+ *      WeldEpsilon. A fraction around 1.0E-6 is suitable for welding. For an *
+ *      empty or single point mesh the diagonal is zero, and WeldEpsilon is   *
+ *      returned instead.                                                     *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being measured.                          *
+ *      fraction (float32):                                                   *
+ *          The fraction of the diagonal.                                     *
+ *  Output:                                                                   *
+ *      epsilon (float32):                                                    *
+ *          The tolerance.                                                    *
+ ******************************************************************************/
+func (self *Canvas) RelativeEpsilon(fraction float32) float32 {
+    lower, upper := self.BoundingBox()
+    var diagonal float32 = vectorNorm(vectorDifference(upper, lower))
+
+    if diagonal == 0.0 {
+        return WeldEpsilon
+    }
+
+    return fraction * diagonal
+}
+/*  End of RelativeEpsilon.                                                   */
@@ -0,0 +1,135 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Removes line segments whose endpoints coincide.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Drops the vertices of the line strips that are within epsilon of the      *
+ *  vertex kept before them in the same strip, so a strip never gets longer,  *
+ *  and returns the number of segments that remain.                           */
+func (self *Canvas) removeDegenerateStripVertices(epsilonSquared float32) int {
+
+    /*  Variables for indexing over the indices, and the number kept.         */
+    var index, kept int
+
+    for index = 0; index < self.IndexSize; index++ {
+        var vertex uint32 = self.Indices[index]
+
+        /*  The first vertex of a strip has nothing before it to compare to.  */
+        if (vertex != StripRestartIndex) && (kept > 0) &&
+           (self.Indices[kept - 1] != StripRestartIndex) {
+            var difference [3]float32 = vectorDifference(
+                self.point(int(self.Indices[kept - 1])),
+                self.point(int(vertex)),
+            )
+
+            if dotProduct(difference, difference) <= epsilonSquared {
+                continue
+            }
+        }
+
+        self.Indices[kept] = vertex
+        kept++
+    }
+
+    self.IndexSize = kept
+    self.Indices = self.Indices[0:kept]
+    self.compactStrips()
+    return self.SegmentCount()
+}
+/*  End of removeDegenerateStripVertices.                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RemoveDegenerateSegmentsWithin                                        *
+ *  Purpose:                                                                  *
+ *      Drops the line segments whose endpoints are within epsilon of each    *
+ *      other. These occur where the parametrization collapses several grid   *
+ *      points to one, like the poles of a sphere, and render as stray dots   *
+ *      while wasting buffer space. The remaining segments are moved to the   *
+ *      front of the index buffer, keeping their order, and IndexSize is      *
+ *      updated. With LineStrips a vertex of a strip within epsilon of the    *
+ *      one before it is dropped instead, joining its neighbors. Too large an *
+ *      epsilon drops short segments that are not degenerate, see             *
+ *      RelativeEpsilon for one that scales with the figure.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the index buffer being cleaned up.                *
+ *      epsilon (float32):                                                    *
+ *          Endpoints at most this far apart are the same.                    *
+ *  Output:                                                                   *
+ *      segments (int):                                                       *
+ *          The number of line segments that remain.                          *
+ ******************************************************************************/
+func (self *Canvas) RemoveDegenerateSegmentsWithin(epsilon float32) int {
+
+    /*  Variables for indexing over the segments.                             */
+    var index, kept int
+
+    /*  Compare squared distances, avoiding a square root per segment.        */
+    var epsilonSquared float32 = epsilon * epsilon
+
+    if self.LineStrips {
+        return self.removeDegenerateStripVertices(epsilonSquared)
+    }
+
+    for index = 0; index + 1 < self.IndexSize; index += 2 {
+        var start uint32 = self.Indices[index]
+        var end uint32 = self.Indices[index + 1]
+
+        var difference [3]float32 = vectorDifference(
+            self.point(int(start)), self.point(int(end)),
+        )
+
+        if dotProduct(difference, difference) <= epsilonSquared {
+            continue
+        }
+
+        self.Indices[kept] = start
+        self.Indices[kept + 1] = end
+        kept += 2
+    }
+
+    self.IndexSize = kept
+    self.Indices = self.Indices[0:kept]
+    return kept / 2
+}
+/*  End of RemoveDegenerateSegmentsWithin.                                    */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RemoveDegenerateSegments                                              *
+ *  Purpose:                                                                  *
+ *      Drops the degenerate segments as RemoveDegenerateSegmentsWithin does, *
+ *      with the fixed tolerance WeldEpsilon.                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the index buffer being cleaned up.                *
+ *  Output:                                                                   *
+ *      segments (int):                                                       *
+ *          The number of line segments that remain.                          *
+ ******************************************************************************/
+func (self *Canvas) RemoveDegenerateSegments() int {
+    return self.RemoveDegenerateSegmentsWithin(WeldEpsilon)
+}
+/*  End of RemoveDegenerateSegments.                                          */
@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for dropping the zero length segments of the wireframe.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the sphere.                  */
+import (
+    "math"
+    "testing"
+)
+
+/*  On a 9x5 sphere the first and last rows are collapsed to the poles, so    *
+ *  their 2 * 8 horizontal segments have zero length. Of the 76 segments the  *
+ *  other 60 are kept, and none of those is degenerate.                       */
+func TestRemoveDegenerateSegmentsSphere(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(9, 5), WithDomain(1.0, 1.0, 0.0, 0.0),
+    )
+
+    canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+        sinV, cosV := math.Sincos(math.Pi * float64(v))
+        return [3]float32{
+            float32(sinV * cosU), float32(sinV * sinU), float32(-cosV),
+        }
+    })
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    if canvas.IndexSize != 2 * 76 {
+        t.Fatalf("%d segments before, want 76", canvas.IndexSize / 2)
+    }
+
+    if segments := canvas.RemoveDegenerateSegments(); segments != 60 {
+        t.Errorf("%d segments remain, want 60", segments)
+    }
+
+    if canvas.IndexSize != 2 * 60 {
+        t.Errorf("IndexSize = %d, want 120", canvas.IndexSize)
+    }
+
+    for index := 0; index + 1 < canvas.IndexSize; index += 2 {
+        var a [3]float32 = canvas.point(int(canvas.Indices[index]))
+        var b [3]float32 = canvas.point(int(canvas.Indices[index + 1]))
+
+        if vectorNorm(vectorDifference(a, b)) <= WeldEpsilon {
+            t.Errorf("segment from %v to %v was kept", a, b)
+        }
+    }
+}
+/*  End of TestRemoveDegenerateSegmentsSphere.                                */
@@ -0,0 +1,119 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Grows the mesh and index storage of a canvas.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Returns the length a buffer is grown to so that it holds needed elements. *
+ *  The length is at least doubled, so repeated growth is cheap, but never    *
+ *  beyond the limit.                                                         */
+func grownLength(current int, needed, limit uint64) int {
+    var length uint64 = 2 * uint64(current)
+
+    if length < needed {
+        length = needed
+    }
+
+    if length > limit {
+        length = limit
+    }
+
+    return int(length)
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ReserveStorage                                                        *
+ *  Purpose:                                                                  *
+ *      Grows the mesh and index storage of a canvas so that they hold at     *
+ *      least the given number of elements. The storage starts out small and  *
+ *      is reallocated here, at most up to MaxBufferLength points, and the    *
+ *      contents and the active Mesh and Indices are carried over to the new  *
+ *      memory. For the main canvas the global MeshBuffer and IndexBuffer are *
+ *      updated too, so the address getters return the new memory. JavaScript *
+ *      must get the addresses again after the resolution is increased.       *
+ *      Storage that is already large enough is left alone.                   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose storage is being grown.                          *
+ *      meshSize (uint64):                                                    *
+ *          The number of floats the mesh storage must hold.                  *
+ *      indexSize (uint64):                                                   *
+ *          The number of indices the index storage must hold.                *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge if this is beyond the maximum.                    *
+ ******************************************************************************/
+func (self *Canvas) ReserveStorage(meshSize, indexSize uint64) error {
+
+    /*  The largest storage allowed, matching MaxMeshBufferSize and           *
+     *  MaxIndexBufferSize for the default maximum.                           */
+    var meshLimit uint64 = 3 * uint64(MaxBufferLength)
+    var indexLimit uint64 = 6 * uint64(MaxBufferLength)
+
+    if (meshSize > meshLimit) || (indexSize > indexLimit) {
+        return fmt.Errorf(
+            "%w: %d mesh and %d index elements, at most %d points",
+            ErrGridTooLarge, meshSize, indexSize, MaxBufferLength,
+        )
+    }
+
+    if uint64(len(self.MeshStorage)) < meshSize {
+        var length int = grownLength(len(self.MeshStorage), meshSize, meshLimit)
+        var storage []float32 = make([]float32, length)
+
+        copy(storage, self.MeshStorage)
+        self.Mesh = storage[0:len(self.Mesh)]
+        self.MeshStorage = storage
+    }
+
+    if uint64(len(self.IndexStorage)) < indexSize {
+        var length int = grownLength(
+            len(self.IndexStorage), indexSize, indexLimit,
+        )
+        var storage []uint32 = make([]uint32, length)
+
+        copy(storage, self.IndexStorage)
+        self.Indices = storage[0:len(self.Indices)]
+        self.IndexStorage = storage
+    }
+
+    /*  JavaScript finds the main canvas through the global buffers.          */
+    if self == &MainCanvas {
+        MeshBuffer = self.MeshStorage
+        IndexBuffer = self.IndexStorage
+    }
+
+    return nil
+}
+/*  End of ReserveStorage.                                                    */
+
+/*  Grows the storage of a canvas to hold a full grid of the given size. Six  *
+ *  indices per point is enough for every mesh type, see MaxIndexBufferSize.  */
+func (self *Canvas) reserveGrid(nxPts, nyPts uint32) error {
+    var numberOfPoints uint64 = uint64(nxPts) * uint64(nyPts)
+    return self.ReserveStorage(3 * numberOfPoints, 6 * numberOfPoints)
+}
@@ -0,0 +1,97 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for growing the mesh and index storage on demand.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Restores the main canvas and the global buffers once a test is done.      */
+func preserveMainCanvas(t *testing.T) {
+    var canvas Canvas = MainCanvas
+    var meshBuffer []float32 = MeshBuffer
+    var indexBuffer []uint32 = IndexBuffer
+
+    t.Cleanup(func() {
+        MainCanvas = canvas
+        MeshBuffer = meshBuffer
+        IndexBuffer = indexBuffer
+    })
+}
+/*  End of preserveMainCanvas.                                                */
+
+/*  Growing the main canvas from 32x32 to 256x256 moves its storage to a new, *
+ *  larger backing array, the address getters return the new memory, and the  *
+ *  active mesh is carried over.                                              */
+func TestReserveStorageGrowsMainCanvas(t *testing.T) {
+    preserveMainCanvas(t)
+
+    var config CanvasConfig = NewCanvasConfig(WithGrid(32, 32))
+
+    if err := MainCanvas.Configure(config); err != nil {
+        t.Fatalf("Configure: %v", err)
+    }
+
+    var err error = MainCanvas.GenerateMeshFromParametrization(paraboloid)
+
+    if err != nil {
+        t.Fatalf("GenerateMeshFromParametrization: %v", err)
+    }
+
+    var small []float32 = append([]float32(nil), MainCanvas.Mesh...)
+    var meshAddress uintptr = MeshBufferAddress()
+    var indexAddress uintptr = IndexBufferAddress()
+
+    if err := MainCanvas.reserveGrid(256, 256); err != nil {
+        t.Fatalf("reserveGrid: %v", err)
+    }
+
+    if (len(MainCanvas.MeshStorage) < 3 * 256 * 256) ||
+       (len(MainCanvas.IndexStorage) < 6 * 256 * 256) {
+        t.Fatalf("storage holds %d floats and %d indices after growing",
+                 len(MainCanvas.MeshStorage), len(MainCanvas.IndexStorage))
+    }
+
+    if (MeshBufferAddress() == meshAddress) ||
+       (MeshBufferAddress() != SliceAddress(MainCanvas.MeshStorage)) {
+        t.Error("MeshBufferAddress is not the new mesh storage")
+    }
+
+    if (IndexBufferAddress() == indexAddress) ||
+       (IndexBufferAddress() != SliceAddress(MainCanvas.IndexStorage)) {
+        t.Error("IndexBufferAddress is not the new index storage")
+    }
+
+    if len(MainCanvas.Mesh) != len(small) {
+        t.Fatalf("the active mesh has %d floats, want %d",
+                 len(MainCanvas.Mesh), len(small))
+    }
+
+    for index := range small {
+        if MainCanvas.Mesh[index] != small[index] {
+            t.Fatalf("mesh entry %d is %g after growing, want %g",
+                     index, MainCanvas.Mesh[index], small[index])
+        }
+    }
+}
+/*  End of TestReserveStorageGrowsMainCanvas.                                 */
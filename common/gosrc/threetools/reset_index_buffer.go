@@ -24,21 +24,46 @@
  ******************************************************************************/
 package threetools
 
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
 /******************************************************************************
  *  Function:                                                                 *
  *      ResetIndexBuffer                                                      *
  *  Purpose:                                                                  *
- *      Resets the size of the index buffer.                                  *
+ *      Resets the size of the index buffer. If the buffer is too small for   *
+ *      the wireframe the canvas is left unchanged and an error is returned,  *
+ *      rather than panicking when slicing.                                   *
  *  Arguments:                                                                *
  *      self (*Canvas):                                                       *
  *          The canvas that is being resized.                                 *
  *      buffer ([]uint32):                                                    *
  *          The buffer where canvas will store its data.                      *
  *  Output:                                                                   *
- *      None.                                                                 *
+ *      err (error):                                                          *
+ *          ErrBufferTooSmall if the buffer can not hold the wireframe, or    *
+ *          ErrGridTooLarge from ComputeIndexSize.                            *
  ******************************************************************************/
-func (self *Canvas) ResetIndexBuffer(buffer []uint32) {
-    self.ComputeIndexSize()
+func (self *Canvas) ResetIndexBuffer(buffer []uint32) error {
+    var previous int = self.IndexSize
+
+    if err := self.ComputeIndexSize(); err != nil {
+        self.IndexSize = previous
+        return err
+    }
+
+    /*  Avoid slicing beyond the end of the buffer.                           */
+    if self.IndexSize > len(buffer) {
+        var needed int = self.IndexSize
+        self.IndexSize = previous
+
+        return fmt.Errorf(
+            "%w: wireframe needs %d indices, buffer holds %d",
+            ErrBufferTooSmall, needed, len(buffer),
+        )
+    }
+
     self.Indices = buffer[0:self.IndexSize]
+    return nil
 }
 /*  End of ResetIndexBuffer.                                                  */
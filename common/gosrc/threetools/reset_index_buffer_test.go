@@ -0,0 +1,50 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for pointing the indices of a canvas at a new buffer.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A buffer too short for the wireframe is rejected with an error rather     *
+ *  than a panic, and the canvas keeps its index size.                        */
+func TestResetIndexBufferShortBuffer(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var previous int = canvas.IndexSize
+    var short []uint32 = make([]uint32, previous - 1)
+
+    var err error = canvas.ResetIndexBuffer(short)
+
+    if !errors.Is(err, ErrBufferTooSmall) {
+        t.Fatalf("short buffer: got %v, want ErrBufferTooSmall", err)
+    }
+
+    if canvas.IndexSize != previous {
+        t.Errorf("index size %d, want %d", canvas.IndexSize, previous)
+    }
+}
+/*  End of TestResetIndexBufferShortBuffer.                                   */
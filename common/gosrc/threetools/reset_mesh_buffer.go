@@ -24,30 +24,50 @@
  ******************************************************************************/
 package threetools
 
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
 /******************************************************************************
  *  Function:                                                                 *
  *      ResetMeshBuffer                                                       *
  *  Purpose:                                                                  *
- *      Resets the size of the mesh buffer.                                   *
+ *      Resets the size of the mesh buffer. If the buffer is too small for    *
+ *      the grid the canvas is left unchanged and an error is returned,       *
+ *      rather than panicking when slicing.                                   *
  *  Arguments:                                                                *
  *      self (*Canvas):                                                       *
  *          The canvas that is being resized.                                 *
  *      buffer ([]float32):                                                   *
  *          The buffer where canvas will store its data.                      *
  *  Output:                                                                   *
- *      None.                                                                 *
+ *      err (error):                                                          *
+ *          ErrBufferTooSmall if the buffer can not hold the grid.            *
  ******************************************************************************/
-func (self *Canvas) ResetMeshBuffer(buffer []float32) {
+func (self *Canvas) ResetMeshBuffer(buffer []float32) error {
 
     /*  The canvas is a rectangular grid, the total number of points is given *
      *  by the product of the width and the height.                           */
-    self.NumberOfPoints = int(self.NxPts * self.NyPts)
+    var numberOfPoints int = int(self.NxPts) * int(self.NyPts)
 
     /*  Each point corresponds to three floats (the x, y, and z components).  *
      *  The mesh size is hence three times the number of points.              */
-    self.MeshSize = 3 * self.NumberOfPoints
+    var meshSize int = 3 * numberOfPoints
+
+    /*  Avoid slicing beyond the end of the buffer.                           */
+    if meshSize > len(buffer) {
+        return fmt.Errorf(
+            "%w: %d x %d grid needs %d floats, buffer holds %d",
+            ErrBufferTooSmall, self.NxPts, self.NyPts, meshSize, len(buffer),
+        )
+    }
+
+    self.NumberOfPoints = numberOfPoints
+    self.MeshSize = meshSize
+    self.HasSpinCenter = false
+    self.invalidateBaseOrientation()
 
     /*  Reset the mesh buffer to use the provided slice.                      */
     self.Mesh = buffer[0:self.MeshSize]
+    return nil
 }
 /*  End of ResetMeshBuffer.                                                   */
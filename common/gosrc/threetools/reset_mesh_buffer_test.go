@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for pointing the mesh of a canvas at a new buffer.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A buffer one float short of the grid is rejected with an error rather     *
+ *  than a panic, and the canvas keeps its mesh, while a buffer of exactly    *
+ *  the right size is used.                                                   */
+func TestResetMeshBufferShortBuffer(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var mesh []float32 = canvas.Mesh
+    var short []float32 = make([]float32, canvas.MeshSize - 1)
+
+    var err error = canvas.ResetMeshBuffer(short)
+
+    if !errors.Is(err, ErrBufferTooSmall) {
+        t.Fatalf("short buffer: got %v, want ErrBufferTooSmall", err)
+    }
+
+    if (canvas.MeshSize != 60) || (&canvas.Mesh[0] != &mesh[0]) {
+        t.Errorf("the canvas was changed by the short buffer")
+    }
+
+    var exact []float32 = make([]float32, 60)
+
+    if err := canvas.ResetMeshBuffer(exact); err != nil {
+        t.Fatalf("exact buffer: %v", err)
+    }
+
+    if (len(canvas.Mesh) != 60) || (&canvas.Mesh[0] != &exact[0]) {
+        t.Errorf("the exact buffer is not the mesh")
+    }
+}
+/*  End of TestResetMeshBufferShortBuffer.                                    */
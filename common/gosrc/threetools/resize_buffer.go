@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Resizes a slice, reusing its memory when possible.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      resizeBuffer                                                          *
+ *  Purpose:                                                                  *
+ *      Returns a slice with the requested length. If the input has enough    *
+ *      capacity its memory is reused, otherwise a new slice is allocated.    *
+ *      This is used for the optional per-vertex buffers, which are only      *
+ *      allocated when they are needed.                                       *
+ *  Arguments:                                                                *
+ *      buffer ([]T):                                                         *
+ *          The slice being resized. May be nil.                              *
+ *      length (int):                                                         *
+ *          The requested number of elements.                                 *
+ *  Output:                                                                   *
+ *      resized ([]T):                                                        *
+ *          A slice with the requested length.                                *
+ ******************************************************************************/
+func resizeBuffer[T any](buffer []T, length int) []T {
+
+    /*  Reuse the existing memory if there is enough of it.                   */
+    if cap(buffer) >= length {
+        return buffer[0:length]
+    }
+
+    return make([]T, length)
+}
+/*  End of resizeBuffer.                                                      */
@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reverses the winding of the triangle faces.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ReverseWinding                                                        *
+ *  Purpose:                                                                  *
+ *      Swaps the second and third vertex of every triangle in the face       *
+ *      buffer, turning counterclockwise triangles into clockwise ones and    *
+ *      back. This flips the direction the faces point, as seen by back-face  *
+ *      culling and by ComputeFaceNormals. Face normals that were already     *
+ *      computed are not changed, recompute them afterwards.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the faces that are being reversed.                *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ReverseWinding() {
+
+    /*  Variable for indexing over the faces.                                 */
+    var index int
+
+    /*  Swapping two vertices of a triangle reverses its winding.             */
+    for index = 0; index + 2 < len(self.Faces); index += 3 {
+        self.Faces[index + 1], self.Faces[index + 2] =
+            self.Faces[index + 2], self.Faces[index + 1]
+    }
+}
+/*  End of ReverseWinding.                                                    */
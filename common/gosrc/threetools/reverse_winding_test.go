@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for reversing the winding of the faces.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Reversing the winding swaps the last two vertices of every triangle, so   *
+ *  the recomputed face normals point the other way, and reversing twice      *
+ *  gives back the original faces.                                            */
+func TestReverseWindingFlipsFaces(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    canvas.GenerateTriangleFaces()
+    canvas.ComputeFaceNormals()
+
+    var faces []uint32 = append([]uint32(nil), canvas.Faces...)
+    var normals []float32 = append([]float32(nil), canvas.FaceNormals...)
+
+    canvas.ReverseWinding()
+
+    for index := 0; index + 2 < len(faces); index += 3 {
+        var want [3]uint32 = [3]uint32{
+            faces[index], faces[index + 2], faces[index + 1],
+        }
+        var got [3]uint32 = [3]uint32{
+            canvas.Faces[index], canvas.Faces[index + 1],
+            canvas.Faces[index + 2],
+        }
+
+        if got != want {
+            t.Errorf("triangle %d is %v, want %v", index / 3, got, want)
+        }
+    }
+
+    canvas.ComputeFaceNormals()
+
+    for index := range normals {
+        if !closeTo(canvas.FaceNormals[index], -normals[index], 1.0E-6) {
+            t.Fatalf("face normal entry %d is %g, want %g", index,
+                     canvas.FaceNormals[index], -normals[index])
+        }
+    }
+
+    canvas.ReverseWinding()
+
+    for index := range faces {
+        if canvas.Faces[index] != faces[index] {
+            t.Fatalf("after reversing twice face index %d is %d, want %d",
+                     index, canvas.Faces[index], faces[index])
+        }
+    }
+}
+/*  End of TestReverseWindingFlipsFaces.                                      */
@@ -0,0 +1,47 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates vectors in a buffer within one of the coordinate planes.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Rotates the first count vectors of a buffer, three floats per vector, in  *
+ *  the plane spanned by the first and second coordinate axes. The rotation   *
+ *  takes the first axis towards the second by the angle of the unit vector.  */
+func rotateCoordinatePlane(buffer []float32, count, first, second int,
+                           point UnitVector) {
+
+    /*  Variable for indexing over the vectors in the buffer.                 */
+    var index int
+
+    for index = 0; index < count; index++ {
+        var firstIndex int = 3 * index + first
+        var secondIndex int = 3 * index + second
+
+        var a float32 = buffer[firstIndex]
+        var b float32 = buffer[secondIndex]
+
+        buffer[firstIndex] = point.AngleCos * a - point.AngleSin * b
+        buffer[secondIndex] = point.AngleCos * b + point.AngleSin * a
+    }
+}
+/*  End of rotateCoordinatePlane.                                             */
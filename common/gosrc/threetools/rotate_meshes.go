@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates the meshes of several canvases together.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RotateMeshes                                                          *
+ *  Purpose:                                                                  *
+ *      Rotates the meshes of several canvases by the same unit vector in one *
+ *      call, as RotateMesh does for one canvas, for figures that spin in     *
+ *      sync, like a grid of thumbnails. Nil canvases are skipped, and a      *
+ *      canvas listed more than once is only rotated once.                    *
+ *  Arguments:                                                                *
+ *      canvases ([]*Canvas):                                                 *
+ *          The canvases with the meshes being rotated.                       *
+ *      point (UnitVector):                                                   *
+ *          A point on the unit circle, the angle used.                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func RotateMeshes(canvases []*Canvas, point UnitVector) {
+
+    /*  The canvases already rotated, so none is rotated twice.               */
+    var rotated map[*Canvas]bool = make(map[*Canvas]bool, len(canvases))
+
+    for _, canvas := range canvases {
+        if (canvas == nil) || rotated[canvas] {
+            continue
+        }
+
+        rotated[canvas] = true
+        canvas.RotateMesh(point)
+    }
+}
+/*  End of RotateMeshes.                                                      */
@@ -0,0 +1,50 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for rotating several meshes in one call.                        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Two canvases rotated together are updated identically, exactly as         *
+ *  RotateMesh rotates one, and a canvas listed twice or a nil canvas does    *
+ *  not change this.                                                          */
+func TestRotateMeshesMatchesRotateMesh(t *testing.T) {
+    var point UnitVector = UnitVectorFromAngle(0.7)
+    var reference *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var first *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var second *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+
+    reference.RotateMesh(point)
+    RotateMeshes([]*Canvas{first, nil, second, first}, point)
+
+    for index := 0; index < reference.NumberOfPoints; index++ {
+        var want [3]float32 = reference.point(index)
+
+        if (first.point(index) != want) || (second.point(index) != want) {
+            t.Fatalf("vertex %d is %v and %v, want %v", index,
+                     first.point(index), second.point(index), want)
+        }
+    }
+}
+/*  End of TestRotateMeshesMatchesRotateMesh.                                 */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates the normals of a mesh by a fixed angle.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RotateNormals                                                         *
+ *  Purpose:                                                                  *
+ *      Rotates the normal vectors of a canvas about the z axis by the        *
+ *      provided unit vector. This is the same rotation RotateMesh applies to *
+ *      the vertices, so the normals stay correct as the mesh spins. Normals  *
+ *      are not translated, only rotated. If the normals have not been        *
+ *      computed nothing is done.                                             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the normals that are being rotated.               *
+ *      point (UnitVector):                                                   *
+ *          A point on the unit circle, its polar angle is used for rotating. *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) RotateNormals(point UnitVector) {
+
+    /*  Variable for indexing over the normals.                               */
+    var index int
+
+    /*  Avoid reading beyond the buffer if the normals were never computed.   */
+    if len(self.Normals) < self.MeshSize {
+        return
+    }
+
+    /*  Loop through the normal for each point in the mesh.                   */
+    for index = 0; index < self.NumberOfPoints; index++ {
+
+        /*  Same layout as the mesh, the x component is at 3 * index.         */
+        var xIndex int = 3 * index
+        var yIndex int = xIndex + 1
+
+        /*  Apply the rotation matrix and update the normal.                  */
+        var x float32 = self.Normals[xIndex]
+        var y float32 = self.Normals[yIndex]
+
+        self.Normals[xIndex] = point.AngleCos * x - point.AngleSin * y
+        self.Normals[yIndex] = point.AngleCos * y + point.AngleSin * x
+    }
+}
+/*  End of RotateNormals.                                                     */
@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for rotating the normals along with the mesh.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Rotating the normals with the mesh gives the same normals as computing    *
+ *  them again from the rotated mesh.                                         */
+func TestRotateNormalsMatchesRecomputation(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(7, 5))
+    var point UnitVector = UnitVectorFromAngle(0.7)
+
+    canvas.ComputeNormals()
+    canvas.RotateMesh(point)
+    canvas.RotateNormals(point)
+
+    var rotated []float32 = append([]float32(nil), canvas.Normals...)
+    canvas.ComputeNormals()
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var start int = 3 * index
+        var got [3]float32 = [3]float32{
+            rotated[start], rotated[start + 1], rotated[start + 2],
+        }
+        var want [3]float32 = [3]float32{
+            canvas.Normals[start],
+            canvas.Normals[start + 1],
+            canvas.Normals[start + 2],
+        }
+
+        expectVector(t, "rotated normal", got, want, 1.0E-5)
+    }
+}
+/*  End of TestRotateNormalsMatchesRecomputation.                             */
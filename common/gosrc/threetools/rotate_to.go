@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Eases the rotation of a mesh towards a target angle.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RotateTo                                                              *
+ *  Purpose:                                                                  *
+ *      Moves the rotation about the z axis from its value at the start of    *
+ *      the transition towards the target angle, following the easing curve.  *
+ *      The angle is start + (target - start) ease(t), with t running from 0  *
+ *      to 1 over the transition. The start angle is recorded by the first    *
+ *      call of a transition, and the transition ends once t reaches 1. The   *
+ *      rotation is applied to the base mesh, see CaptureBaseOrientation.     *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being rotated.                           *
+ *      targetAngle (float32):                                                *
+ *          The angle at the end of the transition, in radians.               *
+ *      t (float32):                                                          *
+ *          The progress of the transition, from 0 to 1.                      *
+ *      ease (Easing):                                                        *
+ *          The easing curve, such as EaseInOutCubic.                         *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func RotateTo(canvas *Canvas, targetAngle, t float32, ease Easing) {
+
+    /*  Values of t outside of [0, 1] are clamped to the ends.                */
+    if t < 0.0 {
+        t = 0.0
+    } else if t > 1.0 {
+        t = 1.0
+    }
+
+    canvas.ensureBaseOrientation()
+
+    /*  The first call of a transition records where it starts from.          */
+    if !canvas.Transitioning {
+        canvas.TransitionStart = canvas.Roll
+        canvas.Transitioning = true
+    }
+
+    var start float32 = canvas.TransitionStart
+    canvas.Roll = start + (targetAngle - start) * ease(t)
+    canvas.applyBaseOrientation()
+
+    if t >= 1.0 {
+        canvas.Transitioning = false
+    }
+}
+/*  End of RotateTo.                                                          */
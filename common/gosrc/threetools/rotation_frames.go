@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the frames of a full turn of the mesh for export.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library packages for Pi and formatting the error messages.       */
+import (
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RotationFrames                                                        *
+ *  Purpose:                                                                  *
+ *      Computes the projected wireframe for each frame of a full turn of the *
+ *      mesh about the z axis, for making looping animations outside of the   *
+ *      browser. Frame k is the mesh turned by 2 pi k / steps with RotateMesh *
+ *      and projected with ProjectOrthographic, so the turn after the last    *
+ *      frame is the first frame again and the loop is seamless. The canvas   *
+ *      is not changed, the mesh is turned in a copy. Drawing the frames,     *
+ *      with the segments of the index buffer, is left to the caller.         *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being turned.                            *
+ *      steps (int):                                                          *
+ *          The number of frames in the turn.                                 *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, see ProjectOrthographic.                   *
+ *  Output:                                                                   *
+ *      frames ([][]float32):                                                 *
+ *          The screen coordinates for each frame, two floats per vertex.     *
+ *      err (error):                                                          *
+ *          ErrInvalidCount if steps is not positive.                         *
+ ******************************************************************************/
+func RotationFrames(canvas *Canvas,
+                    steps int, direction [3]float32) ([][]float32, error) {
+
+    /*  Variable for indexing over the frames.                                */
+    var frame int
+
+    if steps <= 0 {
+        return nil, fmt.Errorf("%w: %d frames", ErrInvalidCount, steps)
+    }
+
+    /*  The mesh is turned in a copy, only the vertices are needed.           */
+    var turning Canvas = Canvas{
+        Mesh: cloneBuffer(canvas.Mesh),
+        NumberOfPoints: canvas.NumberOfPoints,
+        MeshSize: canvas.MeshSize,
+    }
+
+    var step UnitVector = UnitVectorFromAngle(
+        2.0 * math.Pi / float32(steps),
+    )
+
+    var frames [][]float32 = make([][]float32, steps)
+
+    for frame = 0; frame < steps; frame++ {
+        frames[frame] = ProjectOrthographic(&turning, direction)
+        turning.RotateMesh(step)
+    }
+
+    return frames, nil
+}
+/*  End of RotationFrames.                                                    */
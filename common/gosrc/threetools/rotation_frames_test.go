@@ -0,0 +1,100 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the projected frames of a full turn of a mesh.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi is provided by math, errors.Is is used since the error is wrapped.     */
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+/*  Checks that two projected frames agree to within the tolerance.           */
+func expectFrame(t *testing.T, name string, got, want []float32) {
+    t.Helper()
+
+    if len(got) != len(want) {
+        t.Fatalf("%s has %d coordinates, want %d", name, len(got), len(want))
+    }
+
+    for index := range want {
+        if !closeTo(got[index], want[index], 1.0E-4) {
+            t.Fatalf("%s coordinate %d is %g, want %g",
+                     name, index, got[index], want[index])
+        }
+    }
+}
+/*  End of expectFrame.                                                       */
+
+/*  There is one frame per step, the first frame is the canvas as it is, and  *
+ *  the last frame is one step short of a full turn, so turning the canvas    *
+ *  back by one step gives the last frame. The canvas is left unchanged.      */
+func TestRotationFrames(t *testing.T) {
+    const steps int = 12
+    var direction [3]float32 = [3]float32{1.0, -2.0, -3.0}
+    var canvas *Canvas = newGraphCanvas(
+        t, saddle, WithGrid(5, 4), WithDomain(2.0, 2.0, 0.5, -1.0),
+    )
+
+    var original []float32 = append([]float32(nil), canvas.Mesh...)
+    var back UnitVector = UnitVectorFromAngle(-2.0 * math.Pi / float32(steps))
+
+    frames, err := RotationFrames(canvas, steps, direction)
+
+    if err != nil {
+        t.Fatalf("RotationFrames: %v", err)
+    }
+
+    if len(frames) != steps {
+        t.Fatalf("got %d frames, want %d", len(frames), steps)
+    }
+
+    expectFrame(t, "first frame", frames[0],
+                ProjectOrthographic(canvas, direction))
+
+    for index := range original {
+        if canvas.Mesh[index] != original[index] {
+            t.Fatalf("mesh[%d] changed from %g to %g",
+                     index, original[index], canvas.Mesh[index])
+        }
+    }
+
+    canvas.RotateMesh(back)
+    expectFrame(t, "last frame", frames[steps - 1],
+                ProjectOrthographic(canvas, direction))
+}
+/*  End of TestRotationFrames.                                                */
+
+/*  A turn with no frames is rejected.                                        */
+func TestRotationFramesInvalidSteps(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(3, 3))
+    _, err := RotationFrames(canvas, 0, [3]float32{0.0, 0.0, -1.0})
+
+    if !errors.Is(err, ErrInvalidCount) {
+        t.Errorf("RotationFrames with no steps: %v, want ErrInvalidCount",
+                 err)
+    }
+}
+/*  End of TestRotationFramesInvalidSteps.                                    */
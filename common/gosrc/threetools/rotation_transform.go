@@ -0,0 +1,114 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotations about an axis that may be applied to any buffer.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      NewRotationTransform                                                  *
+ *  Purpose:                                                                  *
+ *      Creates a rotation about one of the coordinate axes. The cosine and   *
+ *      sine are stored, so the same rotation may be applied every frame, and *
+ *      to the mesh, the normals, and any overlay, without computing them     *
+ *      again. The rotations follow RotateMesh, RotateMeshX, and RotateMeshY, *
+ *      the x axis is turned towards the y axis for ZAxis, y towards z for    *
+ *      XAxis, and z towards x for YAxis.                                     *
+ *  Arguments:                                                                *
+ *      axis (uint):                                                          *
+ *          The axis of rotation, XAxis, YAxis, or ZAxis.                     *
+ *      point (UnitVector):                                                   *
+ *          A point on the unit circle, its polar angle is the angle of       *
+ *          rotation.                                                         *
+ *  Output:                                                                   *
+ *      transform (RotationTransform):                                        *
+ *          The rotation.                                                     *
+ *      err (error):                                                          *
+ *          ErrInvalidDirection if the axis is not one of the three.          *
+ ******************************************************************************/
+func NewRotationTransform(axis uint,
+                          point UnitVector) (RotationTransform, error) {
+    if axis > ZAxis {
+        return RotationTransform{}, ErrInvalidDirection
+    }
+
+    return RotationTransform{Axis: axis, Rotation: point}, nil
+}
+/*  End of NewRotationTransform.                                              */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Apply                                                                 *
+ *  Purpose:                                                                  *
+ *      Rotates a buffer of vectors, three floats per vector, in place. Any   *
+ *      buffer with this layout may be used, the mesh, the normals, or the    *
+ *      reference grid. Trailing floats that do not make a full vector are    *
+ *      left alone, as is the buffer if the axis is not valid.                *
+ *  Arguments:                                                                *
+ *      self (RotationTransform):                                             *
+ *          The rotation being applied.                                       *
+ *      buffer ([]float32):                                                   *
+ *          The vectors being rotated.                                        *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self RotationTransform) Apply(buffer []float32) {
+    var count int = len(buffer) / 3
+
+    switch self.Axis {
+        case XAxis:
+            rotateCoordinatePlane(buffer, count, 1, 2, self.Rotation)
+        case YAxis:
+            rotateCoordinatePlane(buffer, count, 2, 0, self.Rotation)
+        case ZAxis:
+            rotateCoordinatePlane(buffer, count, 0, 1, self.Rotation)
+    }
+}
+/*  End of Apply.                                                             */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ApplyRotation                                                         *
+ *  Purpose:                                                                  *
+ *      Applies one rotation to the mesh of a canvas and to the buffers drawn *
+ *      with it, the normals and the reference grid, if they exist, so that   *
+ *      they all stay in step.                                                *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being rotated.                                         *
+ *      transform (RotationTransform):                                        *
+ *          The rotation being applied.                                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) ApplyRotation(transform RotationTransform) {
+    transform.Apply(self.Mesh[0:self.MeshSize])
+
+    /*  Avoid reading beyond the buffer if the normals were never computed.   */
+    if len(self.Normals) >= self.MeshSize {
+        transform.Apply(self.Normals[0:self.MeshSize])
+    }
+
+    transform.Apply(self.ReferenceMesh)
+}
+/*  End of ApplyRotation.                                                     */
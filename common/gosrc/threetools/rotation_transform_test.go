@@ -0,0 +1,93 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for applying one rotation to several buffers.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  For each axis one transform is applied to the mesh and the normals. The   *
+ *  mesh matches the rotation from RotateMesh, RotateMeshX, or RotateMeshY,   *
+ *  and the normals match those computed again from the rotated mesh, so both *
+ *  buffers were turned the same way.                                         */
+func TestApplyRotationTurnsMeshAndNormals(t *testing.T) {
+    var point UnitVector = UnitVectorFromAngle(0.7)
+
+    var cases = []struct {
+        axis uint
+        rotate func(*Canvas, UnitVector)
+    }{
+        {XAxis, (*Canvas).RotateMeshX},
+        {YAxis, (*Canvas).RotateMeshY},
+        {ZAxis, (*Canvas).RotateMesh},
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(6, 5))
+        var reference *Canvas = canvas.Clone()
+
+        transform, err := NewRotationTransform(c.axis, point)
+
+        if err != nil {
+            t.Fatalf("NewRotationTransform(%d): %v", c.axis, err)
+        }
+
+        canvas.ComputeNormals()
+        canvas.ApplyRotation(transform)
+        c.rotate(reference, point)
+
+        var rotated []float32 = append([]float32(nil), canvas.Normals...)
+        canvas.ComputeNormals()
+
+        for index := 0; index < canvas.NumberOfPoints; index++ {
+            var start int = 3 * index
+            var got [3]float32 = [3]float32{
+                rotated[start], rotated[start + 1], rotated[start + 2],
+            }
+            var want [3]float32 = [3]float32{
+                canvas.Normals[start],
+                canvas.Normals[start + 1],
+                canvas.Normals[start + 2],
+            }
+
+            expectVector(t, "rotated vertex", canvas.point(index),
+                         reference.point(index), 1.0E-6)
+            expectVector(t, "rotated normal", got, want, 1.0E-5)
+        }
+    }
+}
+/*  End of TestApplyRotationTurnsMeshAndNormals.                              */
+
+/*  An axis other than the three coordinate axes is rejected.                 */
+func TestNewRotationTransformInvalidAxis(t *testing.T) {
+    _, err := NewRotationTransform(ZAxis + 1, UnitVectorFromAngle(0.7))
+
+    if !errors.Is(err, ErrInvalidDirection) {
+        t.Errorf("NewRotationTransform: %v, want ErrInvalidDirection", err)
+    }
+}
+/*  End of TestNewRotationTransformInvalidAxis.                               */
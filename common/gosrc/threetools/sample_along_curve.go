@@ -0,0 +1,75 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Evaluates a surface along a curve in the plane.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SampleAlongCurve                                                      *
+ *  Purpose:                                                                  *
+ *      Evaluates the height of a surface z = f(x, y) along a curve in the xy *
+ *      plane, giving the profile of the surface restricted to the path. The  *
+ *      curve is sampled at evenly spaced parameters from t = 0 to t = 1,     *
+ *      both ends included. This does not use a grid, the surface is          *
+ *      evaluated directly, so the profile may be finer than the mesh.        *
+ *      Sampling z = x^2 + y^2 along the unit circle gives a constant profile *
+ *      of 1.                                                                 *
+ *  Arguments:                                                                *
+ *      f (SurfaceParametrization):                                           *
+ *          The surface being sampled.                                        *
+ *      curve (PlaneCurve):                                                   *
+ *          The path, with parameter t in [0, 1].                             *
+ *      samples (int):                                                        *
+ *          The number of points along the curve.                             *
+ *  Output:                                                                   *
+ *      profile ([]float32):                                                  *
+ *          The heights at the samples, nil if samples is not positive.       *
+ ******************************************************************************/
+func SampleAlongCurve(f SurfaceParametrization,
+                      curve PlaneCurve, samples int) []float32 {
+
+    /*  Variable for indexing over the samples.                               */
+    var index int
+
+    /*  The spacing of the parameter, a single sample is taken at t = 0.      */
+    var dt float32 = 0.0
+
+    if samples < 1 {
+        return nil
+    }
+
+    if samples > 1 {
+        dt = 1.0 / float32(samples - 1)
+    }
+
+    var profile []float32 = make([]float32, samples)
+
+    for index = 0; index < samples; index++ {
+        x, y := curve(float32(index) * dt)
+        profile[index] = f(x, y)
+    }
+
+    return profile
+}
+/*  End of SampleAlongCurve.                                                  */
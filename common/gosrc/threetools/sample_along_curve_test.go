@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for sampling a surface along a plane curve.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the unit circle.             */
+import (
+    "math"
+    "testing"
+)
+
+/*  The unit circle, once around as t goes from 0 to 1.                       */
+func unitCircle(t float32) (float32, float32) {
+    sinT, cosT := math.Sincos(2.0 * math.Pi * float64(t))
+    return float32(cosT), float32(sinT)
+}
+/*  End of unitCircle.                                                        */
+
+/*  The paraboloid is 1 everywhere on the unit circle, so the profile is the  *
+ *  constant 1 with one entry per sample.                                     */
+func TestSampleAlongCurveUnitCircle(t *testing.T) {
+    const samples int = 33
+    var profile []float32 = SampleAlongCurve(paraboloid, unitCircle, samples)
+
+    if len(profile) != samples {
+        t.Fatalf("got %d samples, want %d", len(profile), samples)
+    }
+
+    for index, z := range profile {
+        if !closeTo(z, 1.0, 1.0E-6) {
+            t.Errorf("sample %d is %g, want 1", index, z)
+        }
+    }
+}
+/*  End of TestSampleAlongCurveUnitCircle.                                    */
+
+/*  Without samples there is no profile, and a single sample is taken at the  *
+ *  start of the curve.                                                       */
+func TestSampleAlongCurveFewSamples(t *testing.T) {
+    if profile := SampleAlongCurve(saddle, unitCircle, 0); profile != nil {
+        t.Errorf("no samples gave %v, want nil", profile)
+    }
+
+    var profile []float32 = SampleAlongCurve(saddle, unitCircle, 1)
+
+    if (len(profile) != 1) || !closeTo(profile[0], 1.0, 1.0E-6) {
+        t.Errorf("one sample gave %v, want [1] at (1, 0)", profile)
+    }
+}
+/*  End of TestSampleAlongCurveFewSamples.                                    */
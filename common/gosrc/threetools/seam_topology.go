@@ -0,0 +1,88 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Determines which edges of the grid are glued together.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      seamTopology                                                          *
+ *  Purpose:                                                                  *
+ *      Returns how the edges of the grid are identified for the mesh type of *
+ *      a canvas. If wrapX is set the right edge (the last column) is glued   *
+ *      to the left edge (the first column), and if twistX is also set the    *
+ *      gluing reverses the direction, as in a Mobius strip, so that row y on *
+ *      the right meets row NyPts - 1 - y on the left. wrapY and twistY are   *
+ *      the same for the top and bottom edges.                                *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose topology is being determined.                    *
+ *  Output:                                                                   *
+ *      wrapX (bool):                                                         *
+ *          True if the left and right edges are glued together.              *
+ *      twistX (bool):                                                        *
+ *          True if the left and right edges are glued with a twist.          *
+ *      wrapY (bool):                                                         *
+ *          True if the top and bottom edges are glued together.              *
+ *      twistY (bool):                                                        *
+ *          True if the top and bottom edges are glued with a twist.          *
+ ******************************************************************************/
+func (self *Canvas) seamTopology() (wrapX, twistX, wrapY, twistY bool) {
+    switch self.MeshType {
+
+        /*  A cylinder joins the left and right edges without a twist.        */
+        case CylindricalSquareWireframe:
+            fallthrough
+        case CylindricalTriangleWireframe:
+            return true, false, false, false
+
+        /*  A Mobius strip joins the left and right edges with a twist.       */
+        case MobiusSquareWireframe:
+            fallthrough
+        case MobiusTriangleWireframe:
+            return true, true, false, false
+
+        /*  A torus joins both pairs of edges without any twist.              */
+        case TorodialSquareWireframe:
+            fallthrough
+        case TorodialTriangleWireframe:
+            return true, false, true, false
+
+        /*  A Klein bottle is a cylinder whose ends are joined with a twist.  */
+        case KleinSquareWireframe:
+            fallthrough
+        case KleinTriangleWireframe:
+            return true, false, true, true
+
+        /*  The projective plane joins both pairs of edges with a twist.      */
+        case ProjectiveSquareWireframe:
+            fallthrough
+        case ProjectiveTriangleWireframe:
+            return true, true, true, true
+
+        /*  Plain square and triangle meshes have no seams.                   */
+        default:
+            return false, false, false, false
+    }
+}
+/*  End of seamTopology.                                                      */
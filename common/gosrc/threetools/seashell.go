@@ -0,0 +1,101 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the parametrization of a logarithmic spiral seashell.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp, Pi, and Sincos are provided here.                                    */
+import "math"
+
+/*  The shell used by the seashell preset, about three whorls.                */
+var DefaultSeashell SeashellParams = SeashellParams{
+    Turns: 3.0,
+    Growth: 0.08,
+    TubeGrowth: 0.1,
+    TubeRadius: 0.4,
+    Rise: 1.5,
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Seashell                                                              *
+ *  Purpose:                                                                  *
+ *      Returns the parametrization of a shell, a tube winding about the z    *
+ *      axis along a logarithmic spiral. With c = exp(Growth u) and t =       *
+ *      TubeRadius exp(TubeGrowth u) this is ((c + t cos(v)) cos(u), (c + t   *
+ *      cos(v)) sin(u), Rise (1 - c) + t sin(v)). The center of the tube is c *
+ *      from the axis, so the spiral opens by a factor exp(2 pi Growth) per   *
+ *      turn, and it sinks as it grows, leaving the apex at the origin. A     *
+ *      TubeGrowth larger than Growth gives a tube that swells faster than it *
+ *      spirals out, so the whorls overlap like those of a snail.             *
+ *  Arguments:                                                                *
+ *      params (SeashellParams):                                              *
+ *          The shape of the shell.                                           *
+ *  Output:                                                                   *
+ *      f (ParametricSurface):                                                *
+ *          The parametrization, u the angle about the axis and v the angle   *
+ *          about the tube.                                                   *
+ ******************************************************************************/
+func Seashell(params SeashellParams) ParametricSurface {
+    return func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(float64(u))
+        sinV, cosV := math.Sincos(float64(v))
+        var center float64 = math.Exp(float64(params.Growth * u))
+        var tube float64 = float64(params.TubeRadius) *
+                           math.Exp(float64(params.TubeGrowth * u))
+        var radius float64 = center + tube * cosV
+
+        return [3]float32{
+            float32(radius * cosU),
+            float32(radius * sinU),
+            float32(float64(params.Rise) * (1.0 - center) + tube * sinV),
+        }
+    }
+}
+/*  End of Seashell.                                                          */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateSeashell                                                      *
+ *  Purpose:                                                                  *
+ *      Computes the mesh of a shell with u over [0, 2 pi Turns] and v over   *
+ *      the full circle [0, 2 pi]. The first and last rows of the grid        *
+ *      coincide, closing the tube, as with GenerateCatenoid. The domain of   *
+ *      the canvas is set to match, the number of points must already be set. *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid size already set.     *
+ *      params (SeashellParams):                                              *
+ *          The shape of the shell, see Seashell.                             *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func GenerateSeashell(canvas *Canvas, params SeashellParams) {
+    canvas.HorizontalStart = 0.0
+    canvas.Width = 2.0 * math.Pi * params.Turns
+    canvas.VerticalStart = 0.0
+    canvas.Height = 2.0 * math.Pi
+    canvas.Surface = nil
+    canvas.GenerateMeshFromParametric(Seashell(params))
+}
+/*  End of GenerateSeashell.                                                  */
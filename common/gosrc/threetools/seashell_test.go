@@ -0,0 +1,82 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the logarithmic spiral seashell.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp, Pi, and Sincos are provided by math, used for the closed form.       */
+import (
+    "math"
+    "testing"
+)
+
+/*  Every vertex of the seashell mesh matches the closed form at its (u, v),  *
+ *  and the distance from the axis to the center of the tube grows with u.    */
+func TestGenerateSeashell(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(13, 9))
+    var params SeashellParams = DefaultSeashell
+
+    /*  The step sizes of the grid, the first and last rows coincide.         */
+    var du float64 = 2.0 * math.Pi * float64(params.Turns) / 12.0
+    var dv float64 = 2.0 * math.Pi / 8.0
+
+    /*  The row with v = pi / 2, where the vertex is c from the axis.         */
+    const sideRow uint32 = 2
+    var previous float32 = 0.0
+
+    GenerateSeashell(canvas, params)
+
+    for vIndex := uint32(0); vIndex < canvas.NyPts; vIndex++ {
+        for uIndex := uint32(0); uIndex < canvas.NxPts; uIndex++ {
+            var u float64 = float64(uIndex) * du
+            sinU, cosU := math.Sincos(u)
+            sinV, cosV := math.Sincos(float64(vIndex) * dv)
+            var c float64 = math.Exp(float64(params.Growth) * u)
+            var tube float64 = float64(params.TubeRadius) *
+                               math.Exp(float64(params.TubeGrowth) * u)
+
+            var want [3]float32 = [3]float32{
+                float32((c + tube * cosV) * cosU),
+                float32((c + tube * cosV) * sinU),
+                float32(float64(params.Rise) * (1.0 - c) + tube * sinV),
+            }
+
+            var index int = int(canvas.gridIndex(uIndex, vIndex))
+            expectVector(t, "vertex", canvas.point(index), want, 1.0E-4)
+        }
+    }
+
+    for uIndex := uint32(0); uIndex < canvas.NxPts; uIndex++ {
+        var index int = int(canvas.gridIndex(uIndex, sideRow))
+        var p [3]float32 = canvas.point(index)
+        var radius float32 = float32(math.Hypot(float64(p[0]), float64(p[1])))
+
+        if radius <= previous {
+            t.Fatalf("radius %g at column %d does not exceed %g",
+                     radius, uIndex, previous)
+        }
+
+        previous = radius
+    }
+}
+/*  End of TestGenerateSeashell.                                              */
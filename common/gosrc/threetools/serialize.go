@@ -0,0 +1,112 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Writes the geometry and buffers of a canvas to a binary blob.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "encoding/binary"
+    "math"
+)
+
+/*  Size of the header written by Serialize: the version byte, followed by    *
+ *  twelve four-byte fields for the grid, domain, wireframe, flags, and the   *
+ *  sizes.                                                                    */
+const serializedHeaderSize int = 1 + 4 * 12
+
+/*  Bit of the flags field set when the indices are line strips.              */
+const serializedLineStrips uint32 = 1
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Serialize                                                             *
+ *  Purpose:                                                                  *
+ *      Encodes the state of a canvas as little-endian binary data, for       *
+ *      saving and sharing a figure. The data starts with the version byte    *
+ *      SerializationVersion, followed by the number of points along each     *
+ *      axis, the width, height, and starting points of the domain, the mesh  *
+ *      type, the wireframe stride and direction, the flags, and the mesh and *
+ *      index sizes. The flags record whether the indices are line strips.    *
+ *      The active parts of the mesh and index buffers come last. The         *
+ *      auxiliary buffers are not stored, they may be recomputed from the     *
+ *      mesh.                                                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being serialized.                                      *
+ *  Output:                                                                   *
+ *      data ([]byte):                                                        *
+ *          The encoded canvas, see DeserializeCanvas.                        *
+ ******************************************************************************/
+func (self *Canvas) Serialize() []byte {
+
+    /*  Variables for indexing over the buffers.                              */
+    var index, offset int
+
+    /*  The flags describing the format of the indices.                       */
+    var flags uint32 = 0
+
+    var size int = serializedHeaderSize + 4 * (self.MeshSize + self.IndexSize)
+    var data []byte = make([]byte, size)
+
+    if self.LineStrips {
+        flags |= serializedLineStrips
+    }
+
+    /*  The header, all of the values are written as 32-bit words.            */
+    var header []uint32 = []uint32{
+        self.NxPts,
+        self.NyPts,
+        math.Float32bits(self.Width),
+        math.Float32bits(self.Height),
+        math.Float32bits(self.HorizontalStart),
+        math.Float32bits(self.VerticalStart),
+        uint32(self.MeshType),
+        self.WireframeStride,
+        uint32(self.WireframeDirection),
+        flags,
+        uint32(self.MeshSize),
+        uint32(self.IndexSize),
+    }
+
+    data[0] = SerializationVersion
+    offset = 1
+
+    for index = 0; index < len(header); index++ {
+        binary.LittleEndian.PutUint32(data[offset:], header[index])
+        offset += 4
+    }
+
+    for index = 0; index < self.MeshSize; index++ {
+        var bits uint32 = math.Float32bits(self.Mesh[index])
+        binary.LittleEndian.PutUint32(data[offset:], bits)
+        offset += 4
+    }
+
+    for index = 0; index < self.IndexSize; index++ {
+        binary.LittleEndian.PutUint32(data[offset:], self.Indices[index])
+        offset += 4
+    }
+
+    return data
+}
+/*  End of Serialize.                                                         */
@@ -0,0 +1,123 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for saving and restoring a canvas as binary data.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Serializing the paraboloid and restoring it gives back every stored       *
+ *  field, the whole mesh, and the whole wireframe, bit for bit.              */
+func TestSerializeRoundTrip(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(7, 5), WithDomain(3.0, 2.0, -1.5, -0.5),
+        WithMeshType(TriangleWireframe), WithWireframeStride(2),
+        WithWireframeDirection(HorizontalDirection),
+    )
+
+    restored, err := DeserializeCanvas(canvas.Serialize())
+
+    if err != nil {
+        t.Fatalf("DeserializeCanvas: %v", err)
+    }
+
+    var cases = []struct {
+        name string
+        got, want interface{}
+    }{
+        {"NxPts", restored.NxPts, canvas.NxPts},
+        {"NyPts", restored.NyPts, canvas.NyPts},
+        {"Width", restored.Width, canvas.Width},
+        {"Height", restored.Height, canvas.Height},
+        {"HorizontalStart", restored.HorizontalStart, canvas.HorizontalStart},
+        {"VerticalStart", restored.VerticalStart, canvas.VerticalStart},
+        {"MeshType", restored.MeshType, canvas.MeshType},
+        {"WireframeStride", restored.WireframeStride, canvas.WireframeStride},
+        {
+            "WireframeDirection",
+            restored.WireframeDirection, canvas.WireframeDirection,
+        },
+        {"NumberOfPoints", restored.NumberOfPoints, canvas.NumberOfPoints},
+        {"MeshSize", restored.MeshSize, canvas.MeshSize},
+        {"IndexSize", restored.IndexSize, canvas.IndexSize},
+    }
+
+    for _, c := range cases {
+        if c.got != c.want {
+            t.Errorf("%s is %v, want %v", c.name, c.got, c.want)
+        }
+    }
+
+    if (len(restored.Mesh) != canvas.MeshSize) ||
+       (len(restored.Indices) != canvas.IndexSize) {
+        t.Fatalf("restored %d floats and %d indices, want %d and %d",
+                 len(restored.Mesh), len(restored.Indices),
+                 canvas.MeshSize, canvas.IndexSize)
+    }
+
+    for index := 0; index < canvas.MeshSize; index++ {
+        if restored.Mesh[index] != canvas.Mesh[index] {
+            t.Fatalf("mesh entry %d is %g, want %g", index,
+                     restored.Mesh[index], canvas.Mesh[index])
+        }
+    }
+
+    for index := 0; index < canvas.IndexSize; index++ {
+        if restored.Indices[index] != canvas.Indices[index] {
+            t.Fatalf("index %d is %d, want %d", index,
+                     restored.Indices[index], canvas.Indices[index])
+        }
+    }
+
+    /*  The restored canvas has its own storage.                              */
+    if &restored.Mesh[0] == &canvas.Mesh[0] {
+        t.Error("the restored canvas shares the mesh of the original")
+    }
+}
+/*  End of TestSerializeRoundTrip.                                            */
+
+/*  Data with an unknown version or a missing byte is rejected.               */
+func TestDeserializeInvalidData(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var data []byte = canvas.Serialize()
+
+    var wrongVersion []byte = append([]byte(nil), data...)
+    wrongVersion[0] = SerializationVersion + 1
+
+    _, err := DeserializeCanvas(wrongVersion)
+
+    if !errors.Is(err, ErrUnsupportedVersion) {
+        t.Errorf("unknown version: got %v, want ErrUnsupportedVersion", err)
+    }
+
+    _, err = DeserializeCanvas(data[:len(data) - 1])
+
+    if !errors.Is(err, ErrSizeMismatch) {
+        t.Errorf("truncated data: got %v, want ErrSizeMismatch", err)
+    }
+}
+/*  End of TestDeserializeInvalidData.                                        */
@@ -0,0 +1,44 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the rate at which the mesh spins about the z axis.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetAngularSpeed                                                       *
+ *  Purpose:                                                                  *
+ *      Sets the rate at which StepByTime spins the mesh about the z axis.    *
+ *      Unlike the fixed angle of SetRotationAngle, which is applied once per *
+ *      frame and so spins faster on faster displays, this is a rate per      *
+ *      second and gives the same motion at any refresh rate.                 *
+ *  Arguments:                                                                *
+ *      radiansPerSecond (float32):                                           *
+ *          The angular speed, positive for counterclockwise spin.            *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func SetAngularSpeed(radiansPerSecond float32) {
+    AngularSpeed = radiansPerSecond
+}
+/*  End of SetAngularSpeed.                                                   */
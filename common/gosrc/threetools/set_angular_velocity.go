@@ -0,0 +1,50 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the angular velocity used for spinning a mesh with inertia.      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetAngularVelocity                                                    *
+ *  Purpose:                                                                  *
+ *      Sets the rate at which StepRotation turns the mesh about each of the  *
+ *      coordinate axes. This is typically set from the speed of a drag when  *
+ *      it is released, so the figure keeps spinning and slowly comes to      *
+ *      rest.                                                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose angular velocity is being set.                   *
+ *      wx (float32):                                                         *
+ *          The rate of rotation about the x axis, in radians per second.     *
+ *      wy (float32):                                                         *
+ *          The rate of rotation about the y axis, in radians per second.     *
+ *      wz (float32):                                                         *
+ *          The rate of rotation about the z axis, in radians per second.     *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) SetAngularVelocity(wx, wy, wz float32) {
+    self.AngularVelocity = [3]float32{wx, wy, wz}
+}
+/*  End of SetAngularVelocity.                                                */
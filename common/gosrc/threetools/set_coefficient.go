@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets a named coefficient of the surface family drawn by a canvas.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetCoefficient                                                        *
+ *  Purpose:                                                                  *
+ *      Sets one of the coefficients of the family of surfaces last drawn by  *
+ *      GenerateSurfacePreset, like the amplitude of the Gaussian, and        *
+ *      updates the stored surface. The mesh is not recomputed, call          *
+ *      Regenerate or mark the canvas dirty afterwards. Names the preset does *
+ *      not list are rejected, so a typo does not silently do nothing.        *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas drawing the family.                                    *
+ *      name (string):                                                        *
+ *          The name of the coefficient.                                      *
+ *      value (float32):                                                      *
+ *          The new value.                                                    *
+ *  Output:                                                                   *
+ *      found (bool):                                                         *
+ *          False if the preset has no coefficient with this name.            *
+ ******************************************************************************/
+func (self *Canvas) SetCoefficient(name string, value float32) bool {
+    preset, found := SurfacePresets[self.Preset]
+
+    if !found {
+        return false
+    }
+
+    if _, known := preset.Coefficients[name]; !known {
+        return false
+    }
+
+    if self.Coefficients == nil {
+        self.Coefficients = make(map[string]float32)
+    }
+
+    self.Coefficients[name] = value
+
+    if preset.Family != nil {
+        self.Surface = preset.Family(self.presetCoefficients(preset))
+    }
+
+    /*  As in GenerateSurfacePreset, a time dependent family is stored at t = *
+     *  0. The next AnimateSurface uses the new coefficients.                 */
+    if preset.TimeFamily != nil {
+        self.Surface = surfaceAtTime(self.presetTimeSurface(preset), 0.0)
+    }
+
+    return true
+}
+/*  End of SetCoefficient.                                                    */
@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Changes the domain of a canvas and redraws the surface.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetDomain                                                             *
+ *  Purpose:                                                                  *
+ *      Moves the domain of the canvas to the rectangle with the given bottom *
+ *      left corner and size, and regenerates the mesh from the stored        *
+ *      surface. The resolution, the mesh type, and the buffers are           *
+ *      untouched, so this may be used every frame to pan and zoom over a     *
+ *      function. Nothing is redrawn if the canvas has no stored surface.     *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose domain is being changed.                         *
+ *      xStart (float32):                                                     *
+ *          The left edge of the domain.                                      *
+ *      yStart (float32):                                                     *
+ *          The bottom edge of the domain.                                    *
+ *      width (float32):                                                      *
+ *          The width of the domain.                                          *
+ *      height (float32):                                                     *
+ *          The height of the domain.                                         *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrNonFinite for a bad domain, or the error from Regenerate.      *
+ ******************************************************************************/
+func (self *Canvas) SetDomain(xStart, yStart, width, height float32) error {
+
+    /*  A non-finite domain would fill the whole mesh with NaN.               */
+    if !isFinite(xStart) || !isFinite(yStart) ||
+       !isFinite(width) || !isFinite(height) {
+        return ErrNonFinite
+    }
+
+    self.HorizontalStart = xStart
+    self.VerticalStart = yStart
+    self.Width = width
+    self.Height = height
+    self.HasSpinCenter = false
+    self.invalidateBaseOrientation()
+    return self.Regenerate()
+}
+/*  End of SetDomain.                                                         */
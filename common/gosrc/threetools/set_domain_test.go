@@ -0,0 +1,81 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for moving the domain of a canvas.                              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  NaN is provided here.                                                     */
+import (
+    "math"
+    "testing"
+)
+
+/*  Panning the domain moves the corner vertices by the same amount, the      *
+ *  heights are those of the surface at the new corners, and the grid is      *
+ *  unchanged.                                                                */
+func TestSetDomainPansCorners(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, paraboloid, WithGrid(5, 4), WithDomain(2.0, 3.0, -1.0, -1.5),
+    )
+
+    if err := canvas.SetDomain(0.5, -2.5, 2.0, 3.0); err != nil {
+        t.Fatalf("SetDomain: %v", err)
+    }
+
+    if (canvas.NxPts != 5) || (canvas.NyPts != 4) {
+        t.Errorf("grid is %dx%d, want 5x4", canvas.NxPts, canvas.NyPts)
+    }
+
+    var cases = []struct {
+        xIndex, yIndex uint32
+        x, y float32
+    }{
+        {0, 0, 0.5, -2.5},
+        {4, 0, 2.5, -2.5},
+        {0, 3, 0.5, 0.5},
+        {4, 3, 2.5, 0.5},
+    }
+
+    for _, c := range cases {
+        var index uint32 = canvas.gridIndex(c.xIndex, c.yIndex)
+        var want [3]float32 = [3]float32{c.x, c.y, paraboloid(c.x, c.y)}
+        expectVector(t, "corner", canvas.point(int(index)), want, 1.0E-6)
+    }
+}
+/*  End of TestSetDomainPansCorners.                                          */
+
+/*  A non-finite domain is rejected and the canvas keeps its domain.          */
+func TestSetDomainNonFinite(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+    var previous float32 = canvas.HorizontalStart
+    var nan float32 = float32(math.NaN())
+
+    if err := canvas.SetDomain(nan, 0.0, 1.0, 1.0); err != ErrNonFinite {
+        t.Errorf("NaN domain: got %v, want ErrNonFinite", err)
+    }
+
+    if canvas.HorizontalStart != previous {
+        t.Errorf("start moved to %g", canvas.HorizontalStart)
+    }
+}
+/*  End of TestSetDomainNonFinite.                                            */
@@ -0,0 +1,102 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Keeps the heights of an animated surface within a range.              *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp is provided here.                                                     */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetHeightTarget                                                       *
+ *  Purpose:                                                                  *
+ *      Limits the heights drawn by AnimateSurface to [-target, target], for  *
+ *      time dependent surfaces whose amplitude would otherwise leave the     *
+ *      view. Each frame the heights are scaled uniformly by the largest      *
+ *      factor, at most one, that keeps them in range. When the surface grows *
+ *      the scale shrinks at once, so the bound always holds, and when it     *
+ *      shrinks the scale relaxes back smoothly at the rate HeightRelaxation, *
+ *      so the figure does not flicker. Zero turns this off.                  *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the animated surface.                             *
+ *      target (float32):                                                     *
+ *          The largest height drawn, zero for no limit.                      *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) SetHeightTarget(target float32) {
+    self.HeightTarget = target
+
+    /*  The first frame after this picks the scale without relaxing.          */
+    self.HeightScale = 0.0
+}
+/*  End of SetHeightTarget.                                                   */
+
+/*  Scales the heights of the mesh so that they stay within HeightTarget, see *
+ *  SetHeightTarget. The time t of the frame is used for the relaxation.      */
+func (self *Canvas) normalizeHeights(t float32) {
+
+    /*  Variable for indexing over the z coordinates.                         */
+    var index int
+
+    if self.HeightTarget <= 0.0 {
+        return
+    }
+
+    zMin, zMax := self.HeightRange()
+    var extent float32 = zMax
+
+    if -zMin > extent {
+        extent = -zMin
+    }
+
+    /*  The largest scale, at most one, keeping the heights in range.         */
+    var scale float32 = 1.0
+
+    if extent > self.HeightTarget {
+        scale = self.HeightTarget / extent
+    }
+
+    /*  Larger scales are approached gradually, smaller ones are used at      *
+     *  once. Time going backwards, like a restarted clock, does not relax.   */
+    if (self.HeightScale > 0.0) && (scale > self.HeightScale) {
+        var elapsed float32 = t - self.FrameTime
+
+        if elapsed < 0.0 {
+            elapsed = 0.0
+        }
+
+        var rate float64 = float64(HeightRelaxation * elapsed)
+        var weight float32 = float32(1.0 - math.Exp(-rate))
+        scale = self.HeightScale + weight * (scale - self.HeightScale)
+    }
+
+    self.HeightScale = scale
+    self.FrameTime = t
+
+    for index = 2; index < self.MeshSize; index += 3 {
+        self.Mesh[index] *= scale
+    }
+}
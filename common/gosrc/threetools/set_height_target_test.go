@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for keeping animated surfaces within a height range.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A paraboloid whose amplitude grows as (1 + t)^2 stays within the height   *
+ *  target on every frame, and reaches it, although unscaled it would grow    *
+ *  more than a hundredfold.                                                  */
+func TestSetHeightTargetBoundsGrowingSurface(t *testing.T) {
+    const target float32 = 1.5
+
+    RegisterSurface("growing_paraboloid", SurfacePreset{
+        Time: func(x, y, t float32) float32 {
+            return (1.0 + t) * (1.0 + t) * paraboloid(x, y)
+        },
+        HorizontalStart: -1.0,
+        Width: 2.0,
+        VerticalStart: -1.0,
+        Height: 2.0,
+        MeshType: SquareWireframe,
+    })
+
+    t.Cleanup(func() { delete(SurfacePresets, "growing_paraboloid") })
+
+    var canvas *Canvas = newTestCanvas(t, WithGrid(9, 9))
+
+    if err := canvas.GenerateSurfacePreset("growing_paraboloid"); err != nil {
+        t.Fatalf("GenerateSurfacePreset: %v", err)
+    }
+
+    canvas.SetHeightTarget(target)
+
+    for frame := 0; frame <= 20; frame++ {
+        var time float32 = 0.5 * float32(frame)
+
+        if err := canvas.AnimateSurface(time); err != nil {
+            t.Fatalf("AnimateSurface: %v", err)
+        }
+
+        var largest float32 = largestHeight(canvas)
+
+        if (largest > target * 1.000001) || (largest < 0.99 * target) {
+            t.Fatalf("largest height %g at t = %g, want %g",
+                     largest, time, target)
+        }
+    }
+}
+/*  End of TestSetHeightTargetBoundsGrowingSurface.                           */
@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Changes the mesh type of a canvas and redraws its wireframe.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetMeshType                                                           *
+ *  Purpose:                                                                  *
+ *      Sets the type of wireframe used by a canvas, resizes the index buffer *
+ *      for the new type, and regenerates the line segments. The canvas is    *
+ *      left unchanged if the type is not supported.                          *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose mesh type is being changed.                      *
+ *      meshType (uint):                                                      *
+ *          The new type, one of the SquareWireframe constants.               *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrInvalidMeshType or an error from the wireframe.                *
+ ******************************************************************************/
+func (self *Canvas) SetMeshType(meshType uint) error {
+    if !isValidMeshType(meshType) {
+        return ErrInvalidMeshType
+    }
+
+    self.MeshType = meshType
+
+    /*  Recompute the index size and rebind the buffer, then redraw.          */
+    if err := self.ResetIndexBuffer(self.IndexStorage); err != nil {
+        return err
+    }
+
+    return self.GenerateRectangularWireframe()
+}
+/*  End of SetMeshType.                                                       */
@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for switching the mesh type at runtime.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  errors checks the kind of error.                                          */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Switching a 4x3 grid from squares to triangles adds the six diagonals,    *
+ *  growing IndexSize from 2 * 17 to 2 * 23, and switching back restores it.  */
+func TestSetMeshTypeUpdatesIndexSize(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var cases = []struct {
+        meshType uint
+        indexSize int
+    }{
+        {SquareWireframe, 34},
+        {TriangleWireframe, 46},
+        {SquareWireframe, 34},
+    }
+
+    for _, c := range cases {
+        if err := canvas.SetMeshType(c.meshType); err != nil {
+            t.Fatalf("SetMeshType(%d): %v", c.meshType, err)
+        }
+
+        if (canvas.MeshType != c.meshType) ||
+           (canvas.IndexSize != c.indexSize) {
+            t.Errorf("mesh type %d with IndexSize %d, want %d with %d",
+                     canvas.MeshType, canvas.IndexSize,
+                     c.meshType, c.indexSize)
+        }
+    }
+}
+/*  End of TestSetMeshTypeUpdatesIndexSize.                                   */
+
+/*  An unknown mesh type is rejected and the canvas keeps its type.           */
+func TestSetMeshTypeInvalid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(4, 3))
+    var err error = canvas.SetMeshType(ProjectiveTriangleWireframe + 1)
+
+    if !errors.Is(err, ErrInvalidMeshType) {
+        t.Errorf("SetMeshType gave %v, want ErrInvalidMeshType", err)
+    }
+
+    if canvas.MeshType != SquareWireframe {
+        t.Errorf("mesh type changed to %d", canvas.MeshType)
+    }
+}
+/*  End of TestSetMeshTypeInvalid.                                            */
@@ -0,0 +1,60 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the per-vertex scalar field of a canvas.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetScalarField                                                        *
+ *  Purpose:                                                                  *
+ *      Copies one value per vertex to the scalar field of the canvas. Any    *
+ *      routine computing a quantity on the surface, like the curvature, the  *
+ *      slope, or a custom field, may store it here, and the front end reads  *
+ *      it through ScalarFieldAddress to draw it the same way for all of      *
+ *      them. The memory of the previous field is reused when possible.       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas the field is stored in.                                *
+ *      values ([]float32):                                                   *
+ *          The field, one value per vertex.                                  *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrSizeMismatch if there is not one value per vertex.             *
+ ******************************************************************************/
+func (self *Canvas) SetScalarField(values []float32) error {
+    if len(values) != self.NumberOfPoints {
+        return fmt.Errorf(
+            "%w: %d values for %d vertices",
+            ErrSizeMismatch, len(values), self.NumberOfPoints,
+        )
+    }
+
+    self.ScalarField = resizeBuffer(self.ScalarField, len(values))
+    copy(self.ScalarField, values)
+    return nil
+}
+/*  End of SetScalarField.                                                    */
@@ -0,0 +1,76 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the per-vertex scalar field and its address.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A field set on the canvas is read back unchanged through its address, as  *
+ *  the front end does, and is a copy of the values passed in.                */
+func TestScalarFieldReadThroughAddress(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var values []float32 = make([]float32, canvas.NumberOfPoints)
+
+    if canvas.ScalarFieldAddress() != 0 {
+        t.Errorf("address %#x before a field is set, want 0",
+                 canvas.ScalarFieldAddress())
+    }
+
+    for index := range values {
+        values[index] = 1.5 * float32(index) - 4.0
+    }
+
+    if err := canvas.SetScalarField(values); err != nil {
+        t.Fatalf("SetScalarField: %v", err)
+    }
+
+    var address uintptr = canvas.ScalarFieldAddress()
+    var field []float32 = SliceFromAddress[float32](address, len(values))
+    values[0] = 100.0
+
+    for index, value := range field {
+        var want float32 = 1.5 * float32(index) - 4.0
+
+        if value != want {
+            t.Errorf("field[%d] = %g through the address, want %g",
+                     index, value, want)
+        }
+    }
+}
+/*  End of TestScalarFieldReadThroughAddress.                                 */
+
+/*  A field without exactly one value per vertex is rejected.                 */
+func TestScalarFieldSizeMismatch(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var values []float32 = make([]float32, canvas.NumberOfPoints - 1)
+
+    if err := canvas.SetScalarField(values); !errors.Is(err, ErrSizeMismatch) {
+        t.Errorf("got %v, want ErrSizeMismatch", err)
+    }
+}
+/*  End of TestScalarFieldSizeMismatch.                                       */
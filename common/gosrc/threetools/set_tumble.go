@@ -0,0 +1,50 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sets the rates at which the mesh tumbles.                             *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SetTumble                                                             *
+ *  Purpose:                                                                  *
+ *      Sets independent rates of rotation about the x, y, and z axes, used   *
+ *      by StepByTime. The three rotations are composed and applied to the    *
+ *      base mesh, so the figure tumbles rather than spinning about a single  *
+ *      axis. With wx and wy zero the mesh spins about z alone, the same as   *
+ *      with SetAngularSpeed(wz).                                             *
+ *  Arguments:                                                                *
+ *      wx (float32):                                                         *
+ *          The rate about the x axis, the pitch, in radians per second.      *
+ *      wy (float32):                                                         *
+ *          The rate about the y axis, the yaw, in radians per second.        *
+ *      wz (float32):                                                         *
+ *          The rate about the z axis, the roll, in radians per second.       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func SetTumble(wx, wy, wz float32) {
+    TumbleSpeed = [2]float32{wx, wy}
+    AngularSpeed = wz
+}
+/*  End of SetTumble.                                                         */
@@ -0,0 +1,77 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for rotating the mesh about all three axes.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Sets the rates of rotation about all three axes for the duration of a     *
+ *  test.                                                                     */
+func withTumble(t *testing.T, wx, wy, wz float32) {
+    var previousTumble [2]float32 = TumbleSpeed
+    var previousSpeed float32 = AngularSpeed
+    SetTumble(wx, wy, wz)
+
+    t.Cleanup(func() {
+        TumbleSpeed = previousTumble
+        AngularSpeed = previousSpeed
+    })
+}
+/*  End of withTumble.                                                        */
+
+/*  With only the rate about z nonzero, the tumble is the spin of             *
+ *  SetAngularSpeed, the pitch and yaw stay zero and the vertices agree.      */
+func TestTumbleAboutZIsSpin(t *testing.T) {
+    var spun *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var tumbled *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+
+    withTumble(t, 0.0, 0.0, 0.75)
+
+    for frame := 0; frame < 10; frame++ {
+        tumbled.StepByTime(0.1)
+    }
+
+    SetTumble(0.0, 0.0, 0.0)
+    SetAngularSpeed(0.75)
+
+    for frame := 0; frame < 10; frame++ {
+        spun.StepByTime(0.1)
+    }
+
+    if (tumbled.Pitch != 0.0) || (tumbled.Yaw != 0.0) {
+        t.Errorf("pitch %g and yaw %g, want both 0",
+                 tumbled.Pitch, tumbled.Yaw)
+    }
+
+    if !closeTo(tumbled.Roll, spun.Roll, 1.0E-6) {
+        t.Errorf("roll %g from the tumble, %g from the spin",
+                 tumbled.Roll, spun.Roll)
+    }
+
+    for index := 0; index < spun.NumberOfPoints; index++ {
+        expectVector(t, "vertex", tumbled.point(index),
+                     spun.point(index), 1.0E-6)
+    }
+}
+/*  End of TestTumbleAboutZIsSpin.                                            */
@@ -0,0 +1,92 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the sine and cosine of an angle together.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Round and Pi are provided here, used for reducing the angle.              */
+import "math"
+
+/*  Coefficients of the Taylor series for cosine, in terms of z^2.            */
+const C0 float32 = +1.00000000E+00
+const C1 float32 = -5.00000000E-01
+const C2 float32 = +4.16666667E-02
+const C3 float32 = -1.38888889E-03
+const C4 float32 = +2.48015873E-05
+
+/*  Coefficients of the Taylor series for sine, in terms of z^2.              */
+const S0 float32 = +1.00000000E+00
+const S1 float32 = -1.66666667E-01
+const S2 float32 = +8.33333333E-03
+const S3 float32 = -1.98412698E-04
+const S4 float32 = +2.75573192E-06
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SinCos                                                                *
+ *  Purpose:                                                                  *
+ *      Computes the sine and cosine of an angle in a single evaluation. The  *
+ *      angle is reduced to [-pi/4, pi/4] by a whole number of quarter turns, *
+ *      and the Taylor series, which share the square of the reduced angle,   *
+ *      are accurate to single precision on this interval. The quarter turns  *
+ *      then swap and negate the results. This is the one place the rotations *
+ *      get their trig from, see SetRotationAngle and UnitVectorFromAngle.    *
+ *  Arguments:                                                                *
+ *      angle (float32):                                                      *
+ *          The angle, in radians.                                            *
+ *  Output:                                                                   *
+ *      sinAngle (float32):                                                   *
+ *          The sine of the angle, NaN if the angle is not finite.            *
+ *      cosAngle (float32):                                                   *
+ *          The cosine of the angle, NaN if the angle is not finite.          *
+ ******************************************************************************/
+func SinCos(angle float32) (float32, float32) {
+
+    if !isFinite(angle) {
+        var nan float32 = float32(math.NaN())
+        return nan, nan
+    }
+
+    /*  The number of quarter turns, and the remaining angle. The reduction   *
+     *  is done in double precision so large angles keep their accuracy.      */
+    var turns float64 = math.Round(float64(angle) * (2.0 / math.Pi))
+    var z float32 = float32(float64(angle) - turns * (0.5 * math.Pi))
+    var zsq float32 = z * z
+
+    /*  Horner's method for both series.                                      */
+    var s float32 = z * (S0 + zsq * (S1 + zsq * (S2 + zsq * (S3 + zsq * S4))))
+    var c float32 = C0 + zsq * (C1 + zsq * (C2 + zsq * (C3 + zsq * C4)))
+
+    /*  Rotate the result by the quarter turns, sin(z + k pi / 2) and so on.  */
+    switch int64(math.Mod(turns, 4.0) + 4.0) % 4 {
+        case 1:
+            return c, -s
+        case 2:
+            return -s, -c
+        case 3:
+            return -c, s
+        default:
+            return s, c
+    }
+}
+/*  End of SinCos.                                                            */
@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the combined sine and cosine used by the rotations.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos, Pi, NaN, and Inf are provided here.                               */
+import (
+    "math"
+    "testing"
+)
+
+/*  Across several turns of the circle, in both directions, SinCos agrees     *
+ *  with math.Sincos to single precision.                                     */
+func TestSinCosMatchesSincos(t *testing.T) {
+    const samples int = 2000
+
+    for sample := 0; sample <= samples; sample++ {
+        var angle float32 = float32(
+            -6.0 * math.Pi + 12.0 * math.Pi * float64(sample) /
+            float64(samples),
+        )
+
+        sinWant, cosWant := math.Sincos(float64(angle))
+        sinGot, cosGot := SinCos(angle)
+
+        if !closeTo(sinGot, float32(sinWant), 1.0E-6) ||
+           !closeTo(cosGot, float32(cosWant), 1.0E-6) {
+            t.Fatalf("SinCos(%g) = (%g, %g), want (%g, %g)",
+                     angle, sinGot, cosGot, sinWant, cosWant)
+        }
+    }
+}
+/*  End of TestSinCosMatchesSincos.                                           */
+
+/*  An angle that is not finite gives NaN for both values.                    */
+func TestSinCosNonFinite(t *testing.T) {
+    var angles []float32 = []float32{
+        float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)),
+    }
+
+    for _, angle := range angles {
+        sinAngle, cosAngle := SinCos(angle)
+
+        if !math.IsNaN(float64(sinAngle)) || !math.IsNaN(float64(cosAngle)) {
+            t.Errorf("SinCos(%g) = (%g, %g), want NaN",
+                     angle, sinAngle, cosAngle)
+        }
+    }
+}
+/*  End of TestSinCosNonFinite.                                               */
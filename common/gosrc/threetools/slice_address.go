@@ -0,0 +1,52 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Returns the address of the data of a slice.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  The Pointer type is provided here, which gets an address from an array.   */
+import "unsafe"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SliceAddress                                                          *
+ *  Purpose:                                                                  *
+ *      Returns the address of the first element of a slice, the inverse of   *
+ *      SliceFromAddress.                                                     *
+ *  Arguments:                                                                *
+ *      buffer ([]T):                                                         *
+ *          The slice whose address is being computed.                        *
+ *  Output:                                                                   *
+ *      address (uintptr):                                                    *
+ *          The address of the data, or zero if the slice is empty.           *
+ ******************************************************************************/
+func SliceAddress[T any](buffer []T) uintptr {
+
+    /*  An empty slice may not have any memory behind it.                     */
+    if len(buffer) == 0 {
+        return 0
+    }
+
+    return uintptr(unsafe.Pointer(&buffer[0]))
+}
+/*  End of SliceAddress.                                                      */
@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Creates a slice from a memory address, with a bounds check.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SliceFromAddressChecked                                               *
+ *  Purpose:                                                                  *
+ *      Like SliceFromAddress, but rejects lengths that are negative or       *
+ *      larger than the known size of the memory, for example                 *
+ *      MaxMeshBufferSize for the mesh buffer. A view beyond the end of the   *
+ *      buffer would let writes corrupt unrelated memory, so this should be   *
+ *      used whenever the length comes from outside of Go, like from          *
+ *      JavaScript.                                                           *
+ *  Arguments:                                                                *
+ *      address (uintptr):                                                    *
+ *          The memory address of the start of the data.                      *
+ *      length (int):                                                         *
+ *          The number of elements in the array, not bytes.                   *
+ *      max (int):                                                            *
+ *          The largest number of elements the memory holds.                  *
+ *  Output:                                                                   *
+ *      arr ([]float32 or []uint32):                                          *
+ *          A slice for the data, nil on failure.                             *
+ *      err (error):                                                          *
+ *          ErrBufferTooSmall if the length is not valid.                     *
+ ******************************************************************************/
+func SliceFromAddressChecked[T float32 | uint32](address uintptr,
+                                                 length, max int) ([]T, error) {
+    if (length < 0) || (length > max) {
+        return nil, fmt.Errorf(
+            "%w: length %d is not in [0, %d]", ErrBufferTooSmall, length, max,
+        )
+    }
+
+    /*  There is no memory at the null address.                               */
+    if (address == 0) && (length > 0) {
+        return nil, fmt.Errorf("%w: null address", ErrBufferTooSmall)
+    }
+
+    return SliceFromAddress[T](address, length), nil
+}
+/*  End of SliceFromAddressChecked.                                           */
@@ -0,0 +1,116 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the bounds checked view of a memory address.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, and unsafe gives the address of a     *
+ *  buffer the way the JavaScript side sees it.                               */
+import (
+    "errors"
+    "runtime"
+    "testing"
+    "unsafe"
+)
+
+/*  The number of elements in the buffer the views are made of.               */
+const checkedBufferLength int = 64
+
+/*  A length past the end of the memory is rejected, as is a negative one.    */
+func TestSliceFromAddressCheckedTooLong(t *testing.T) {
+    var buffer [checkedBufferLength]float32
+    var address uintptr = uintptr(unsafe.Pointer(&buffer[0]))
+
+    for _, length := range []int{checkedBufferLength + 1, -1} {
+        arr, err := SliceFromAddressChecked[float32](
+            address, length, checkedBufferLength,
+        )
+
+        if !errors.Is(err, ErrBufferTooSmall) || (arr != nil) {
+            t.Errorf("length %d gave (%v, %v), want ErrBufferTooSmall",
+                     length, arr, err)
+        }
+    }
+
+    runtime.KeepAlive(&buffer)
+}
+/*  End of TestSliceFromAddressCheckedTooLong.                                */
+
+/*  For any length and maximum, the view either fails with ErrBufferTooSmall  *
+ *  and no slice, or has exactly the requested length and aliases the memory. *
+ *  The maximum is limited to the size of the buffer, as the callers do with  *
+ *  MaxMeshBufferSize.                                                        */
+func FuzzSliceFromAddressChecked(f *testing.F) {
+    f.Add(0, 0, false)
+    f.Add(16, checkedBufferLength, false)
+    f.Add(checkedBufferLength, checkedBufferLength, false)
+    f.Add(checkedBufferLength + 1, checkedBufferLength, false)
+    f.Add(-1, checkedBufferLength, false)
+    f.Add(4, 8, true)
+    f.Add(0, 8, true)
+
+    f.Fuzz(func(t *testing.T, length, max int, null bool) {
+        var buffer [checkedBufferLength]uint32
+        var address uintptr = uintptr(unsafe.Pointer(&buffer[0]))
+
+        if max > checkedBufferLength {
+            max = checkedBufferLength
+        }
+
+        if null {
+            address = 0
+        }
+
+        arr, err := SliceFromAddressChecked[uint32](address, length, max)
+        var valid bool = (length >= 0) && (length <= max) &&
+                         ((address != 0) || (length == 0))
+
+        if !valid {
+            if !errors.Is(err, ErrBufferTooSmall) || (arr != nil) {
+                t.Fatalf("length %d, max %d, null %v gave (%v, %v)",
+                         length, max, null, arr, err)
+            }
+
+            return
+        }
+
+        if (err != nil) || (len(arr) != length) || (cap(arr) != length) {
+            t.Fatalf("length %d, max %d gave a slice of length %d, %v",
+                     length, max, len(arr), err)
+        }
+
+        /*  Writes through the view land in the buffer.                       */
+        for index := range arr {
+            arr[index] = uint32(index + 1)
+        }
+
+        for index := 0; index < length; index++ {
+            if buffer[index] != uint32(index + 1) {
+                t.Fatalf("element %d was not written to the buffer", index)
+            }
+        }
+
+        runtime.KeepAlive(&buffer)
+    })
+}
+/*  End of FuzzSliceFromAddressChecked.                                       */
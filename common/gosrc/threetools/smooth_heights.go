@@ -0,0 +1,113 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Smooths the heights of a graph with a Gaussian blur.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp and Ceil are provided here, used for the kernel.                      */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SmoothHeights                                                         *
+ *  Purpose:                                                                  *
+ *      Convolves the z values of the mesh with a Gaussian kernel, reducing   *
+ *      noise in measured or sampled data. The kernel is separable, so the    *
+ *      rows are blurred first and then the columns. The grid is given by     *
+ *      NxPts and NyPts, and near the boundary the grid is extended by        *
+ *      repeating the edge values, while closed seams are crossed, see        *
+ *      NeighborIndex. The x and y coordinates are unchanged. The kernel is   *
+ *      cut off at three standard deviations.                                 *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh being smoothed.                          *
+ *      sigma (float32):                                                      *
+ *          The standard deviation of the kernel, in grid cells.              *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) SmoothHeights(sigma float32) {
+
+    /*  Variables for indexing over the grid and the kernel.                  */
+    var xIndex, yIndex, offset int
+
+    /*  The grid dimensions, as integers for the index computations.          */
+    var width int = int(self.NxPts)
+    var height int = int(self.NyPts)
+
+    if (sigma <= 0.0) || (width * height != self.NumberOfPoints) {
+        return
+    }
+
+    /*  The kernel extends three standard deviations on either side.          */
+    var radius int = int(math.Ceil(3.0 * float64(sigma)))
+    var kernel []float32 = make([]float32, 2 * radius + 1)
+    var total float32 = 0.0
+
+    for offset = -radius; offset <= radius; offset++ {
+        var t float64 = float64(offset) / float64(sigma)
+        kernel[offset + radius] = float32(math.Exp(-0.5 * t * t))
+        total += kernel[offset + radius]
+    }
+
+    /*  Normalize so that constant surfaces are unchanged.                    */
+    for offset = range kernel {
+        kernel[offset] /= total
+    }
+
+    /*  Heights after the horizontal pass, one per vertex.                    */
+    var blurred []float32 = make([]float32, self.NumberOfPoints)
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var sum float32 = 0.0
+
+            for offset = -radius; offset <= radius; offset++ {
+                neighbor, _ := self.NeighborIndex(xIndex, yIndex, offset, 0)
+                sum += kernel[offset + radius] * self.Mesh[3 * neighbor + 2]
+            }
+
+            vertex, _ := self.NeighborIndex(xIndex, yIndex, 0, 0)
+            blurred[vertex] = sum
+        }
+    }
+
+    /*  The vertical pass reads the blurred rows and writes the mesh.         */
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var sum float32 = 0.0
+
+            for offset = -radius; offset <= radius; offset++ {
+                neighbor, _ := self.NeighborIndex(xIndex, yIndex, 0, offset)
+                sum += kernel[offset + radius] * blurred[neighbor]
+            }
+
+            vertex, _ := self.NeighborIndex(xIndex, yIndex, 0, 0)
+            self.Mesh[3 * vertex + 2] = sum
+        }
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of SmoothHeights.                                                     */
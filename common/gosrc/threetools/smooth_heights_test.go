@@ -0,0 +1,77 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the Gaussian smoothing of the heights.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  A single spike in the middle of a flat 9x9 grid spreads to its neighbors. *
+ *  The peak drops, the four nearest neighbors rise by the same amount, the   *
+ *  total height is kept since the kernel stays away from the boundary, and   *
+ *  the far corner, beyond the cutoff, stays flat.                            */
+func TestSmoothHeightsSpike(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, Plane, WithGrid(9, 9))
+    var center int = 3 * int(canvas.gridIndex(4, 4))
+    var original *Canvas
+
+    canvas.Mesh[center + 2] = 1.0
+    original = canvas.Clone()
+    canvas.SmoothHeights(1.0)
+
+    var height = func(xIndex, yIndex uint32) float32 {
+        return canvas.Mesh[3 * canvas.gridIndex(xIndex, yIndex) + 2]
+    }
+
+    var peak float32 = height(4, 4)
+    var neighbor float32 = height(5, 4)
+    var total float32 = 0.0
+
+    if (peak >= 1.0) || (neighbor <= 0.0) || (neighbor >= peak) {
+        t.Errorf("peak %g and neighbor %g after smoothing", peak, neighbor)
+    }
+
+    for _, other := range []float32{height(3, 4), height(4, 3), height(4, 5)} {
+        if !closeTo(other, neighbor, 1.0E-7) {
+            t.Errorf("neighbors %g and %g differ", other, neighbor)
+        }
+    }
+
+    if height(0, 0) != 0.0 {
+        t.Errorf("far corner rose to %g", height(0, 0))
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p, q [3]float32 = canvas.point(index), original.point(index)
+        total += p[2]
+
+        if (p[0] != q[0]) || (p[1] != q[1]) {
+            t.Fatalf("vertex %d moved from %v to %v", index, q, p)
+        }
+    }
+
+    if !closeTo(total, 1.0, 1.0E-5) {
+        t.Errorf("total height %g after smoothing, want 1", total)
+    }
+}
+/*  End of TestSmoothHeightsSpike.                                            */
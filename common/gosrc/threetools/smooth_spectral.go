@@ -0,0 +1,180 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Removes high frequencies from the heights of a grid.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt and IsNaN are provided here, used for the cutoff.                    */
+import "math"
+
+/*  Returns the length of an axis of n points after it is mirrored about its  *
+ *  last point, so the extended sequence is periodic without a jump.          */
+func mirroredLength(n int) int {
+    if n < 2 {
+        return 1
+    }
+
+    return 2 * (n - 1)
+}
+
+/*  Returns the index of the original point for an index of the mirrored      *
+ *  axis, see mirroredLength.                                                 */
+func mirroredIndex(index, n int) int {
+    if index < n {
+        return index
+    }
+
+    return 2 * (n - 1) - index
+}
+
+/*  Returns the frequency of an index of a transform of the mirrored axis as  *
+ *  a fraction of the Nyquist frequency, between 0 and 1.                     */
+func mirroredFrequency(index, n int) float64 {
+    var length int = mirroredLength(n)
+
+    if length < 2 {
+        return 0.0
+    }
+
+    if index > length / 2 {
+        index = length - index
+    }
+
+    return float64(index) / float64(n - 1)
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SmoothSpectral                                                        *
+ *  Purpose:                                                                  *
+ *      Band-limits the z values of the mesh. The heights are transformed     *
+ *      with a two dimensional FFT, every frequency above the cutoff is set   *
+ *      to zero, and the result is transformed back. Unlike SmoothHeights,    *
+ *      the frequencies below the cutoff are kept exactly, so large features  *
+ *      keep their shape while fine noise is removed. The grid is mirrored    *
+ *      about its edges before the transform, so the boundary is not treated  *
+ *      as a jump. The cutoff is the radial frequency as a fraction of the    *
+ *      Nyquist frequency of the grid, zero leaves only the mean height, and  *
+ *      square root 2 or more changes nothing. The x and y coordinates are    *
+ *      unchanged, and normals computed before are not updated.               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being smoothed.                          *
+ *      cutoff (float32):                                                     *
+ *          The largest frequency kept.                                       *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrSizeMismatch if the mesh is not the grid, or ErrNonFinite for  *
+ *          a NaN cutoff.                                                     *
+ ******************************************************************************/
+func SmoothSpectral(canvas *Canvas, cutoff float32) error {
+
+    /*  Variables for indexing over the grid and the extended grid.           */
+    var xIndex, yIndex int
+
+    /*  The grid dimensions, as integers for the index computations.          */
+    var width int = int(canvas.NxPts)
+    var height int = int(canvas.NyPts)
+
+    if width * height != canvas.NumberOfPoints {
+        return ErrSizeMismatch
+    }
+
+    if math.IsNaN(float64(cutoff)) {
+        return ErrNonFinite
+    }
+
+    if canvas.NumberOfPoints == 0 {
+        return nil
+    }
+
+    /*  The mirrored grid, stored row by row, and a buffer for one column.    */
+    var xLength int = mirroredLength(width)
+    var yLength int = mirroredLength(height)
+    var spectrum []complex128 = make([]complex128, xLength * yLength)
+    var column []complex128 = make([]complex128, yLength)
+
+    for yIndex = 0; yIndex < yLength; yIndex++ {
+        var y uint32 = uint32(mirroredIndex(yIndex, height))
+
+        for xIndex = 0; xIndex < xLength; xIndex++ {
+            var x uint32 = uint32(mirroredIndex(xIndex, width))
+            var z float32 = canvas.Mesh[3 * canvas.gridIndex(x, y) + 2]
+
+            spectrum[yIndex * xLength + xIndex] = complex(float64(z), 0.0)
+        }
+    }
+
+    /*  The two dimensional transform is done one axis at a time.             */
+    var transform = func(inverse bool) {
+        for yIndex = 0; yIndex < yLength; yIndex++ {
+            fft(spectrum[yIndex * xLength:(yIndex + 1) * xLength], inverse)
+        }
+
+        for xIndex = 0; xIndex < xLength; xIndex++ {
+            for yIndex = 0; yIndex < yLength; yIndex++ {
+                column[yIndex] = spectrum[yIndex * xLength + xIndex]
+            }
+
+            fft(column, inverse)
+
+            for yIndex = 0; yIndex < yLength; yIndex++ {
+                spectrum[yIndex * xLength + xIndex] = column[yIndex]
+            }
+        }
+    }
+
+    transform(false)
+
+    for yIndex = 0; yIndex < yLength; yIndex++ {
+        var fy float64 = mirroredFrequency(yIndex, height)
+
+        for xIndex = 0; xIndex < xLength; xIndex++ {
+            var fx float64 = mirroredFrequency(xIndex, width)
+
+            if math.Sqrt(fx * fx + fy * fy) > float64(cutoff) {
+                spectrum[yIndex * xLength + xIndex] = 0.0
+            }
+        }
+    }
+
+    transform(true)
+
+    /*  The inverse transform is not normalized.                              */
+    var scale float64 = 1.0 / float64(xLength * yLength)
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var value complex128 = spectrum[yIndex * xLength + xIndex]
+            var index uint32 = canvas.gridIndex(uint32(xIndex), uint32(yIndex))
+
+            canvas.Mesh[3 * index + 2] = float32(real(value) * scale)
+        }
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    canvas.rebaseOrientation()
+
+    return nil
+}
+/*  End of SmoothSpectral.                                                    */
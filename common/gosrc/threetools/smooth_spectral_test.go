@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for band-limiting the heights with the FFT.                     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Cos and Pi are provided by math, errors.Is is used for the error.         */
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+/*  The heights on a 17x17 grid, a slow wave along x with one component of    *
+ *  the frequency 12 / 16 of the Nyquist frequency added along y. Cosines     *
+ *  with a whole number of half periods over the grid are the modes of the    *
+ *  mirrored grid, so each lies at a single frequency.                        */
+func twoBandHeights(xIndex, yIndex uint32, withHigh bool) float32 {
+    var low float64 = math.Cos(math.Pi * float64(xIndex) / 16.0)
+    var high float64 = 0.0
+
+    if withHigh {
+        high = 0.5 * math.Cos(12.0 * math.Pi * float64(yIndex) / 16.0)
+    }
+
+    return float32(low + high)
+}
+/*  End of twoBandHeights.                                                    */
+
+/*  With the cutoff at half the Nyquist frequency the component at 3 / 4 of   *
+ *  it is removed, and the slow wave at 1 / 16 of it is kept exactly.         */
+func TestSmoothSpectralRemovesHighFrequency(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(17, 17))
+
+    for yIndex := uint32(0); yIndex < 17; yIndex++ {
+        for xIndex := uint32(0); xIndex < 17; xIndex++ {
+            var index uint32 = canvas.gridIndex(xIndex, yIndex)
+            canvas.Mesh[3 * index + 2] = twoBandHeights(xIndex, yIndex, true)
+        }
+    }
+
+    if err := SmoothSpectral(canvas, 0.5); err != nil {
+        t.Fatalf("SmoothSpectral: %v", err)
+    }
+
+    for yIndex := uint32(0); yIndex < 17; yIndex++ {
+        for xIndex := uint32(0); xIndex < 17; xIndex++ {
+            var index uint32 = canvas.gridIndex(xIndex, yIndex)
+            var z float32 = canvas.Mesh[3 * index + 2]
+            var want float32 = twoBandHeights(xIndex, yIndex, false)
+
+            if !closeTo(z, want, 1.0E-5) {
+                t.Fatalf("height at (%d, %d) is %g, want %g",
+                         xIndex, yIndex, z, want)
+            }
+        }
+    }
+}
+/*  End of TestSmoothSpectralRemovesHighFrequency.                            */
+
+/*  A NaN cutoff is rejected.                                                 */
+func TestSmoothSpectralNaNCutoff(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 5))
+    var nan float32 = float32(math.NaN())
+
+    if err := SmoothSpectral(canvas, nan); !errors.Is(err, ErrNonFinite) {
+        t.Errorf("SmoothSpectral(NaN) = %v, want ErrNonFinite", err)
+    }
+}
+/*  End of TestSmoothSpectralNaNCutoff.                                       */
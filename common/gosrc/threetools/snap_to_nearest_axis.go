@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Snaps the orientation of a mesh to the nearest axis-aligned view.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Round is provided here.                                                   */
+import "math"
+
+/*  Rounds an angle to the nearest multiple of a quarter turn.                */
+func nearestQuarterTurn(angle float32) float32 {
+    var quarterTurn float64 = 0.5 * math.Pi
+    return float32(quarterTurn * math.Round(float64(angle) / quarterTurn))
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SnapToNearestAxis                                                     *
+ *  Purpose:                                                                  *
+ *      Rounds the accumulated yaw, pitch, and roll of the canvas to the      *
+ *      nearest multiples of 90 degrees and rotates the base mesh to this     *
+ *      orientation. Products of quarter turns about the coordinate axes map  *
+ *      the axes to the axes, so after a free rotation this gives a tidy      *
+ *      front, side, or top view. The rotation starts from the base mesh      *
+ *      recorded by CaptureBaseOrientation, with the angles left by           *
+ *      DragRotate, SpinLatLon, and the other rotations that track the        *
+ *      orientation.                                                          *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh that is being snapped.                   *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func SnapToNearestAxis(canvas *Canvas) {
+    canvas.ensureBaseOrientation()
+
+    canvas.Yaw = nearestQuarterTurn(canvas.Yaw)
+    canvas.Pitch = nearestQuarterTurn(canvas.Pitch)
+    canvas.Roll = nearestQuarterTurn(canvas.Roll)
+
+    canvas.applyBaseOrientation()
+}
+/*  End of SnapToNearestAxis.                                                 */
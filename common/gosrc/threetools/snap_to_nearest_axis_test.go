@@ -0,0 +1,57 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for snapping the orientation to the coordinate axes.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi is provided by math.                                                   */
+import (
+    "math"
+    "testing"
+)
+
+/*  Starting slightly off a quarter turn about the z axis, with a small tilt, *
+ *  snapping gives exactly 90 degrees and no tilt, so the mesh is the         *
+ *  original turned a quarter of the way around, (x, y, z) to (-y, x, z).     */
+func TestSnapToNearestAxisQuarterTurn(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var original *Canvas = canvas.Clone()
+
+    SpinLatLon(canvas, 0.5 * math.Pi + 0.08, -0.05)
+    SnapToNearestAxis(canvas)
+
+    var quarterTurn float32 = 0.5 * math.Pi
+
+    if (canvas.Roll != quarterTurn) || (canvas.Pitch != 0.0) ||
+       (canvas.Yaw != 0.0) {
+        t.Errorf("yaw, pitch, roll = %g, %g, %g, want 0, 0, %g",
+                 canvas.Yaw, canvas.Pitch, canvas.Roll, quarterTurn)
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = original.point(index)
+        var want [3]float32 = [3]float32{-p[1], p[0], p[2]}
+        expectVector(t, "snapped", canvas.point(index), want, 1.0E-6)
+    }
+}
+/*  End of TestSnapToNearestAxisQuarterTurn.                                  */
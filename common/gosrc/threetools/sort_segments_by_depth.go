@@ -0,0 +1,103 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Sorts the line segments of a wireframe from back to front.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  SliceStable is provided here, used for ordering the segments by depth.    */
+import "sort"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SortSegmentsByDepth                                                   *
+ *  Purpose:                                                                  *
+ *      Returns the line segments of the index buffer ordered from the        *
+ *      farthest to the nearest, measured by the depth of their midpoints     *
+ *      along the viewing direction. Drawing the segments in this order gives *
+ *      the painter's algorithm look for 2D exports. Line strips are split    *
+ *      into their segments.                                                  *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh and index buffer being sorted.           *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction, pointing from the viewer into the scene.   *
+ *  Output:                                                                   *
+ *      sorted ([]uint32):                                                    *
+ *          Pairs of vertex indices, one pair per segment, sorted back to     *
+ *          front. The index buffer of the canvas is not modified.            *
+ ******************************************************************************/
+func SortSegmentsByDepth(canvas *Canvas, direction [3]float32) []uint32 {
+
+    /*  Variable for indexing over the line segments.                         */
+    var index int
+
+    /*  Each line segment is given by two indices, see segmentPairs.          */
+    var pairs []uint32 = canvas.segmentPairs()
+    var numberOfSegments int = len(pairs) / 2
+
+    /*  Depth is measured along the forward vector of the camera.             */
+    _, _, forward := viewBasis(direction)
+
+    /*  The order of the segments, and the depth of each segment.             */
+    var order []int = make([]int, numberOfSegments)
+    var depths []float32 = make([]float32, numberOfSegments)
+
+    /*  The output, the sorted pairs of indices.                              */
+    var sorted []uint32 = make([]uint32, 2 * numberOfSegments)
+
+    /*  Compute the depth of each segment. Since the dot product is linear,   *
+     *  the depth of the midpoint is the average of the endpoint depths.      */
+    for index = 0; index < numberOfSegments; index++ {
+        var start uint32 = 3 * pairs[2 * index]
+        var end uint32 = 3 * pairs[2 * index + 1]
+
+        var startPoint [3]float32 = [3]float32{
+            canvas.Mesh[start], canvas.Mesh[start + 1], canvas.Mesh[start + 2],
+        }
+
+        var endPoint [3]float32 = [3]float32{
+            canvas.Mesh[end], canvas.Mesh[end + 1], canvas.Mesh[end + 2],
+        }
+
+        var startDepth float32 = dotProduct(startPoint, forward)
+        var endDepth float32 = dotProduct(endPoint, forward)
+
+        order[index] = index
+        depths[index] = 0.5 * (startDepth + endDepth)
+    }
+
+    /*  Farther segments have larger depth and need to be drawn first. Use a  *
+     *  stable sort so segments of equal depth keep their original order.     */
+    sort.SliceStable(order, func(m, n int) bool {
+        return depths[order[m]] > depths[order[n]]
+    })
+
+    /*  Copy the segments into the output in the sorted order.                */
+    for index = 0; index < numberOfSegments; index++ {
+        sorted[2 * index] = pairs[2 * order[index]]
+        sorted[2 * index + 1] = pairs[2 * order[index] + 1]
+    }
+
+    return sorted
+}
+/*  End of SortSegmentsByDepth.                                               */
@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for ordering the segments of the wireframe by depth.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On a plane tilted away from the viewer the segments come back to front,   *
+ *  the far segments before the near ones, and every segment is kept.         */
+func TestSortSegmentsByDepthTiltedPlane(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, func(x, y float32) float32 {
+        return 0.5 * y
+    }, WithGrid(4, 4))
+
+    var direction [3]float32 = [3]float32{0.0, 1.0, -1.0}
+    var sorted []uint32 = SortSegmentsByDepth(canvas, direction)
+    _, _, forward := viewBasis(direction)
+
+    if len(sorted) != canvas.IndexSize {
+        t.Fatalf("%d indices sorted, want %d", len(sorted), canvas.IndexSize)
+    }
+
+    var previous float32 = float32(1.0E30)
+
+    for index := 0; index + 1 < len(sorted); index += 2 {
+        var midpoint [3]float32 = scaleVector(
+            vectorSum(canvas.point(int(sorted[index])),
+                      canvas.point(int(sorted[index + 1]))), 0.5,
+        )
+        var depth float32 = dotProduct(midpoint, forward)
+
+        if depth > previous + 1.0E-6 {
+            t.Fatalf("segment %d at depth %g is behind the one before it",
+                     index / 2, depth)
+        }
+
+        previous = depth
+    }
+
+    /*  The first segment is on the far edge of the plane, y = 1.             */
+    if canvas.point(int(sorted[0]))[1] != 1.0 {
+        t.Errorf("first segment starts at %v, not on the far edge",
+                 canvas.point(int(sorted[0])))
+    }
+}
+/*  End of TestSortSegmentsByDepthTiltedPlane.                                */
@@ -0,0 +1,138 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Surfaces given by the real spherical harmonics.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  fmt formats the error messages, and math provides Sincos, Sqrt, and the   *
+ *  trig functions.                                                           */
+import (
+    "fmt"
+    "math"
+)
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SphericalHarmonic                                                     *
+ *  Purpose:                                                                  *
+ *      Returns the radial surface r = |Y_l^m(theta, phi)| for the real       *
+ *      spherical harmonic of degree l and order m, in Cartesian coordinates. *
+ *      The real harmonics use cos(m phi) for m > 0 and sin(|m| phi) for m <  *
+ *      0, giving the familiar lobes. The radius is scaled by sqrt(4 pi) so   *
+ *      that Y_0^0 is the unit sphere. The u parameter is the azimuth phi and *
+ *      v is the polar angle theta, measured down from the z axis.            *
+ *  Arguments:                                                                *
+ *      l (int):                                                              *
+ *          The degree, at least zero.                                        *
+ *      m (int):                                                              *
+ *          The order, between -l and l.                                      *
+ *  Output:                                                                   *
+ *      f (ParametricSurface):                                                *
+ *          The parametrization of the surface.                               *
+ ******************************************************************************/
+func SphericalHarmonic(l, m int) ParametricSurface {
+
+    /*  Variable for computing the ratio of factorials.                       */
+    var index int
+
+    /*  The order without its sign, used for the Legendre polynomial.         */
+    var order int = m
+
+    if order < 0 {
+        order = -order
+    }
+
+    /*  The normalization, sqrt((2l + 1) (l - |m|)! / (l + |m|)!). The usual  *
+     *  factor of 1 / sqrt(4 pi) is cancelled by the scaling of the radius.   */
+    var scale float64 = float64(2 * l + 1)
+
+    for index = l - order + 1; index <= l + order; index++ {
+        scale /= float64(index)
+    }
+
+    scale = math.Sqrt(scale)
+
+    /*  The real harmonics with m != 0 carry an extra factor of sqrt(2).      */
+    if m != 0 {
+        scale *= math.Sqrt2
+    }
+
+    return func(u, v float32) [3]float32 {
+        sinPhi, cosPhi := math.Sincos(float64(u))
+        sinTheta, cosTheta := math.Sincos(float64(v))
+
+        var radius float64 = scale * associatedLegendre(l, order, cosTheta)
+
+        if m > 0 {
+            radius *= math.Cos(float64(m) * float64(u))
+        } else if m < 0 {
+            radius *= math.Sin(float64(order) * float64(u))
+        }
+
+        radius = math.Abs(radius)
+
+        return [3]float32{
+            float32(radius * sinTheta * cosPhi),
+            float32(radius * sinTheta * sinPhi),
+            float32(radius * cosTheta),
+        }
+    }
+}
+/*  End of SphericalHarmonic.                                                 */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      GenerateSphericalHarmonic                                             *
+ *  Purpose:                                                                  *
+ *      Computes the mesh and wireframe of the surface r = |Y_l^m| with the   *
+ *      azimuth over [0, 2 pi] and the polar angle over [0, pi]. The domain   *
+ *      of the canvas is set to match, the number of points must already be   *
+ *      set. The surface is not a graph, so the stored surface is cleared.    *
+ *      Invalid degrees and orders, l < 0 or |m| > l, leave the canvas        *
+ *      unchanged.                                                            *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas for the animation, with the grid size already set.     *
+ *      l (int):                                                              *
+ *          The degree, at least zero.                                        *
+ *      m (int):                                                              *
+ *          The order, between -l and l.                                      *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrInvalidDegree for an invalid l or m, or the error from the     *
+ *          wireframe.                                                        *
+ ******************************************************************************/
+func GenerateSphericalHarmonic(canvas *Canvas, l, m int) error {
+    if (l < 0) || (m > l) || (m < -l) {
+        return fmt.Errorf("%w: l = %d, m = %d", ErrInvalidDegree, l, m)
+    }
+
+    canvas.HorizontalStart = 0.0
+    canvas.Width = 2.0 * math.Pi
+    canvas.VerticalStart = 0.0
+    canvas.Height = math.Pi
+    canvas.Surface = nil
+    canvas.GenerateMeshFromParametric(SphericalHarmonic(l, m))
+    return canvas.GenerateRectangularWireframe()
+}
+/*  End of GenerateSphericalHarmonic.                                         */
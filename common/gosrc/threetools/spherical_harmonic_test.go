@@ -0,0 +1,83 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the spherical harmonic surfaces.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  Y_0^0 is the unit sphere, it replaces a stored graph, and the wireframe   *
+ *  is rebuilt.                                                               */
+func TestGenerateSphericalHarmonicSphere(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 5))
+
+    /*  The segments of the rows and columns of the grid.                     */
+    var wantIndexSize int = 2 * (8 * 5 + 4 * 9)
+
+    if err := GenerateSphericalHarmonic(canvas, 0, 0); err != nil {
+        t.Fatalf("GenerateSphericalHarmonic: %v", err)
+    }
+
+    if canvas.Surface != nil {
+        t.Fatalf("the paraboloid is still stored")
+    }
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+
+        if !closeTo(vectorNorm(p), 1.0, 1.0E-5) {
+            t.Fatalf("vertex %v is not on the unit sphere", p)
+        }
+    }
+
+    if canvas.IndexSize != wantIndexSize {
+        t.Errorf("IndexSize = %d, want %d", canvas.IndexSize, wantIndexSize)
+    }
+}
+/*  End of TestGenerateSphericalHarmonicSphere.                               */
+
+/*  Invalid degrees and orders are reported and leave the mesh alone.         */
+func TestGenerateSphericalHarmonicInvalid(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 5))
+    var before [3]float32 = canvas.point(6)
+
+    for _, degree := range [][2]int{{-1, 0}, {1, 2}, {2, -3}} {
+        var err error = GenerateSphericalHarmonic(canvas, degree[0], degree[1])
+
+        if !errors.Is(err, ErrInvalidDegree) {
+            t.Errorf("l = %d, m = %d gave %v, want ErrInvalidDegree",
+                     degree[0], degree[1], err)
+        }
+    }
+
+    expectVector(t, "vertex", canvas.point(6), before, 0.0)
+
+    if canvas.Surface == nil {
+        t.Errorf("the stored surface was cleared by a failed call")
+    }
+}
+/*  End of TestGenerateSphericalHarmonicInvalid.                              */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates a mesh about its centroid.                                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SpinAboutCentroid                                                     *
+ *  Purpose:                                                                  *
+ *      Rotates the mesh about the vertical line through its centroid, rather *
+ *      than the z axis like RotateMesh, so surfaces that are off center spin *
+ *      in place. The centroid is computed the first time and stored in       *
+ *      SpinCenter, the rotation leaves it fixed, and computing it once keeps *
+ *      round-off from making the center drift over many frames. It is found  *
+ *      again after the grid or the domain changes.                           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being rotated.                   *
+ *      point (UnitVector):                                                   *
+ *          A point on the unit circle, its polar angle is used for rotating. *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) SpinAboutCentroid(point UnitVector) {
+
+    /*  Variable for indexing over the vertices.                              */
+    var index int
+
+    if !self.HasSpinCenter {
+        var center [3]float32 = self.Centroid()
+        self.SpinCenter = [2]float32{center[0], center[1]}
+        self.HasSpinCenter = true
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        var x float32 = self.Mesh[index] - self.SpinCenter[0]
+        var y float32 = self.Mesh[index + 1] - self.SpinCenter[1]
+
+        self.Mesh[index] = self.SpinCenter[0] +
+                           point.AngleCos * x - point.AngleSin * y
+        self.Mesh[index + 1] = self.SpinCenter[1] +
+                               point.AngleCos * y + point.AngleSin * x
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of SpinAboutCentroid.                                                 */
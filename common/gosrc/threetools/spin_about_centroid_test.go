@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for rotating a mesh about its centroid.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  math.Pi is used for the angle of each step.                               */
+import (
+    "math"
+    "testing"
+)
+
+/*  An off-center mesh spun through a full turn in small steps keeps its      *
+ *  centroid fixed at every step, and comes back to where it started.         */
+func TestSpinAboutCentroidKeepsCentroid(t *testing.T) {
+    const steps int = 64
+
+    var canvas *Canvas = newGraphCanvas(
+        t, saddle, WithGrid(7, 6), WithDomain(2.0, 2.0, 2.0, -3.0),
+    )
+
+    var original []float32 = append([]float32(nil), canvas.Mesh...)
+    var center [3]float32 = canvas.Centroid()
+    var point UnitVector = UnitVectorFromAngle(2.0 * math.Pi / float32(steps))
+
+    for step := 0; step < steps; step++ {
+        canvas.SpinAboutCentroid(point)
+        expectVector(t, "centroid", canvas.Centroid(), center, 1.0E-4)
+    }
+
+    for index := range original {
+        if !closeTo(canvas.Mesh[index], original[index], 1.0E-3) {
+            t.Fatalf("mesh[%d] = %g after a full turn, want %g",
+                     index, canvas.Mesh[index], original[index])
+        }
+    }
+}
+/*  End of TestSpinAboutCentroidKeepsCentroid.                                */
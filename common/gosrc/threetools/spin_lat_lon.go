@@ -0,0 +1,59 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Rotates a mesh by a longitude and a latitude.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SpinLatLon                                                            *
+ *  Purpose:                                                                  *
+ *      Orients the mesh by a longitude and a latitude, the natural controls  *
+ *      for globes and other spherical surfaces. The mesh is turned about the *
+ *      z axis by the longitude, and then tilted about the rotated x axis by  *
+ *      the latitude. Tilting about the rotated axis is the same as tilting   *
+ *      about the fixed x axis first and turning about z second, which is the *
+ *      pitch and roll of DragRotate with no yaw, so the angles are stored in *
+ *      Pitch and Roll. The rotation is applied to the base mesh recorded by  *
+ *      CaptureBaseOrientation, so there is no drift, and replaces the        *
+ *      previous orientation rather than adding to it. Angles are in radians. *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh that is being rotated.                   *
+ *      lon (float32):                                                        *
+ *          The longitude, the angle about the z axis.                        *
+ *      lat (float32):                                                        *
+ *          The latitude, the angle about the rotated x axis.                 *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func SpinLatLon(canvas *Canvas, lon, lat float32) {
+    canvas.ensureBaseOrientation()
+
+    canvas.Yaw = 0.0
+    canvas.Pitch = lat
+    canvas.Roll = lon
+
+    canvas.applyBaseOrientation()
+}
+/*  End of SpinLatLon.                                                        */
@@ -0,0 +1,56 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for orienting the mesh by longitude and latitude.               *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi is provided by math.                                                   */
+import (
+    "math"
+    "testing"
+)
+
+/*  A longitude and latitude of zero leave the mesh where it was, and a       *
+ *  longitude of a quarter turn rotates it counterclockwise about the z axis, *
+ *  taking (x, y, z) to (-y, x, z). Neither depends on earlier calls.         */
+func TestSpinLatLonKnownRotations(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var original *Canvas = canvas.Clone()
+
+    SpinLatLon(canvas, 0.3, -0.7)
+    SpinLatLon(canvas, 0.0, 0.0)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        expectVector(t, "identity", canvas.point(index),
+                     original.point(index), 1.0E-6)
+    }
+
+    SpinLatLon(canvas, 0.5 * math.Pi, 0.0)
+
+    for index := 0; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = original.point(index)
+        var want [3]float32 = [3]float32{-p[1], p[0], p[2]}
+        expectVector(t, "quarter turn", canvas.point(index), want, 1.0E-6)
+    }
+}
+/*  End of TestSpinLatLonKnownRotations.                                      */
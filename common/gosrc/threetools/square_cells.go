@@ -0,0 +1,103 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Matches the resolution of a grid to the shape of its domain.          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package used for rounding and absolute values.           */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SquareCellPoints                                                      *
+ *  Purpose:                                                                  *
+ *      Suggests the number of points along the vertical axis so that the     *
+ *      cells of the grid are as close to square as possible, given the       *
+ *      number of points along the horizontal axis and the size of the        *
+ *      domain. A 2x1 domain with 65 horizontal points gives 33 vertical      *
+ *      points, 64 by 32 cells. There is always at least one row of cells,    *
+ *      and never more than MaxHeight points. If the width is zero or not     *
+ *      finite there is nothing to match, and nxPts is returned.              *
+ *  Arguments:                                                                *
+ *      nxPts (uint32):                                                       *
+ *          The number of points along the horizontal axis.                   *
+ *      width (float32):                                                      *
+ *          The width of the domain, the sign is ignored.                     *
+ *      height (float32):                                                     *
+ *          The height of the domain, the sign is ignored.                    *
+ *  Output:                                                                   *
+ *      nyPts (uint32):                                                       *
+ *          The suggested number of points along the vertical axis.           *
+ ******************************************************************************/
+func SquareCellPoints(nxPts uint32, width, height float32) uint32 {
+    var xLength float64 = math.Abs(float64(width))
+    var yLength float64 = math.Abs(float64(height))
+
+    if (nxPts < 2) || (xLength == 0) || !isFinite(width) || !isFinite(height) {
+        return nxPts
+    }
+
+    /*  The cell width is xLength / (nxPts - 1), and the cell height should   *
+     *  match it.                                                             */
+    var cells float64 = math.Round(float64(nxPts - 1) * yLength / xLength)
+
+    if cells < 1 {
+        cells = 1
+    }
+
+    if cells + 1 > float64(MaxHeight) {
+        return MaxHeight
+    }
+
+    return uint32(cells) + 1
+}
+/*  End of SquareCellPoints.                                                  */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      CellAspectRatio                                                       *
+ *  Purpose:                                                                  *
+ *      Computes the width of a cell of the grid divided by its height. A     *
+ *      value of one means square cells, far from one means the cells are     *
+ *      stretched and the normals and shading will be lopsided. See           *
+ *      SquareCellPoints for a resolution that fixes this. A grid without     *
+ *      cells, or with a domain of zero height, gives zero.                   *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose grid is measured.                                *
+ *  Output:                                                                   *
+ *      ratio (float32):                                                      *
+ *          The aspect ratio of the cells.                                    *
+ ******************************************************************************/
+func (self *Canvas) CellAspectRatio() float32 {
+    var cellWidth, cellHeight float64
+
+    if (self.NxPts < 2) || (self.NyPts < 2) || (self.Height == 0) {
+        return 0
+    }
+
+    cellWidth = math.Abs(float64(self.Width)) / float64(self.NxPts - 1)
+    cellHeight = math.Abs(float64(self.Height)) / float64(self.NyPts - 1)
+    return float32(cellWidth / cellHeight)
+}
+/*  End of CellAspectRatio.                                                   */
@@ -0,0 +1,69 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for choosing a grid with square cells.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  On a 2:1 domain a square grid has cells twice as wide as they are tall.   *
+ *  With WithSquareCells the vertical resolution is chosen from the           *
+ *  horizontal one, 65 points give 33, and the cells are square, or as close  *
+ *  to square as whole numbers of cells allow.                                */
+func TestSquareCellsTwoByOneDomain(t *testing.T) {
+    var cases = []struct {
+        nxPts, nyPts uint32
+    }{
+        {65, 33},
+        {9, 5},
+        {20, 11},
+    }
+
+    var stretched *Canvas = newTestCanvas(
+        t, WithGrid(65, 65), WithDomain(4.0, 2.0, -2.0, -1.0),
+    )
+
+    if ratio := stretched.CellAspectRatio(); !closeTo(ratio, 2.0, 1.0E-6) {
+        t.Errorf("65x65 grid has aspect ratio %g, want 2", ratio)
+    }
+
+    for _, c := range cases {
+        var canvas *Canvas = newTestCanvas(
+            t, WithSquareCells(true), WithGrid(c.nxPts, 65),
+            WithDomain(4.0, 2.0, -2.0, -1.0),
+        )
+
+        if canvas.NyPts != c.nyPts {
+            t.Errorf("%d horizontal points give %d vertical, want %d",
+                     c.nxPts, canvas.NyPts, c.nyPts)
+        }
+
+        /*  With 20 points the 19 columns need 9.5 rows. This is rounded to   *
+         *  10, giving the largest ratio of the cases, 20 / 19.               */
+        if ratio := canvas.CellAspectRatio(); !closeTo(ratio, 1.0, 0.06) {
+            t.Errorf("%dx%d grid has aspect ratio %g, want about 1",
+                     c.nxPts, canvas.NyPts, ratio)
+        }
+    }
+}
+/*  End of TestSquareCellsTwoByOneDomain.                                     */
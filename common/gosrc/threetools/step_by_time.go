@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Spins a mesh about the z axis over an elapsed time.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Remainder is provided here.                                               */
+import "math"
+
+/*  Adds an increment to an angle, the result is kept in [-pi, pi].           */
+func advanceAngle(angle, increment float32) float32 {
+    var sum float64 = float64(angle + increment)
+    return float32(math.Remainder(sum, 2.0 * math.Pi))
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      StepByTime                                                            *
+ *  Purpose:                                                                  *
+ *      Advances the roll of the mesh, its angle about the z axis, by         *
+ *      AngularSpeed * dt, so the spin depends only on the elapsed time and   *
+ *      not on the frame rate. The pitch and yaw are advanced the same way by *
+ *      TumbleSpeed, which is zero unless SetTumble was called. The angles    *
+ *      are applied to the base mesh recorded by CaptureBaseOrientation, so   *
+ *      there is no drift, and steps adding up to the same time give the same *
+ *      orientation. The angles are kept within a half turn of zero so long   *
+ *      animations do not lose precision. Nothing moves while the rotation is *
+ *      paused, see PauseRotation.                                            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being rotated.                   *
+ *      dt (float32):                                                         *
+ *          The time since the previous frame, in seconds.                    *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) StepByTime(dt float32) {
+    if self.RotationPaused {
+        return
+    }
+
+    self.ensureBaseOrientation()
+    self.Pitch = advanceAngle(self.Pitch, TumbleSpeed[0] * dt)
+    self.Yaw = advanceAngle(self.Yaw, TumbleSpeed[1] * dt)
+    self.Roll = advanceAngle(self.Roll, AngularSpeed * dt)
+    self.applyBaseOrientation()
+}
+/*  End of StepByTime.                                                        */
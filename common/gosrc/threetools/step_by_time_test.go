@@ -0,0 +1,68 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the frame-rate independent spin.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Sets the angular speed for the duration of a test.                        */
+func withAngularSpeed(t *testing.T, radiansPerSecond float32) {
+    var previous float32 = AngularSpeed
+    SetAngularSpeed(radiansPerSecond)
+    t.Cleanup(func() { SetAngularSpeed(previous) })
+}
+/*  End of withAngularSpeed.                                                  */
+
+/*  Two sequences of frames that add up to the same time, one at a steady     *
+ *  rate and one with uneven frames, give the same final orientation.         */
+func TestStepByTimeFrameRateIndependent(t *testing.T) {
+    withAngularSpeed(t, 1.5)
+
+    var steady *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+    var uneven *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+
+    for frame := 0; frame < 16; frame++ {
+        steady.StepByTime(0.125)
+    }
+
+    for _, dt := range []float32{0.5, 0.0625, 0.4375, 0.75, 0.25} {
+        uneven.StepByTime(dt)
+    }
+
+    if !closeTo(steady.Roll, uneven.Roll, 1.0E-6) {
+        t.Errorf("roll %g at steady frames, %g at uneven frames",
+                 steady.Roll, uneven.Roll)
+    }
+
+    /*  Two seconds at 1.5 radians per second.                                */
+    if !closeTo(steady.Roll, 3.0, 1.0E-6) {
+        t.Errorf("roll %g after two seconds, want 3", steady.Roll)
+    }
+
+    for index := 0; index < steady.NumberOfPoints; index++ {
+        expectVector(t, "vertex", uneven.point(index),
+                     steady.point(index), 1.0E-5)
+    }
+}
+/*  End of TestStepByTimeFrameRateIndependent.                                */
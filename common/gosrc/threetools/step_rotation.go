@@ -0,0 +1,74 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Advances a spinning mesh by one frame of its angular velocity.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp is provided here, used for the decay of the velocity.                 */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      StepRotation                                                          *
+ *  Purpose:                                                                  *
+ *      Advances the orientation of the mesh by its angular velocity over the *
+ *      elapsed time, and then slows the velocity down by the factor          *
+ *      exp(-RotationDamping * dt). Zero damping spins forever at a constant  *
+ *      rate, positive damping makes the figure come to rest. Since the decay *
+ *      depends only on the elapsed time, the spin-down looks the same at any *
+ *      frame rate. The angles are applied to the base mesh, see              *
+ *      CaptureBaseOrientation. Nothing changes while the rotation is paused, *
+ *      see PauseRotation.                                                    *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being rotated.                   *
+ *      dt (float32):                                                         *
+ *          The time since the previous frame, in seconds.                    *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) StepRotation(dt float32) {
+
+    /*  The decay factor for the velocity over this frame.                    */
+    var decay float32
+
+    /*  The velocity is kept too, so the spin picks up where it left off.     */
+    if self.RotationPaused {
+        return
+    }
+
+    decay = float32(math.Exp(-float64(RotationDamping * dt)))
+
+    self.ensureBaseOrientation()
+
+    self.Pitch += self.AngularVelocity[0] * dt
+    self.Yaw += self.AngularVelocity[1] * dt
+    self.Roll += self.AngularVelocity[2] * dt
+
+    self.AngularVelocity[0] *= decay
+    self.AngularVelocity[1] *= decay
+    self.AngularVelocity[2] *= decay
+
+    self.applyBaseOrientation()
+}
+/*  End of StepRotation.                                                      */
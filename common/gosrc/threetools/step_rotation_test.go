@@ -0,0 +1,97 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the inertia of the spinning mesh.                           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Exp is provided by math, used for the limit of the damped spin.           */
+import (
+    "math"
+    "testing"
+)
+
+/*  Sets the global damping for the duration of a test, restoring it after.   */
+func withRotationDamping(t *testing.T, damping float32) {
+    var previous float32 = RotationDamping
+    SetRotationDamping(damping)
+    t.Cleanup(func() { SetRotationDamping(previous) })
+}
+/*  End of withRotationDamping.                                               */
+
+/*  Without damping the mesh spins at a constant rate, the yaw grows by the   *
+ *  same amount every step and the velocity never changes.                    */
+func TestStepRotationUndamped(t *testing.T) {
+    withRotationDamping(t, 0.0)
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    canvas.SetAngularVelocity(0.0, 2.0, 0.0)
+
+    for step := 1; step <= 100; step++ {
+        canvas.StepRotation(0.01)
+
+        if !closeTo(canvas.Yaw, 0.02 * float32(step), 1.0E-5) {
+            t.Fatalf("yaw %g after %d steps, want %g",
+                     canvas.Yaw, step, 0.02 * float32(step))
+        }
+    }
+
+    if canvas.AngularVelocity[1] != 2.0 {
+        t.Errorf("velocity %g without damping, want 2",
+                 canvas.AngularVelocity[1])
+    }
+}
+/*  End of TestStepRotationUndamped.                                          */
+
+/*  With damping the velocity decays towards zero and the yaw increases to a  *
+ *  limit without passing it. Each step adds w dt and then scales w by the    *
+ *  factor q = exp(-damping dt), so the yaw is a geometric series with the    *
+ *  sum w dt / (1 - q), about w / damping for small steps.                    */
+func TestStepRotationDamped(t *testing.T) {
+    withRotationDamping(t, 2.0)
+
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(3, 3))
+    var previous float32 = 0.0
+    var limit float32 = float32(0.02 / (1.0 - math.Exp(-0.02)))
+    canvas.SetAngularVelocity(0.0, 2.0, 0.0)
+
+    for step := 0; step < 1000; step++ {
+        canvas.StepRotation(0.01)
+
+        if (canvas.Yaw < previous) || (canvas.Yaw > limit) {
+            t.Fatalf("yaw %g after %d steps, want it increasing to %g",
+                     canvas.Yaw, step + 1, limit)
+        }
+
+        previous = canvas.Yaw
+    }
+
+    if canvas.AngularVelocity[1] > 1.0E-6 {
+        t.Errorf("velocity %g after 10 seconds, want about 0",
+                 canvas.AngularVelocity[1])
+    }
+
+    if !closeTo(canvas.Yaw, limit, 1.0E-5) {
+        t.Errorf("yaw %g at rest, want %g", canvas.Yaw, limit)
+    }
+}
+/*  End of TestStepRotationDamped.                                            */
@@ -0,0 +1,140 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Reads the line segments of the index buffer in either index format.   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the line segments of the index buffer as pairs of indices. If     *
+ *  LineStrips is set every two consecutive vertices of a strip make up a     *
+ *  segment, otherwise the index buffer already holds the pairs and is        *
+ *  returned as is, so the result must not be modified.                       */
+func (self *Canvas) segmentPairs() []uint32 {
+
+    /*  Variable for indexing over the strips.                                */
+    var index int
+
+    /*  The pairs of the segments, for the line strip format.                 */
+    var pairs []uint32
+
+    if !self.LineStrips {
+        return self.Indices[0:self.IndexSize]
+    }
+
+    pairs = make([]uint32, 0, 2 * self.SegmentCount())
+
+    for index = 0; index + 1 < self.IndexSize; index++ {
+        var start uint32 = self.Indices[index]
+        var end uint32 = self.Indices[index + 1]
+
+        if (start == StripRestartIndex) || (end == StripRestartIndex) {
+            continue
+        }
+
+        pairs = append(pairs, start, end)
+    }
+
+    return pairs
+}
+/*  End of segmentPairs.                                                      */
+
+/*  Removes the restarts that no longer separate two strips, those at the     *
+ *  start and end of the index buffer and those following another restart,    *
+ *  along with the strips left with a single vertex, which draw nothing.      *
+ *  The indices are moved to the front of the buffer and IndexSize updated.   */
+func (self *Canvas) compactStrips() {
+
+    /*  Variables for indexing over the indices, and the number kept.         */
+    var index, kept int
+
+    /*  Where the strip being copied starts in the kept indices.              */
+    var stripStart int = 0
+
+    for index = 0; index < self.IndexSize; index++ {
+        var vertex uint32 = self.Indices[index]
+
+        if vertex != StripRestartIndex {
+            self.Indices[kept] = vertex
+            kept++
+            continue
+        }
+
+        /*  A strip of one vertex is dropped, and a strip of more is ended.   */
+        if kept - stripStart == 1 {
+            kept = stripStart
+        } else if kept > stripStart {
+            self.Indices[kept] = StripRestartIndex
+            kept++
+            stripStart = kept
+        }
+    }
+
+    /*  The last strip may also be a lone vertex, or end with a restart.      */
+    if kept - stripStart == 1 {
+        kept = stripStart
+    }
+
+    if (kept > 0) && (self.Indices[kept - 1] == StripRestartIndex) {
+        kept--
+    }
+
+    self.IndexSize = kept
+    self.Indices = self.Indices[0:kept]
+}
+/*  End of compactStrips.                                                     */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SegmentCount                                                          *
+ *  Purpose:                                                                  *
+ *      Counts the line segments of the index buffer. Without LineStrips      *
+ *      this is half of IndexSize, with it every two consecutive vertices of  *
+ *      a strip are one segment, and the restarts are not counted.            *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the index buffer.                                 *
+ *  Output:                                                                   *
+ *      count (int):                                                          *
+ *          The number of line segments.                                      *
+ ******************************************************************************/
+func (self *Canvas) SegmentCount() int {
+
+    /*  Variable for indexing over the strips.                                */
+    var index int
+
+    /*  The number of segments found in the strips.                           */
+    var count int = 0
+
+    if !self.LineStrips {
+        return self.IndexSize / 2
+    }
+
+    for index = 0; index + 1 < self.IndexSize; index++ {
+        if (self.Indices[index] != StripRestartIndex) &&
+           (self.Indices[index + 1] != StripRestartIndex) {
+            count++
+        }
+    }
+
+    return count
+}
+/*  End of SegmentCount.                                                      */
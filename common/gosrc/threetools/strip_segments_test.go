@@ -0,0 +1,337 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests that the segment consumers treat line strips like segments.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "errors"
+    "reflect"
+    "strings"
+    "testing"
+)
+
+/*  Creates the graph of f twice, once with its wireframe as line strips and  *
+ *  once as segments, so the consumers may be compared on the two.            */
+func stripAndSegmentCanvases(t *testing.T, f SurfaceParametrization,
+                             options ...CanvasOption) (*Canvas, *Canvas) {
+    t.Helper()
+
+    var strips *Canvas = newGraphCanvas(
+        t, f, append(options, WithLineStrips(true))...,
+    )
+
+    var segments *Canvas = newGraphCanvas(
+        t, f, append(options, WithLineStrips(false))...,
+    )
+
+    return strips, segments
+}
+/*  End of stripAndSegmentCanvases.                                           */
+
+/*  Counts the segments given by pairs of indices, ignoring the order of the  *
+ *  two ends, so wireframes written in different orders may be compared.      */
+func segmentMultiset(pairs []uint32) map[[2]uint32]int {
+    var counts map[[2]uint32]int = make(map[[2]uint32]int)
+
+    for index := 0; index + 1 < len(pairs); index += 2 {
+        var start, end uint32 = pairs[index], pairs[index + 1]
+
+        if start > end {
+            start, end = end, start
+        }
+
+        counts[[2]uint32{start, end}]++
+    }
+
+    return counts
+}
+/*  End of segmentMultiset.                                                   */
+
+/*  Checks that the strips are tidy: no restart at either end, none directly  *
+ *  after another, and no strip of a single vertex.                           */
+func expectTidyStrips(t *testing.T, canvas *Canvas) {
+    t.Helper()
+
+    var length int = 0
+
+    for index := 0; index < canvas.IndexSize; index++ {
+        if canvas.Indices[index] != StripRestartIndex {
+            length++
+            continue
+        }
+
+        if length < 2 {
+            t.Errorf("strip of %d vertices ends at index %d", length, index)
+        }
+
+        length = 0
+    }
+
+    if (canvas.IndexSize > 0) && (length < 2) {
+        t.Errorf("last strip has %d vertices", length)
+    }
+}
+/*  End of expectTidyStrips.                                                  */
+
+/*  The read-only consumers see the same segments in either format.           */
+func TestStripConsumersMatchSegments(t *testing.T) {
+    strips, segments := stripAndSegmentCanvases(
+        t, saddle, WithGrid(7, 5), WithMeshType(TriangleWireframe),
+    )
+
+    var direction [3]float32 = [3]float32{0.3, -0.5, -1.0}
+
+    if strips.IndexSize >= segments.IndexSize {
+        t.Fatalf("%d strip indices, not fewer than %d segment indices",
+                 strips.IndexSize, segments.IndexSize)
+    }
+
+    if got, want := strips.SegmentCount(), segments.SegmentCount();
+       got != want {
+        t.Errorf("SegmentCount: %d strip segments, want %d", got, want)
+    }
+
+    if !reflect.DeepEqual(segmentMultiset(strips.segmentPairs()),
+                          segmentMultiset(segments.Indices)) {
+        t.Errorf("segmentPairs: the strips give different segments")
+    }
+
+    if got, want := EulerCharacteristic(strips),
+                    EulerCharacteristic(segments); got != want {
+        t.Errorf("EulerCharacteristic: %d with strips, want %d", got, want)
+    }
+
+    if got, want := ConnectedComponents(strips),
+                    ConnectedComponents(segments); got != want {
+        t.Errorf("ConnectedComponents: %d with strips, want %d", got, want)
+    }
+
+    if !reflect.DeepEqual(
+        segmentMultiset(SortSegmentsByDepth(strips, direction)),
+        segmentMultiset(SortSegmentsByDepth(segments, direction)),
+    ) {
+        t.Errorf("SortSegmentsByDepth: the strips give different segments")
+    }
+
+    var stripSVG string = ExportSVGWithStroke(strips, direction, "#000", 1.0)
+    var segmentSVG string = ExportSVGWithStroke(
+        segments, direction, "#000", 1.0,
+    )
+
+    if got, want := strings.Count(stripSVG, "<line"),
+                    strings.Count(segmentSVG, "<line"); got != want {
+        t.Errorf("ExportSVGWithStroke: %d lines with strips, want %d",
+                 got, want)
+    }
+
+    strips.ExpandLinesToQuads(0.1)
+    segments.ExpandLinesToQuads(0.1)
+
+    if (len(strips.LineQuadMesh) != len(segments.LineQuadMesh)) ||
+       (len(strips.LineQuadFaces) != len(segments.LineQuadFaces)) {
+        t.Errorf("ExpandLinesToQuads: %d and %d with strips, want %d and %d",
+                 len(strips.LineQuadMesh), len(strips.LineQuadFaces),
+                 len(segments.LineQuadMesh), len(segments.LineQuadFaces))
+    }
+
+    if got, want := len(ExportGLB(strips)), len(ExportGLB(segments));
+       got != want {
+        t.Errorf("ExportGLB: %d bytes with strips, want %d", got, want)
+    }
+}
+/*  End of TestStripConsumersMatchSegments.                                   */
+
+/*  Clipping removes the same segments in either format, and the strips left  *
+ *  behind are still separated by single restarts.                            */
+func TestStripClipBelowMatchesSegments(t *testing.T) {
+    strips, segments := stripAndSegmentCanvases(
+        t, paraboloid, WithGrid(9, 7), WithMeshType(TriangleWireframe),
+    )
+
+    /*  Keeps the points with z = x^2 + y^2 >= 0.3, cutting out the middle.   */
+    var plane [4]float32 = [4]float32{0.0, 0.0, 1.0, -0.3}
+
+    var stripsRemoved int = strips.ClipBelow(plane)
+    var segmentsRemoved int = segments.ClipBelow(plane)
+
+    if (segmentsRemoved == 0) || (stripsRemoved != segmentsRemoved) {
+        t.Errorf("removed %d strip segments, want %d, not zero",
+                 stripsRemoved, segmentsRemoved)
+    }
+
+    if !reflect.DeepEqual(segmentMultiset(strips.segmentPairs()),
+                          segmentMultiset(segments.Indices)) {
+        t.Errorf("the clipped strips give different segments")
+    }
+
+    expectTidyStrips(t, strips)
+}
+/*  End of TestStripClipBelowMatchesSegments.                                 */
+
+/*  Collapsing the bottom row to a point, like the pole of a sphere, leaves   *
+ *  the same number of segments in either format, none of them degenerate.    */
+func TestStripRemoveDegenerateMatchesSegments(t *testing.T) {
+    strips, segments := stripAndSegmentCanvases(t, saddle, WithGrid(6, 4))
+
+    for _, canvas := range []*Canvas{strips, segments} {
+        var pole [3]float32 = canvas.point(0)
+
+        for column := uint32(1); column < canvas.NxPts; column++ {
+            var index uint32 = 3 * canvas.gridIndex(column, 0)
+            copy(canvas.Mesh[index:index + 3], pole[:])
+        }
+    }
+
+    if got, want := strips.RemoveDegenerateSegments(),
+                    segments.RemoveDegenerateSegments(); got != want {
+        t.Errorf("%d strip segments remain, want %d", got, want)
+    }
+
+    var pairs []uint32 = strips.segmentPairs()
+
+    for index := 0; index < len(pairs); index += 2 {
+        var difference [3]float32 = vectorDifference(
+            strips.point(int(pairs[index])),
+            strips.point(int(pairs[index + 1])),
+        )
+
+        if dotProduct(difference, difference) == 0.0 {
+            t.Errorf("segment %d to %d is degenerate",
+                     pairs[index], pairs[index + 1])
+        }
+    }
+
+    expectTidyStrips(t, strips)
+}
+/*  End of TestStripRemoveDegenerateMatchesSegments.                          */
+
+/*  The combined generator writes strips, not pairs, into the strip-sized     *
+ *  buffer, and still writes the faces.                                       */
+func TestStripWireframeAndFaces(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(6, 4), WithLineStrips(true),
+    )
+
+    var want []uint32 = wireframeIndices(t, 6, 4, true)
+
+    if err := canvas.GenerateWireframeAndFaces(true); err != nil {
+        t.Fatalf("GenerateWireframeAndFaces: %v", err)
+    }
+
+    if !reflect.DeepEqual(canvas.Indices, want) {
+        t.Errorf("indices are not the strips of GenerateWireframeStrips")
+    }
+
+    if len(canvas.Faces) != 6 * 5 * 3 {
+        t.Errorf("%d face indices, want %d", len(canvas.Faces), 6 * 5 * 3)
+    }
+}
+/*  End of TestStripWireframeAndFaces.                                        */
+
+/*  Welding a torus drawn with strips renumbers the vertices, keeping the     *
+ *  restarts, and joins the same vertices as the segments.                    */
+func TestStripWeldSeams(t *testing.T) {
+    var canvases [2]*Canvas
+
+    for index, enabled := range []bool{true, false} {
+        canvases[index] = newTestCanvas(
+            t, WithGrid(7, 5), WithMeshType(TorodialSquareWireframe),
+            WithDomain(1.0, 1.0, 0.0, 0.0), WithLineStrips(enabled),
+        )
+
+        canvases[index].GenerateMeshFromParametric(torus)
+
+        if err := canvases[index].GenerateRectangularWireframe(); err != nil {
+            t.Fatalf("GenerateRectangularWireframe: %v", err)
+        }
+    }
+
+    strips, segments := canvases[0], canvases[1]
+
+    if got, want := strips.WeldSeams(), segments.WeldSeams(); got != want {
+        t.Errorf("welded away %d vertices with strips, want %d", got, want)
+    }
+
+    if !reflect.DeepEqual(segmentMultiset(strips.segmentPairs()),
+                          segmentMultiset(segments.Indices)) {
+        t.Errorf("the welded strips give different segments")
+    }
+
+    if got, want := EulerCharacteristic(strips),
+                    EulerCharacteristic(segments); got != want {
+        t.Errorf("EulerCharacteristic: %d with strips, want %d", got, want)
+    }
+}
+/*  End of TestStripWeldSeams.                                                */
+
+/*  The format is saved with the canvas, and the restarts are accepted.       */
+func TestStripSerializeRoundTrip(t *testing.T) {
+    strips, _ := stripAndSegmentCanvases(t, saddle, WithGrid(5, 4))
+
+    restored, err := DeserializeCanvas(strips.Serialize())
+
+    if err != nil {
+        t.Fatalf("DeserializeCanvas: %v", err)
+    }
+
+    if !restored.LineStrips {
+        t.Errorf("the restored canvas does not use line strips")
+    }
+
+    if !reflect.DeepEqual(restored.Indices, strips.Indices) {
+        t.Errorf("the restored strips differ")
+    }
+}
+/*  End of TestStripSerializeRoundTrip.                                       */
+
+/*  Merging keeps the strips of the two canvases apart, and refuses to mix    *
+ *  strips with segments.                                                     */
+func TestStripMergeCanvases(t *testing.T) {
+    a, segments := stripAndSegmentCanvases(t, saddle, WithGrid(4, 3))
+    b, _ := stripAndSegmentCanvases(t, paraboloid, WithGrid(3, 3))
+    var dst *Canvas = newTestCanvas(t)
+
+    if err := MergeCanvases(dst, a, b); err != nil {
+        t.Fatalf("MergeCanvases: %v", err)
+    }
+
+    if !dst.LineStrips {
+        t.Errorf("the merged canvas does not use line strips")
+    }
+
+    if got, want := dst.SegmentCount(), a.SegmentCount() + b.SegmentCount();
+       got != want {
+        t.Errorf("%d merged segments, want %d", got, want)
+    }
+
+    if got := ConnectedComponents(dst); got != 2 {
+        t.Errorf("%d connected components, want 2", got)
+    }
+
+    if err := MergeCanvases(dst, a, segments);
+       !errors.Is(err, ErrIncompatibleFormat) {
+        t.Errorf("mixed formats: got %v, want ErrIncompatibleFormat", err)
+    }
+}
+/*  End of TestStripMergeCanvases.                                            */
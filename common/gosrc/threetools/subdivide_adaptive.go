@@ -0,0 +1,317 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Refines the cells of a grid where a surface needs more detail.        *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the key of the grid edge starting at the vertex (x, y). The edge  *
+ *  goes to the right, to (x + 1, y), if horizontal, and up otherwise. Keys   *
+ *  number the grid row by row for either layout, they are not vertices.      */
+func gridEdgeKey(canvas *Canvas,
+                 xIndex, yIndex uint32, horizontal bool) uint32 {
+    var key uint32 = 2 * (yIndex * canvas.NxPts + xIndex)
+
+    if horizontal {
+        return key
+    }
+
+    return key + 1
+}
+/*  End of gridEdgeKey.                                                       */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SubdivideAdaptive                                                     *
+ *  Purpose:                                                                  *
+ *      Splits the cells of the grid whose estimate exceeds a threshold into  *
+ *      four, adding a vertex at the middle of each of their edges and one at *
+ *      their center, and leaves the other cells alone. Midpoints are shared  *
+ *      by neighboring refined cells. Where a refined cell meets an unrefined *
+ *      one there is a T-junction, the unrefined cell is stitched to the new  *
+ *      midpoint: its edge is drawn as two segments, and its triangles are    *
+ *      fanned from the midpoint, so the mesh has no cracks. The new vertices *
+ *      are evaluated on the stored surface if there is one, otherwise they   *
+ *      are interpolated from the corners. They are stored after the vertices *
+ *      of the grid, so the grid vertices keep their indices. The line        *
+ *      segments are those of the square wireframe plus the new edges, and    *
+ *      the faces are rebuilt with the same winding as GenerateTriangleFaces. *
+ *      The result is no longer a grid, like ImportOBJ the canvas is treated  *
+ *      as a single row of vertices and the other per-vertex buffers are      *
+ *      cleared.                                                              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being refined, with a rectangular grid.                *
+ *      estimate (CellEstimate):                                              *
+ *          The refinement indicator for each cell.                           *
+ *      threshold (float32):                                                  *
+ *          Cells with a larger estimate are refined.                         *
+ *  Output:                                                                   *
+ *      added (int):                                                          *
+ *          The number of vertices that were added.                           *
+ *      err (error):                                                          *
+ *          ErrGridTooSmall, or ErrBufferTooSmall if the refined mesh does    *
+ *          not fit in the storage.                                           *
+ ******************************************************************************/
+func (self *Canvas) SubdivideAdaptive(estimate CellEstimate,
+                                      threshold float32) (int, error) {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  The grid dimensions, and the number of cells along each axis.         */
+    var width, height uint32 = self.NxPts, self.NyPts
+    var cellsX, cellsY uint32
+
+    if (width < 2) || (height < 2) {
+        return 0, ErrGridTooSmall
+    }
+
+    cellsX, cellsY = width - 1, height - 1
+
+    /*  Which cells are refined, decided before anything is modified.         */
+    var refined []bool = make([]bool, cellsX * cellsY)
+
+    /*  The index of the new vertex at the middle of each split edge, and of  *
+     *  the new vertex at the center of each refined cell.                    */
+    var midpoints map[uint32]uint32 = make(map[uint32]uint32)
+    var centers map[uint32]uint32 = make(map[uint32]uint32)
+
+    /*  The new vertices are numbered after those of the grid.                */
+    var numberOfPoints uint32 = width * height
+
+    /*  Records a midpoint on an edge, unless a neighbor already added it.    */
+    var addMidpoint = func(key uint32) {
+        if _, found := midpoints[key]; !found {
+            midpoints[key] = numberOfPoints
+            numberOfPoints++
+        }
+    }
+
+    for yIndex = 0; yIndex < cellsY; yIndex++ {
+        for xIndex = 0; xIndex < cellsX; xIndex++ {
+            var cell uint32 = yIndex * cellsX + xIndex
+
+            if estimate(self, xIndex, yIndex) <= threshold {
+                continue
+            }
+
+            refined[cell] = true
+            addMidpoint(gridEdgeKey(self, xIndex, yIndex, true))
+            addMidpoint(gridEdgeKey(self, xIndex + 1, yIndex, false))
+            addMidpoint(gridEdgeKey(self, xIndex, yIndex + 1, true))
+            addMidpoint(gridEdgeKey(self, xIndex, yIndex, false))
+            centers[cell] = numberOfPoints
+            numberOfPoints++
+        }
+    }
+
+    /*  Every edge of the grid is one or two segments, and each refined cell  *
+     *  adds four segments from its center to the midpoints of its edges.     */
+    var edges int = int(cellsX * height + cellsY * width)
+    var indexSize int = 2 * (edges + len(midpoints) + 4 * len(centers))
+
+    if (3 * int(numberOfPoints) > len(self.MeshStorage)) ||
+       (indexSize > len(self.IndexStorage)) {
+        return 0, ErrBufferTooSmall
+    }
+
+    var vertices []float32 = self.MeshStorage[0:3 * numberOfPoints]
+    var gridSize uint32 = width * height
+
+    /*  Step sizes in the horizontal and vertical axes, for the surface.      */
+    var dx float32 = self.Width / float32(cellsX)
+    var dy float32 = self.Height / float32(cellsY)
+
+    /*  Writes the new vertex at the grid coordinates (u, v), which are half  *
+     *  integers, averaging the given grid vertices if there is no surface.   */
+    var setVertex = func(vertex uint32, u, v float32, corners ...uint32) {
+        var point [3]float32
+
+        if self.Surface != nil {
+            point[0] = self.HorizontalStart + u * dx
+            point[1] = self.VerticalStart + v * dy
+            point[2] = self.Surface(point[0], point[1])
+        } else {
+            for _, corner := range corners {
+                var p [3]float32 = self.point(int(corner))
+                point[0] += p[0] / float32(len(corners))
+                point[1] += p[1] / float32(len(corners))
+                point[2] += p[2] / float32(len(corners))
+            }
+        }
+
+        copy(vertices[3 * vertex:], point[:])
+    }
+
+    for key, vertex := range midpoints {
+        var x, y uint32 = (key / 2) % width, (key / 2) / width
+        var start uint32 = self.gridIndex(x, y)
+
+        if key % 2 == 0 {
+            setVertex(vertex, float32(x) + 0.5, float32(y),
+                      start, self.gridIndex(x + 1, y))
+        } else {
+            setVertex(vertex, float32(x), float32(y) + 0.5,
+                      start, self.gridIndex(x, y + 1))
+        }
+    }
+
+    for cell, vertex := range centers {
+        var x, y uint32 = cell % cellsX, cell / cellsX
+        var corners [4]uint32 = cellCorners(self, x, y)
+        setVertex(
+            vertex, float32(x) + 0.5, float32(y) + 0.5,
+            corners[0], corners[1], corners[2], corners[3],
+        )
+    }
+
+    /*  The line segments. Each edge of the grid is split at its midpoint if  *
+     *  it has one, and the refined cells add the lines through their center. */
+    var indices []uint32 = self.IndexStorage[0:0]
+
+    var addEdge = func(a, b, key uint32) {
+        if middle, found := midpoints[key]; found {
+            indices = append(indices, a, middle, middle, b)
+        } else {
+            indices = append(indices, a, b)
+        }
+    }
+
+    for yIndex = 0; yIndex < height; yIndex++ {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var index00 uint32 = self.gridIndex(xIndex, yIndex)
+
+            if xIndex < cellsX {
+                var key uint32 = gridEdgeKey(self, xIndex, yIndex, true)
+                addEdge(index00, self.gridIndex(xIndex + 1, yIndex), key)
+            }
+
+            if yIndex < cellsY {
+                var key uint32 = gridEdgeKey(self, xIndex, yIndex, false)
+                addEdge(index00, self.gridIndex(xIndex, yIndex + 1), key)
+            }
+        }
+    }
+
+    /*  The faces, rebuilt for every cell. The new midpoints of the edges of  *
+     *  a cell, in counterclockwise order: bottom, right, top, and left.      */
+    var faces []uint32 = self.Faces[:0]
+    var reversed bool = self.domainOrientation() < 0.0
+
+    var addTriangle = func(a, b, c uint32) {
+        if reversed {
+            b, c = c, b
+        }
+
+        faces = append(faces, a, b, c)
+    }
+
+    for yIndex = 0; yIndex < cellsY; yIndex++ {
+        for xIndex = 0; xIndex < cellsX; xIndex++ {
+            var cell uint32 = yIndex * cellsX + xIndex
+            var corners [4]uint32 = cellCorners(self, xIndex, yIndex)
+            var keys [4]uint32 = [4]uint32{
+                gridEdgeKey(self, xIndex, yIndex, true),
+                gridEdgeKey(self, xIndex + 1, yIndex, false),
+                gridEdgeKey(self, xIndex, yIndex + 1, true),
+                gridEdgeKey(self, xIndex, yIndex, false),
+            }
+
+            /*  The corners of the cell and any midpoints on its edges, in    *
+             *  counterclockwise order, and the position of the first         *
+             *  midpoint.                                                     */
+            var polygon []uint32 = make([]uint32, 0, 8)
+            var apex int = -1
+
+            for side := 0; side < 4; side++ {
+                polygon = append(polygon, corners[side])
+
+                if middle, found := midpoints[keys[side]]; found {
+                    if apex < 0 {
+                        apex = len(polygon)
+                    }
+
+                    polygon = append(polygon, middle)
+                }
+            }
+
+            if refined[cell] {
+
+                /*  Four smaller cells around the center. The polygon has all *
+                 *  four midpoints, so the corners are at the even positions. */
+                var center uint32 = centers[cell]
+
+                for side := 0; side < 8; side += 2 {
+                    var before uint32 = polygon[(side + 7) % 8]
+                    var after uint32 = polygon[side + 1]
+                    addTriangle(center, before, polygon[side])
+                    addTriangle(center, polygon[side], after)
+                }
+            } else if apex < 0 {
+
+                /*  An ordinary cell, split as in GenerateTriangleFaces.      */
+                addTriangle(corners[0], corners[1], corners[2])
+                addTriangle(corners[0], corners[2], corners[3])
+            } else {
+
+                /*  A cell with T-junctions. Fanning from a midpoint never    *
+                 *  creates a triangle with three points on one edge.         */
+                var count int = len(polygon)
+
+                for step := 1; step + 1 < count; step++ {
+                    addTriangle(
+                        polygon[apex],
+                        polygon[(apex + step) % count],
+                        polygon[(apex + step + 1) % count],
+                    )
+                }
+            }
+        }
+    }
+
+    /*  Lines through the centers of the refined cells.                       */
+    for cell, center := range centers {
+        var x, y uint32 = cell % cellsX, cell / cellsX
+
+        indices = append(
+            indices,
+            center, midpoints[gridEdgeKey(self, x, y, true)],
+            center, midpoints[gridEdgeKey(self, x + 1, y, false)],
+            center, midpoints[gridEdgeKey(self, x, y + 1, true)],
+            center, midpoints[gridEdgeKey(self, x, y, false)],
+        )
+    }
+
+    /*  Everything fits, update the sizes. The grid vertices are unchanged.   */
+    self.clearDerivedBuffers()
+    self.Faces = faces
+    self.NumberOfPoints = int(numberOfPoints)
+    self.MeshSize = 3 * self.NumberOfPoints
+    self.IndexSize = len(indices)
+    self.NxPts = numberOfPoints
+    self.NyPts = 1
+    self.Mesh = vertices
+    self.Indices = indices
+    return int(numberOfPoints - gridSize), nil
+}
+/*  End of SubdivideAdaptive.                                                 */
@@ -0,0 +1,84 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the adaptive subdivision of steep cells.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Abs is provided by math, used for locating the new vertices.              */
+import (
+    "math"
+    "testing"
+)
+
+/*  On the paraboloid the corners of a cell differ by at least 0.625 near the *
+ *  rim, and by at most 0.375 within the square |x|, |y| < 0.5. The new       *
+ *  vertices all lie outside this square, some of them on the rim cells, and  *
+ *  the original grid vertices keep their positions.                          */
+func TestSubdivideAdaptiveParaboloidRim(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(9, 9))
+    var original *Canvas = canvas.Clone()
+    var gridSize int = canvas.NumberOfPoints
+
+    added, err := canvas.SubdivideAdaptive(CellHeightChange, 0.4)
+
+    if err != nil {
+        t.Fatalf("SubdivideAdaptive: %v", err)
+    }
+
+    if (added == 0) || (canvas.NumberOfPoints != gridSize + added) {
+        t.Fatalf("added %d vertices, NumberOfPoints %d, grid %d",
+                 added, canvas.NumberOfPoints, gridSize)
+    }
+
+    for index := 0; index < gridSize; index++ {
+        if canvas.point(index) != original.point(index) {
+            t.Fatalf("grid vertex %d moved from %v to %v", index,
+                     original.point(index), canvas.point(index))
+        }
+    }
+
+    /*  Set if a new vertex lies in one of the rim cells.                     */
+    var onRim bool = false
+
+    for index := gridSize; index < canvas.NumberOfPoints; index++ {
+        var p [3]float32 = canvas.point(index)
+        var distance float64 = math.Max(
+            math.Abs(float64(p[0])), math.Abs(float64(p[1])),
+        )
+
+        if distance < 0.5 {
+            t.Errorf("new vertex %d at %v is in the flat center", index, p)
+        }
+
+        if !closeTo(p[2], paraboloid(p[0], p[1]), 1.0E-6) {
+            t.Errorf("new vertex %d at %v is not on the surface", index, p)
+        }
+
+        onRim = onRim || (distance > 0.75)
+    }
+
+    if !onRim {
+        t.Error("no vertices were added near the rim")
+    }
+}
+/*  End of TestSubdivideAdaptiveParaboloidRim.                                */
@@ -0,0 +1,135 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Refines the grid of a canvas by one level.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Standard library package for formatting the error messages.               */
+import "fmt"
+
+/*  Returns the number of points along an axis after one subdivision, with a  *
+ *  new point between each pair of neighbors. A single point stays single.    */
+func subdividedCount(count uint32) uint32 {
+    if count < 2 {
+        return count
+    }
+
+    return 2 * count - 1
+}
+/*  End of subdividedCount.                                                   */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SubdivideOnce                                                         *
+ *  Purpose:                                                                  *
+ *      Refines the grid of a canvas with one step of bilinear subdivision,   *
+ *      so a coarse mesh may be drawn and then refined. A new vertex is       *
+ *      placed at the midpoint of each edge of the grid and at the center of  *
+ *      each cell, the mean of its corners, and the old vertices keep their   *
+ *      places, giving a (2 NxPts - 1) by (2 NyPts - 1) grid. The new points  *
+ *      lie on the bilinear patches through the old ones, the surface is not  *
+ *      smoothed. The cells across closed seams, see WrapU, are not split.    *
+ *      The storage is grown if needed, the per-vertex buffers computed from  *
+ *      the old mesh are cleared, and the wireframe is rebuilt.               *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas being refined.                                         *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrUnsupportedGrid if the mesh is not a grid, ErrGridTooLarge if  *
+ *          the refined grid does not fit, or an error from rebuilding the    *
+ *          wireframe.                                                        *
+ ******************************************************************************/
+func SubdivideOnce(canvas *Canvas) error {
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    var nxPts uint32 = subdividedCount(canvas.NxPts)
+    var nyPts uint32 = subdividedCount(canvas.NyPts)
+
+    if uint64(canvas.NxPts) * uint64(canvas.NyPts) !=
+       uint64(canvas.NumberOfPoints) {
+        return fmt.Errorf(
+            "%w: %d vertices in a %dx%d grid",
+            ErrUnsupportedGrid, canvas.NumberOfPoints,
+            canvas.NxPts, canvas.NyPts,
+        )
+    }
+
+    if err := checkGrid(nxPts, nyPts, canvas.MeshType); err != nil {
+        return fmt.Errorf("%w: refined grid is %dx%d", err, nxPts, nyPts)
+    }
+
+    if err := canvas.reserveGrid(nxPts, nyPts); err != nil {
+        return err
+    }
+
+    /*  The old grid is read from a copy, in the layout of the canvas.        */
+    var coarse Canvas = Canvas{
+        Mesh: cloneBuffer(canvas.Mesh),
+        NxPts: canvas.NxPts,
+        NyPts: canvas.NyPts,
+        LayoutColumnMajor: canvas.LayoutColumnMajor,
+    }
+
+    /*  The surface is still valid, only the resolution has changed.          */
+    var surface SurfaceParametrization = canvas.Surface
+    canvas.clearDerivedBuffers()
+    canvas.Surface = surface
+
+    canvas.NxPts = nxPts
+    canvas.NyPts = nyPts
+
+    if err := canvas.Recompute(canvas.MeshStorage,
+                               canvas.IndexStorage); err != nil {
+        return err
+    }
+
+    /*  A new vertex is the mean of the old vertices around it. Old vertices  *
+     *  are counted four times and edge midpoints twice, so the same formula  *
+     *  covers every case.                                                    */
+    for yIndex = 0; yIndex < nyPts; yIndex++ {
+        var y0 uint32 = yIndex / 2
+        var y1 uint32 = (yIndex + 1) / 2
+
+        for xIndex = 0; xIndex < nxPts; xIndex++ {
+            var x0 uint32 = xIndex / 2
+            var x1 uint32 = (xIndex + 1) / 2
+
+            var sum [3]float32 = vectorSum(
+                vectorSum(coarse.point(int(coarse.gridIndex(x0, y0))),
+                          coarse.point(int(coarse.gridIndex(x1, y0)))),
+                vectorSum(coarse.point(int(coarse.gridIndex(x0, y1))),
+                          coarse.point(int(coarse.gridIndex(x1, y1)))),
+            )
+
+            var index uint32 = 3 * canvas.gridIndex(xIndex, yIndex)
+            var point [3]float32 = scaleVector(sum, 0.25)
+            copy(canvas.Mesh[index:index + 3], point[:])
+        }
+    }
+
+    return canvas.GenerateRectangularWireframe()
+}
+/*  End of SubdivideOnce.                                                     */
@@ -0,0 +1,90 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for one step of bilinear grid subdivision.                      *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, the error may be wrapped.             */
+import (
+    "errors"
+    "testing"
+)
+
+/*  A 3x3 grid is refined to 5x5. The old vertices keep their places, each    *
+ *  new vertex on an edge is the midpoint of its two parents, and each new    *
+ *  vertex in a cell is the mean of the four corners of the cell.             */
+func TestSubdivideOnceThreeByThree(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(
+        t, saddle, WithGrid(3, 3), WithDomain(3.0, 4.0, -1.0, -2.0),
+    )
+
+    var coarse *Canvas = canvas.Clone()
+
+    /*  Returns the vertex of the old grid in column x and row y.             */
+    var parent = func(x, y uint32) [3]float32 {
+        return coarse.point(int(coarse.gridIndex(x, y)))
+    }
+
+    if err := SubdivideOnce(canvas); err != nil {
+        t.Fatalf("SubdivideOnce: %v", err)
+    }
+
+    if (canvas.NxPts != 5) || (canvas.NyPts != 5) ||
+       (canvas.NumberOfPoints != 25) {
+        t.Fatalf("refined grid is %dx%d with %d points, want 5x5",
+                 canvas.NxPts, canvas.NyPts, canvas.NumberOfPoints)
+    }
+
+    for yIndex := uint32(0); yIndex < 5; yIndex++ {
+        for xIndex := uint32(0); xIndex < 5; xIndex++ {
+            var x0, x1 uint32 = xIndex / 2, (xIndex + 1) / 2
+            var y0, y1 uint32 = yIndex / 2, (yIndex + 1) / 2
+
+            var want [3]float32 = scaleVector(
+                vectorSum(vectorSum(parent(x0, y0), parent(x1, y0)),
+                          vectorSum(parent(x0, y1), parent(x1, y1))),
+                0.25,
+            )
+
+            var index int = int(canvas.gridIndex(xIndex, yIndex))
+            expectVector(t, "refined vertex", canvas.point(index), want,
+                         1.0E-6)
+        }
+    }
+}
+/*  End of TestSubdivideOnceThreeByThree.                                     */
+
+/*  A grid whose refinement does not fit in MaxWidth is left as it is.        */
+func TestSubdivideOnceTooLarge(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(300, 3))
+    var err error = SubdivideOnce(canvas)
+
+    if !errors.Is(err, ErrGridTooLarge) {
+        t.Fatalf("SubdivideOnce: %v, want ErrGridTooLarge", err)
+    }
+
+    if (canvas.NxPts != 300) || (canvas.NyPts != 3) {
+        t.Errorf("grid changed to %dx%d", canvas.NxPts, canvas.NyPts)
+    }
+}
+/*  End of TestSubdivideOnceTooLarge.                                         */
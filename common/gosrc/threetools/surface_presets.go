@@ -0,0 +1,167 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Registry of the built-in surfaces.                                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Pi and Round are provided here, used for the domains and mode numbers.    */
+import "math"
+
+/*  Creates the built-in presets, keyed by name. New surfaces are added here. */
+func defaultSurfacePresets() map[string]SurfacePreset {
+    return map[string]SurfacePreset{
+        "bessel_ripple": {
+            Family: func(c map[string]float32) SurfaceParametrization {
+                return BesselRipple(c["k"])
+            },
+            Coefficients: map[string]float32{"k": 3.0},
+            HorizontalStart: -4.0,
+            Width: 8.0,
+            VerticalStart: -4.0,
+            Height: 8.0,
+            MeshType: SquareWireframe,
+        },
+        "catenoid": {
+            Parametric: Catenoid,
+            HorizontalStart: 0.0,
+            Width: 2.0 * math.Pi,
+            VerticalStart: -1.5,
+            Height: 3.0,
+            MeshType: SquareWireframe,
+        },
+        "damped_wave": {
+            Time: DampedWave(0.3, 0.5, 6.0, 4.0),
+            HorizontalStart: -4.0,
+            Width: 8.0,
+            VerticalStart: -4.0,
+            Height: 8.0,
+            MeshType: SquareWireframe,
+        },
+        "interference": {
+            Family: func(c map[string]float32) SurfaceParametrization {
+                var source1 [2]float32 = [2]float32{c["x1"], c["y1"]}
+                var source2 [2]float32 = [2]float32{c["x2"], c["y2"]}
+                return Interference(source1, source2, c["k"])
+            },
+            Coefficients: map[string]float32{
+                "k": 8.0, "x1": -0.5, "y1": 0.0, "x2": 0.5, "y2": 0.0,
+            },
+            HorizontalStart: -2.0,
+            Width: 4.0,
+            VerticalStart: -2.0,
+            Height: 4.0,
+            MeshType: SquareWireframe,
+        },
+        "plane": {
+            Graph: Plane,
+            HorizontalStart: -1.0,
+            Width: 2.0,
+            VerticalStart: -1.0,
+            Height: 2.0,
+            MeshType: SquareWireframe,
+        },
+        "seashell": {
+            Parametric: Seashell(DefaultSeashell),
+            HorizontalStart: 0.0,
+            Width: 2.0 * math.Pi * DefaultSeashell.Turns,
+            VerticalStart: 0.0,
+            Height: 2.0 * math.Pi,
+            MeshType: SquareWireframe,
+        },
+        "spherical_harmonic": {
+            Parametric: SphericalHarmonic(3, 2),
+            HorizontalStart: 0.0,
+            Width: 2.0 * math.Pi,
+            VerticalStart: 0.0,
+            Height: math.Pi,
+            MeshType: SquareWireframe,
+        },
+        "drum_mode": {
+            TimeFamily: func(c map[string]float32) TimeSurface {
+                var m int = int(math.Round(float64(c["m"])))
+                var n int = int(math.Round(float64(c["n"])))
+                return DrumMode(m, n, 2.0, 2.0, c["c"])
+            },
+            Coefficients: map[string]float32{"m": 2.0, "n": 3.0, "c": 1.0},
+            HorizontalStart: -1.0,
+            Width: 2.0,
+            VerticalStart: -1.0,
+            Height: 2.0,
+            MeshType: SquareWireframe,
+        },
+        "elliptic_paraboloid": {
+            Family: func(c map[string]float32) SurfaceParametrization {
+                var a float32 = c["a"]
+
+                return func(x, y float32) float32 {
+                    return x*x + a * y*y
+                }
+            },
+            Coefficients: map[string]float32{"a": 2.0},
+            HorizontalStart: -1.0,
+            Width: 2.0,
+            VerticalStart: -1.0,
+            Height: 2.0,
+            MeshType: SquareWireframe,
+        },
+        "gaussian": {
+            Family: func(c map[string]float32) SurfaceParametrization {
+                return GaussianBump(c["amplitude"], c["sigma"])
+            },
+            Coefficients: map[string]float32{"amplitude": 1.0, "sigma": 0.5},
+            HorizontalStart: -2.0,
+            Width: 4.0,
+            VerticalStart: -2.0,
+            Height: 4.0,
+            MeshType: SquareWireframe,
+        },
+        "helicoid": {
+            Parametric: Helicoid(0.25),
+            HorizontalStart: 0.0,
+            Width: 4.0 * math.Pi,
+            VerticalStart: -2.0,
+            Height: 4.0,
+            MeshType: SquareWireframe,
+        },
+    }
+}
+/*  End of defaultSurfacePresets.                                             */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      RegisterSurface                                                       *
+ *  Purpose:                                                                  *
+ *      Adds a surface to the registry, or replaces the surface with the same *
+ *      name, so it may be drawn with GenerateSurfacePreset.                  *
+ *  Arguments:                                                                *
+ *      name (string):                                                        *
+ *          The name of the surface.                                          *
+ *      preset (SurfacePreset):                                               *
+ *          The surface and its default domain.                               *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func RegisterSurface(name string, preset SurfacePreset) {
+    SurfacePresets[name] = preset
+}
+/*  End of RegisterSurface.                                                   */
@@ -0,0 +1,62 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Swaps the front and back mesh buffers of a canvas.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      SwapBuffers                                                           *
+ *  Purpose:                                                                  *
+ *      Presents the finished mesh to JavaScript. The mesh Go has been        *
+ *      writing to becomes the front buffer, which JavaScript reads, and the  *
+ *      old front buffer becomes the new back buffer. Since many routines     *
+ *      update the mesh in place, like RotateMesh, the finished mesh is       *
+ *      copied into the new back buffer so the next frame continues from it.  *
+ *      The front storage is allocated the first time this is called, with    *
+ *      the size of the mesh storage. For the main canvas the global          *
+ *      MeshBuffer is moved to the new back buffer, so MeshBufferAddress      *
+ *      changes with every swap. The caller should hold CanvasLock, so the    *
+ *      swap is atomic.                                                       *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose buffers are being swapped.                       *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) SwapBuffers() {
+    if len(self.FrontStorage) != len(self.MeshStorage) {
+        self.FrontStorage = make([]float32, len(self.MeshStorage))
+    }
+
+    self.MeshStorage, self.FrontStorage = self.FrontStorage, self.MeshStorage
+    self.FrontMesh = self.FrontStorage[0:self.MeshSize]
+    self.Mesh = self.MeshStorage[0:self.MeshSize]
+    copy(self.Mesh, self.FrontMesh)
+
+    /*  JavaScript finds the main canvas through the global buffers.          */
+    if self == &MainCanvas {
+        MeshBuffer = self.MeshStorage
+    }
+}
+/*  End of SwapBuffers.                                                       */
@@ -0,0 +1,116 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the double-buffered mesh.                                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Checks that the front buffer holds exactly the given mesh.                */
+func expectFrontMesh(t *testing.T, canvas *Canvas, want []float32) {
+    t.Helper()
+
+    if len(canvas.FrontMesh) != len(want) {
+        t.Fatalf("front mesh has %d floats, want %d",
+                 len(canvas.FrontMesh), len(want))
+    }
+
+    for index := range want {
+        if canvas.FrontMesh[index] != want[index] {
+            t.Fatalf("front mesh entry %d is %g, want %g",
+                     index, canvas.FrontMesh[index], want[index])
+        }
+    }
+}
+/*  End of expectFrontMesh.                                                   */
+
+/*  After a write and a swap the front buffer holds the finished mesh. The    *
+ *  next write goes to the back buffer, leaving the front one untouched until *
+ *  the following swap, and the back buffer continues from the finished mesh. */
+func TestSwapBuffersWriteThenSwap(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+
+    /*  The first frame.                                                      */
+    canvas.RotateMesh(UnitVectorFromAngle(0.3))
+    var first []float32 = append([]float32(nil), canvas.Mesh...)
+    canvas.SwapBuffers()
+
+    expectFrontMesh(t, canvas, first)
+
+    if canvas.FrontMeshAddress() != SliceAddress(canvas.FrontMesh) {
+        t.Error("FrontMeshAddress is not the address of the front mesh")
+    }
+
+    if SliceAddress(canvas.FrontMesh) == SliceAddress(canvas.Mesh) {
+        t.Fatal("the front and back buffers are the same memory")
+    }
+
+    /*  The back buffer starts from the finished frame.                       */
+    for index := range first {
+        if canvas.Mesh[index] != first[index] {
+            t.Fatalf("back mesh entry %d is %g, want %g",
+                     index, canvas.Mesh[index], first[index])
+        }
+    }
+
+    /*  Writing the second frame does not tear the first one.                 */
+    canvas.RotateMesh(UnitVectorFromAngle(0.3))
+    var second []float32 = append([]float32(nil), canvas.Mesh...)
+    expectFrontMesh(t, canvas, first)
+
+    canvas.SwapBuffers()
+    expectFrontMesh(t, canvas, second)
+}
+/*  End of TestSwapBuffersWriteThenSwap.                                      */
+
+/*  Swapping the buffers of the main canvas moves the global MeshBuffer to    *
+ *  the new back buffer, so the address JavaScript reads it from changes.     */
+func TestSwapBuffersMovesMeshBuffer(t *testing.T) {
+    preserveMainCanvas(t)
+
+    var config CanvasConfig = NewCanvasConfig(WithGrid(5, 4))
+
+    if err := MainCanvas.Configure(config); err != nil {
+        t.Fatalf("Configure: %v", err)
+    }
+
+    var err error = MainCanvas.GenerateMeshFromParametrization(paraboloid)
+
+    if err != nil {
+        t.Fatalf("GenerateMeshFromParametrization: %v", err)
+    }
+
+    for swap := 0; swap < 2; swap++ {
+        var address uintptr = MeshBufferAddress()
+        MainCanvas.SwapBuffers()
+
+        if MeshBufferAddress() == address {
+            t.Fatalf("swap %d: MeshBufferAddress did not change", swap)
+        }
+
+        if MeshBufferAddress() != SliceAddress(MainCanvas.MeshStorage) {
+            t.Fatalf("swap %d: MeshBufferAddress is not the back buffer", swap)
+        }
+    }
+}
+/*  End of TestSwapBuffersMovesMeshBuffer.                                    */
@@ -0,0 +1,150 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the eigenvalues and eigenvectors of a symmetric 3x3 matrix.  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sqrt and Abs are provided here, used for the Jacobi rotations.            */
+import "math"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      symmetricEigen                                                        *
+ *  Purpose:                                                                  *
+ *      Diagonalizes a real symmetric 3x3 matrix using the cyclic Jacobi      *
+ *      method. Each step applies a plane rotation that zeroes one off-       *
+ *      diagonal entry, and the sweeps are repeated until the off-diagonal    *
+ *      part vanishes. The eigenvalues are returned in decreasing order with  *
+ *      the eigenvectors in the same order. The eigenvectors form a right-    *
+ *      handed orthonormal frame.                                             *
+ *  Arguments:                                                                *
+ *      matrix ([3][3]float64):                                               *
+ *          The symmetric matrix being diagonalized.                          *
+ *  Output:                                                                   *
+ *      values ([3]float64):                                                  *
+ *          The eigenvalues, largest first.                                   *
+ *      vectors ([3][3]float64):                                              *
+ *          The unit eigenvectors, vectors[n] goes with values[n].            *
+ ******************************************************************************/
+func symmetricEigen(matrix [3][3]float64) ([3]float64, [3][3]float64) {
+
+    /*  Variables for indexing over the sweeps and the matrix entries.        */
+    var sweep, p, q, k int
+
+    /*  The accumulated rotations, the columns are the eigenvectors.          */
+    var rotation [3][3]float64 = [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+    /*  The output, eigenvalues and eigenvectors, both sorted.                */
+    var values [3]float64
+    var vectors [3][3]float64
+    var order [3]int = [3]int{0, 1, 2}
+
+    /*  The Jacobi method converges quadratically, a handful of sweeps is     *
+     *  enough for a 3x3 matrix. The limit guards against NaN inputs.         */
+    for sweep = 0; sweep < 50; sweep++ {
+
+        var offDiagonal float64 = math.Abs(matrix[0][1]) +
+                                  math.Abs(matrix[0][2]) +
+                                  math.Abs(matrix[1][2])
+
+        if offDiagonal < 1.0E-15 {
+            break
+        }
+
+        /*  Zero out each of the off-diagonal entries in turn.                */
+        for p = 0; p < 2; p++ {
+            for q = p + 1; q < 3; q++ {
+
+                if matrix[p][q] == 0.0 {
+                    continue
+                }
+
+                /*  Compute the rotation angle from the standard formula,     *
+                 *  choosing the smaller root for numerical stability.        */
+                var theta float64 = 0.5 * (matrix[q][q] - matrix[p][p]) /
+                                    matrix[p][q]
+                var t float64 = 1.0 / (math.Abs(theta) +
+                                       math.Sqrt(theta * theta + 1.0))
+
+                if theta < 0.0 {
+                    t = -t
+                }
+
+                var c float64 = 1.0 / math.Sqrt(t * t + 1.0)
+                var s float64 = t * c
+
+                /*  Apply the rotation to the rows and columns p and q.       */
+                for k = 0; k < 3; k++ {
+                    var mkp float64 = matrix[k][p]
+                    var mkq float64 = matrix[k][q]
+                    matrix[k][p] = c * mkp - s * mkq
+                    matrix[k][q] = s * mkp + c * mkq
+                }
+
+                for k = 0; k < 3; k++ {
+                    var mpk float64 = matrix[p][k]
+                    var mqk float64 = matrix[q][k]
+                    matrix[p][k] = c * mpk - s * mqk
+                    matrix[q][k] = s * mpk + c * mqk
+                }
+
+                /*  Accumulate the rotation for the eigenvectors.             */
+                for k = 0; k < 3; k++ {
+                    var rkp float64 = rotation[k][p]
+                    var rkq float64 = rotation[k][q]
+                    rotation[k][p] = c * rkp - s * rkq
+                    rotation[k][q] = s * rkp + c * rkq
+                }
+            }
+        }
+    }
+
+    /*  Sort the eigenvalues in decreasing order, three elements only.        */
+    for p = 0; p < 2; p++ {
+        for q = p + 1; q < 3; q++ {
+            if matrix[order[q]][order[q]] > matrix[order[p]][order[p]] {
+                order[p], order[q] = order[q], order[p]
+            }
+        }
+    }
+
+    for p = 0; p < 3; p++ {
+        values[p] = matrix[order[p]][order[p]]
+
+        for k = 0; k < 3; k++ {
+            vectors[p][k] = rotation[k][order[p]]
+        }
+    }
+
+    /*  Make the frame right-handed by setting the last vector to the cross   *
+     *  product of the first two. This only changes its sign, if anything.    */
+    var u, v [3]float64 = vectors[0], vectors[1]
+    vectors[2] = [3]float64{
+        u[1] * v[2] - u[2] * v[1],
+        u[2] * v[0] - u[0] * v[2],
+        u[0] * v[1] - u[1] * v[0],
+    }
+
+    return values, vectors
+}
+/*  End of symmetricEigen.                                                    */
@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides the triangle faces of a canvas for picking.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      TrianglePickBuffer                                                    *
+ *  Purpose:                                                                  *
+ *      Returns the triangle faces of the canvas, three vertex indices per    *
+ *      triangle, for ray picking on the front end. This is separate from the *
+ *      index buffer, which holds the wireframe that is drawn. The faces are  *
+ *      generated from the grid with GenerateTriangleFaces if the face buffer *
+ *      is empty, or if its size does not match the cells of the grid because *
+ *      the grid changed since the faces were made. Faces of meshes that are  *
+ *      not grids, like those from GenerateRegionBetween, are kept.           *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose faces are being returned.                        *
+ *  Output:                                                                   *
+ *      faces ([]uint32):                                                     *
+ *          The face buffer of the canvas.                                    *
+ ******************************************************************************/
+func (self *Canvas) TrianglePickBuffer() []uint32 {
+
+    /*  Two triangles, six indices, for each cell of the grid.                */
+    cellsX, cellsY := self.gridCells()
+    var expected int = int(6 * cellsX * cellsY)
+    var stale bool = (expected > 0) && (len(self.Faces) != expected)
+
+    if (len(self.Faces) == 0) || stale {
+        self.GenerateTriangleFaces()
+    }
+
+    return self.Faces
+}
+/*  End of TrianglePickBuffer.                                                */
@@ -0,0 +1,66 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the triangle faces returned for picking.                    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  The faces are made on the first call, two triangles per cell, and made    *
+ *  again with the new size after the grid changes.                           */
+func TestTrianglePickBufferSize(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(5, 4))
+
+    if len(canvas.Faces) != 0 {
+        t.Fatalf("%d face indices before the call, want 0", len(canvas.Faces))
+    }
+
+    var faces []uint32 = canvas.TrianglePickBuffer()
+
+    if (len(faces) != 6 * 4 * 3) || (canvas.FaceCount() != 24) {
+        t.Errorf("%d face indices and %d triangles, want 72 and 24",
+                 len(faces), canvas.FaceCount())
+    }
+
+    canvas.NxPts, canvas.NyPts = 6, 5
+
+    err := canvas.Recompute(canvas.MeshStorage, canvas.IndexStorage)
+
+    if err != nil {
+        t.Fatalf("Recompute: %v", err)
+    }
+
+    faces = canvas.TrianglePickBuffer()
+
+    if (len(faces) != 6 * 5 * 4) || (canvas.FaceCount() != 40) {
+        t.Errorf("%d face indices and %d triangles after resizing, " +
+                 "want 120 and 40", len(faces), canvas.FaceCount())
+    }
+
+    for index, vertex := range faces {
+        if int(vertex) >= canvas.NumberOfPoints {
+            t.Fatalf("face index %d refers to vertex %d", index, vertex)
+        }
+    }
+}
+/*  End of TestTrianglePickBufferSize.                                        */
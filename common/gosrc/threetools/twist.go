@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Twists a mesh about the z axis.                                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      Twist                                                                 *
+ *  Purpose:                                                                  *
+ *      Rotates each vertex about the z axis by an angle proportional to its  *
+ *      height, amount times z, producing a helical twist of the figure. The  *
+ *      plane z = 0 is fixed, and the twist turns counterclockwise above it   *
+ *      for positive amounts. The normals are not updated, compute them       *
+ *      again.                                                                *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh that is being twisted.                   *
+ *      amount (float32):                                                     *
+ *          The angle of the twist per unit height, in radians.               *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) Twist(amount float32) {
+
+    /*  Variable for indexing over the vertices of the mesh.                  */
+    var index int
+
+    if amount == 0.0 {
+        return
+    }
+
+    for index = 0; index < self.MeshSize; index += 3 {
+        var angle float32 = amount * self.Mesh[index + 2]
+        var point UnitVector = UnitVectorFromAngle(angle)
+
+        var x float32 = self.Mesh[index]
+        var y float32 = self.Mesh[index + 1]
+
+        self.Mesh[index] = point.AngleCos * x - point.AngleSin * y
+        self.Mesh[index + 1] = point.AngleCos * y + point.AngleSin * x
+    }
+
+    /*  The next rotation starts from the edited mesh.                        */
+    self.rebaseOrientation()
+}
+/*  End of Twist.                                                             */
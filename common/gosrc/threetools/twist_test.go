@@ -0,0 +1,72 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for twisting the mesh about the z axis.                         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Atan2 is provided by math, used for the angle of the twisted vertices.    */
+import (
+    "math"
+    "testing"
+)
+
+/*  Twisting a vertical strip in the plane y = 0 turns each vertex by the     *
+ *  angle amount times its height, so the top of the strip turns the most and *
+ *  the bottom, on z = 0, stays where it was.                                 */
+func TestTwistVerticalStrip(t *testing.T) {
+    const amount float32 = 0.5
+
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(3, 5), WithDomain(1.0, 2.0, 0.5, 0.0),
+    )
+
+    canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+        return [3]float32{u, 0.0, v}
+    })
+
+    canvas.Twist(amount)
+
+    var previous float32 = -1.0
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        var p [3]float32 = canvas.point(int(canvas.gridIndex(0, yIndex)))
+        var angle float32 = float32(math.Atan2(float64(p[1]), float64(p[0])))
+
+        if !closeTo(angle, amount * p[2], 1.0E-5) {
+            t.Errorf("vertex %v turned by %g, want %g",
+                     p, angle, amount * p[2])
+        }
+
+        if angle <= previous {
+            t.Errorf("vertex %v turned by %g, no more than the one below",
+                     p, angle)
+        }
+
+        previous = angle
+    }
+
+    if canvas.point(0) != [3]float32{0.5, 0.0, 0.0} {
+        t.Errorf("bottom corner moved to %v", canvas.point(0))
+    }
+}
+/*  End of TestTwistVerticalStrip.                                            */
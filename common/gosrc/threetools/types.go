@@ -27,11 +27,131 @@ package threetools
 /*  Parametrization for surfaces of the form z = f(x, y).                     */
 type SurfaceParametrization func(x, y float32) float32
 
+/*  Height of a surface over a disk in polar coordinates, z = f(r, theta).    */
+type PolarSurface func(r, theta float32) float32
+
+/*  Parametrization for surfaces of the form (x, y, z) = f(u, v).             */
+type ParametricSurface func(u, v float32) [3]float32
+
+/*  Time dependent surface z = f(x, y, t), with t in seconds.                 */
+type TimeSurface func(x, y, t float32) float32
+
+/*  A family of graphs z = f(x, y) depending on named coefficients.           */
+type SurfaceFamily func(coefficients map[string]float32) SurfaceParametrization
+
+/*  A family of time dependent surfaces depending on named coefficients.      */
+type TimeSurfaceFamily func(coefficients map[string]float32) TimeSurface
+
+/*  A registered surface, see GenerateSurfacePreset. Exactly one of Graph,    *
+ *  Parametric, Family, Time, and TimeFamily should be set. A family is drawn *
+ *  with the coefficients of the canvas, falling back to the defaults given   *
+ *  here, which also list the names of the coefficients. A time dependent     *
+ *  surface is drawn at t = 0 and animated with AnimateSurface. The domain    *
+ *  and mesh type are the defaults used when the surface is drawn, the number *
+ *  of points is chosen by the caller.                                        */
+type SurfacePreset struct {
+    Graph SurfaceParametrization
+    Parametric ParametricSurface
+    Family SurfaceFamily
+    Time TimeSurface
+    TimeFamily TimeSurfaceFamily
+    Coefficients map[string]float32
+    HorizontalStart, VerticalStart float32
+    Width, Height float32
+    MeshType uint
+}
+
+/*  Refinement indicator for a cell of the grid, the cell with the given      *
+ *  bottom left corner. See SubdivideAdaptive and CellHeightChange.           */
+type CellEstimate func(canvas *Canvas, xIndex, yIndex uint32) float32
+
+/*  Maps a value in [0, 1] to an RGB color, see ColorByRadius.                */
+type Colormap func(t float32) [3]float32
+
+/*  Easing curve for transitions, maps [0, 1] to [0, 1] with 0 and 1 fixed.   */
+type Easing func(t float32) float32
+
+/*  Complex valued function of a complex variable, w(re + i im).              */
+type ComplexFunction func(re, im float32) (float32, float32)
+
+/*  Curve in the xy plane, (x, y) = c(t), see SampleAlongCurve.               */
+type PlaneCurve func(t float32) (float32, float32)
+
+/*  Geometry used to set up a canvas, see NewCanvasConfig and Configure.      */
+type CanvasConfig struct {
+    NxPts, NyPts uint32
+    Width, Height float32
+    HorizontalStart, VerticalStart float32
+    MeshType uint
+    WireframeStride uint32
+    WireframeDirection uint
+    WrapU, WrapV bool
+    LineStrips bool
+    LayoutColumnMajor bool
+    FlipU, FlipV bool
+    SquareCells bool
+}
+
+/*  Shape of the shell drawn by GenerateSeashell. The center of the tube      *
+ *  spirals out as exp(Growth u) and sinks by Rise for each unit it grows,    *
+ *  and the radius of the tube is TubeRadius exp(TubeGrowth u).               */
+type SeashellParams struct {
+    Turns float32
+    Growth, TubeGrowth float32
+    TubeRadius float32
+    Rise float32
+}
+
+/*  Functional option that modifies a canvas configuration.                   */
+type CanvasOption func(config *CanvasConfig)
+
+/*  Sizes, in bytes, of the buffers of a canvas, see MemoryUsage. The active  *
+ *  sizes are the parts of the mesh and index buffers in use, the maximum     *
+ *  sizes are their storage. Auxiliary buffers only count if they exist.      */
+type MemoryReport struct {
+    MeshBytes, MaxMeshBytes int
+    IndexBytes, MaxIndexBytes int
+    AuxiliaryBytes int
+}
+
+/*  Vertex positions stored as integers, see QuantizePositions. Each of the   *
+ *  coordinates is mapped from the box [Lower, Upper] to [0, 2^Bits - 1].     */
+type QuantizedMesh struct {
+    Positions []uint16
+    Lower, Upper [3]float32
+    Bits int
+}
+
+/*  The features compiled into the package, see Capabilities. The mesh        *
+ *  types are listed in the order of the SquareWireframe constants.           */
+type CapabilityReport struct {
+    Version string
+    MeshTypes []string
+    ExportFormats []string
+    Surfaces []string
+    Colormaps []string
+    Features []string
+}
+
+/*  A vertex of the grid where the surface is flat, see CriticalPoints. The   *
+ *  kind is LocalMinimum, LocalMaximum, or SaddlePoint.                       */
+type CriticalPoint struct {
+    XIndex, YIndex uint32
+    Kind uint
+}
+
 /*  Vector struct used for rotating points about the z axis.                  */
 type UnitVector struct {
     AngleCos, AngleSin float32
 }
 
+/*  A rotation about one of the coordinate axes, XAxis, YAxis, or ZAxis, with *
+ *  the cosine and sine of the angle computed once. See NewRotationTransform. */
+type RotationTransform struct {
+    Axis uint
+    Rotation UnitVector
+}
+
 /*  Struct with the geometry and buffers for the animation.                   */
 type Canvas struct {
     Mesh []float32
@@ -41,4 +161,136 @@ type Canvas struct {
     Width, Height float32
     HorizontalStart, VerticalStart float32
     MeshType uint
+
+    /*  Only every WireframeStride-th grid line is drawn by the wireframe     *
+     *  generators. Zero and one both mean every line is drawn.               */
+    WireframeStride uint32
+
+    /*  Which grid lines the wireframe generators draw. The zero value,       *
+     *  BothDirections, draws the full grid.                                  */
+    WireframeDirection uint
+
+    /*  Whether the wireframe and faces join the last column of the grid to   *
+     *  the first, and the last row to the first. This closes the seams of    *
+     *  parametric surfaces with the plain square and triangle mesh types,    *
+     *  and the parametric generator then leaves out the repeated column or   *
+     *  row. A cylinder wraps in u only, a torus in both.                     */
+    WrapU, WrapV bool
+
+    /*  Whether GenerateRectangularWireframe writes the wireframe as line     *
+     *  strips, one per grid line separated by StripRestartIndex, rather      *
+     *  than as pairs of indices for disjoint segments.                       */
+    LineStrips bool
+
+    /*  Whether the vertices are stored column by column, with index equal    *
+     *  to x * NyPts + y, rather than row by row, y * NxPts + x. Every        *
+     *  routine that walks the grid, from the generators to Downsample and    *
+     *  WeldSeams, finds its vertices with gridIndex.                         */
+    LayoutColumnMajor bool
+
+    /*  Whether the generators walk the domain backwards along x (u) or y     *
+     *  (v). The vertex in column xIndex gets the coordinate of column NxPts  *
+     *  - 1 - xIndex, so the surface is the same but its faces are wound the  *
+     *  other way, see domainSteps. Flipping one axis turns the normals over. */
+    FlipU, FlipV bool
+
+    /*  The full memory the Mesh and Indices slices are cut from. For the     *
+     *  main canvas these are the global MeshBuffer and IndexBuffer slices.   *
+     *  The storage starts out small and grows, see ReserveStorage.           */
+    MeshStorage []float32
+    IndexStorage []uint32
+
+    /*  The mesh JavaScript reads when double buffering is used, and the      *
+     *  memory it is cut from. These are empty until the first SwapBuffers,   *
+     *  after which Go writes to Mesh and JavaScript reads from FrontMesh.    */
+    FrontMesh []float32
+    FrontStorage []float32
+
+    /*  Optional per-vertex buffers. These are empty until a routine computes *
+     *  them or the caller sets them. The normals have three floats per       *
+     *  vertex, the colors four, red, green, blue, and the opacity.           */
+    Normals []float32
+    Colors []float32
+
+    /*  Texture coordinates, two floats per vertex, see GenerateUVs.          */
+    UVs []float32
+
+    /*  A scalar field, one float per vertex, for the front end to draw, like *
+     *  the curvature or the slope. See SetScalarField.                       */
+    ScalarField []float32
+
+    /*  Gradient of the height, two floats per vertex, see ComputeGradient.   */
+    Gradients []float32
+
+    /*  Tangents for normal mapping, four floats per vertex, the direction    *
+     *  and the handedness. See ComputeTangents.                              */
+    Tangents []float32
+
+    /*  Triangle faces, three vertex indices per triangle, and one normal,    *
+     *  three floats, per triangle. See GenerateTriangleFaces.                */
+    Faces []uint32
+    FaceNormals []float32
+
+    /*  One color, three floats, per triangle, see ColorCheckerboard.         */
+    FaceColors []float32
+
+    /*  Quadrilateral faces, four vertex indices per cell of the grid. See    *
+     *  GenerateQuadFaces.                                                    */
+    QuadFaces []uint32
+
+    /*  Thick lines as triangles, four vertices and two triangles for each    *
+     *  segment of the wireframe, see ExpandLinesToQuads.                     */
+    LineQuadMesh []float32
+    LineQuadFaces []uint32
+
+    /*  A flat grid on the plane z = 0 drawn under the surface for scale, two *
+     *  vertices and one segment per line. See GenerateReferenceGrid.         */
+    ReferenceMesh []float32
+    ReferenceIndices []uint32
+
+    /*  Interleaved position, normal, and color data, see BuildInterleaved.   */
+    Interleaved []float32
+
+    /*  Copies of the mesh and normals that DragRotate rotates from, and the  *
+     *  angles accumulated from the drags. See CaptureBaseOrientation.        */
+    BaseMesh []float32
+    BaseNormals []float32
+    Yaw, Pitch, Roll float32
+
+    /*  Rates of rotation about the x, y, and z axes, see StepRotation.       */
+    AngularVelocity [3]float32
+
+    /*  Whether StepByTime and StepRotation hold the orientation still, see   *
+     *  PauseRotation.                                                        */
+    RotationPaused bool
+
+    /*  The point in the xy plane SpinAboutCentroid turns the mesh about, and *
+     *  whether it has been found. It is the centroid when the mesh is first  *
+     *  spun, and is found again once the grid or the domain changes.         */
+    SpinCenter [2]float32
+    HasSpinCenter bool
+
+    /*  The rotation at the start of an eased transition, see RotateTo.       */
+    TransitionStart float32
+    Transitioning bool
+
+    /*  The positions of the vertices on the coarse and fine meshes while the *
+     *  level of detail changes, see BeginLODBlend. Empty otherwise.          */
+    LODStart []float32
+    LODEnd []float32
+
+    /*  The surface the mesh was generated from, used by Regenerate, and a    *
+     *  flag for regenerations that are pending until the next Flush.         */
+    Surface SurfaceParametrization
+    Dirty bool
+
+    /*  The largest height AnimateSurface lets the mesh reach, zero for no    *
+     *  limit, the scale applied to the heights to stay below it, and the     *
+     *  time of the last frame. See SetHeightTarget.                          */
+    HeightTarget, HeightScale, FrameTime float32
+
+    /*  The name of the preset last drawn by GenerateSurfacePreset, and the   *
+     *  coefficients set for its family, see SetCoefficient.                  */
+    Preset string
+    Coefficients map[string]float32
 }
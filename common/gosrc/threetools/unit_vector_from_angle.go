@@ -0,0 +1,44 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the point on the unit circle for an angle.                   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      UnitVectorFromAngle                                                   *
+ *  Purpose:                                                                  *
+ *      Computes the point on the unit circle with the given polar angle,     *
+ *      using SinCos, which is accurate for any angle.                        *
+ *  Arguments:                                                                *
+ *      angle (float32):                                                      *
+ *          The polar angle, in radians.                                      *
+ *  Output:                                                                   *
+ *      point (UnitVector):                                                   *
+ *          The cosine and sine of the angle.                                 *
+ ******************************************************************************/
+func UnitVectorFromAngle(angle float32) UnitVector {
+    sinAngle, cosAngle := SinCos(angle)
+    return UnitVector{cosAngle, sinAngle}
+}
+/*  End of UnitVectorFromAngle.                                               */
@@ -0,0 +1,104 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Recomputes the vertices of a mesh in a rectangle of the grid.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      UpdateRegion                                                          *
+ *  Purpose:                                                                  *
+ *      Recomputes the vertices of the surface z = f(x, y) for the grid       *
+ *      points with x0 <= xIndex < x1 and y0 <= yIndex < y1, leaving the rest *
+ *      of the mesh alone. The grid is the same as in                         *
+ *      GenerateMeshFromParametrization so the topology, and hence the index  *
+ *      buffer, is unchanged. This keeps interactive edits of large meshes    *
+ *      responsive. The rectangle is clipped to the grid. If the mesh is      *
+ *      rotated from a base orientation the base is updated too, and the      *
+ *      rotation is applied again, so the region turns with the rest.         *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas for the animation, with the mesh already generated.    *
+ *      f (SurfaceParametrization):                                           *
+ *          The function that defines the surface, z = f(x, y).               *
+ *      x0 (uint32):                                                          *
+ *          The first column of the region.                                   *
+ *      y0 (uint32):                                                          *
+ *          The first row of the region.                                      *
+ *      x1 (uint32):                                                          *
+ *          One past the last column of the region.                           *
+ *      y1 (uint32):                                                          *
+ *          One past the last row of the region.                              *
+ *  Output:                                                                   *
+ *      None.                                                                 *
+ ******************************************************************************/
+func (self *Canvas) UpdateRegion(f SurfaceParametrization,
+                                 x0, y0, x1, y1 uint32) {
+
+    /*  Step sizes in the horizontal and vertical axes.                       */
+    var dx float32 = self.Width / float32(self.NxPts - 1)
+    var dy float32 = self.Height / float32(self.NyPts - 1)
+
+    /*  Variables for indexing the horizontal and vertical axes.              */
+    var xIndex, yIndex uint32
+
+    /*  Clip the region to the grid.                                          */
+    if x1 > self.NxPts {
+        x1 = self.NxPts
+    }
+
+    if y1 > self.NyPts {
+        y1 = self.NyPts
+    }
+
+    if int(self.NxPts * self.NyPts) != self.NumberOfPoints {
+        return
+    }
+
+    /*  A rotated mesh is rebuilt from its base, which is updated instead.    */
+    var oriented bool = (self.MeshSize > 0) &&
+                        (len(self.BaseMesh) == self.MeshSize)
+    var target []float32 = self.Mesh
+
+    if oriented {
+        target = self.BaseMesh
+    }
+
+    for yIndex = y0; yIndex < y1; yIndex++ {
+        for xIndex = x0; xIndex < x1; xIndex++ {
+            xStep, yStep := self.domainSteps(xIndex, yIndex)
+            var xPt float32 = self.HorizontalStart + float32(xStep) * dx
+            var yPt float32 = self.VerticalStart + float32(yStep) * dy
+            var index uint32 = 3 * self.gridIndex(xIndex, yIndex)
+
+            target[index] = xPt
+            target[index + 1] = yPt
+            target[index + 2] = f(xPt, yPt)
+        }
+    }
+
+    if oriented {
+        self.applyBaseOrientation()
+    }
+}
+/*  End of UpdateRegion.                                                      */
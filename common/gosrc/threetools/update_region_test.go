@@ -0,0 +1,70 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for updating a sub-region of the mesh.                          *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  Updating the 2x2 block of vertices with 2 <= x < 4 and 1 <= y < 3 changes *
+ *  the heights of exactly those four vertices, to the new surface, and       *
+ *  leaves every other vertex, and the x and y coordinates, untouched.        */
+func TestUpdateRegionTwoByTwo(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(6, 5))
+    var original *Canvas = canvas.Clone()
+    var changed int = 0
+
+    var raised = func(x, y float32) float32 {
+        return paraboloid(x, y) + 1.0
+    }
+
+    canvas.UpdateRegion(raised, 2, 1, 4, 3)
+
+    for yIndex := uint32(0); yIndex < canvas.NyPts; yIndex++ {
+        for xIndex := uint32(0); xIndex < canvas.NxPts; xIndex++ {
+            var index int = int(canvas.gridIndex(xIndex, yIndex))
+            var p, q [3]float32 = canvas.point(index), original.point(index)
+            var inside bool = (2 <= xIndex) && (xIndex < 4) &&
+                              (1 <= yIndex) && (yIndex < 3)
+
+            if p != q {
+                changed++
+            }
+
+            if inside && !closeTo(p[2], raised(p[0], p[1]), 1.0E-6) {
+                t.Errorf("height at (%d, %d) = %g, want %g",
+                         xIndex, yIndex, p[2], raised(p[0], p[1]))
+            }
+
+            if (p[0] != q[0]) || (p[1] != q[1]) || (!inside && (p != q)) {
+                t.Errorf("vertex (%d, %d) moved from %v to %v",
+                         xIndex, yIndex, q, p)
+            }
+        }
+    }
+
+    if changed != 4 {
+        t.Errorf("%d vertices changed, want 4", changed)
+    }
+}
+/*  End of TestUpdateRegionTwoByTwo.                                          */
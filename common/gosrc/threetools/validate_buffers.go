@@ -0,0 +1,85 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Checks that the buffers of a canvas match its sizes.                  *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errorf is provided here, used for wrapping the errors with the sizes.     */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      ValidateBuffers                                                       *
+ *  Purpose:                                                                  *
+ *      Checks that the grid and mesh type of a canvas are supported and that *
+ *      its mesh and index slices are long enough for its sizes. The mesh     *
+ *      slice must hold MeshSize floats, and three floats for every point of  *
+ *      the grid, and the index slice must hold IndexSize indices. A canvas   *
+ *      whose fields were set by hand can easily break these, which otherwise *
+ *      panics deep inside a generator. The generators and InitCanvas call    *
+ *      this up front so they fail with a clear message instead.              *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas being checked.                                         *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          ErrGridTooLarge or ErrInvalidMeshType for an unsupported grid,    *
+ *          or ErrBufferTooSmall for a slice that is too short.               *
+ ******************************************************************************/
+func (self *Canvas) ValidateBuffers() error {
+
+    /*  The number of floats the grid needs, three per point.                 */
+    var gridSize int = 3 * int(self.NxPts) * int(self.NyPts)
+
+    if err := checkGrid(self.NxPts, self.NyPts, self.MeshType); err != nil {
+        return fmt.Errorf(
+            "%w: %dx%d grid with mesh type %d",
+            err, self.NxPts, self.NyPts, self.MeshType,
+        )
+    }
+
+    if len(self.Mesh) < self.MeshSize {
+        return fmt.Errorf(
+            "%w: mesh slice has %d floats, MeshSize is %d",
+            ErrBufferTooSmall, len(self.Mesh), self.MeshSize,
+        )
+    }
+
+    if len(self.Mesh) < gridSize {
+        return fmt.Errorf(
+            "%w: mesh slice has %d floats, the %dx%d grid needs %d",
+            ErrBufferTooSmall, len(self.Mesh), self.NxPts, self.NyPts,
+            gridSize,
+        )
+    }
+
+    if len(self.Indices) < self.IndexSize {
+        return fmt.Errorf(
+            "%w: index slice has %d indices, IndexSize is %d",
+            ErrBufferTooSmall, len(self.Indices), self.IndexSize,
+        )
+    }
+
+    return nil
+}
+/*  End of ValidateBuffers.                                                   */
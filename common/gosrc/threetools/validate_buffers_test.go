@@ -0,0 +1,63 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for the buffer checks done before generating a mesh.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errors are compared with errors.Is, and the message is built with fmt and *
+ *  searched with strings.                                                    */
+import (
+    "errors"
+    "fmt"
+    "strings"
+    "testing"
+)
+
+/*  A canvas set up by Configure passes, and one whose index slice was cut    *
+ *  short fails with ErrBufferTooSmall and a message with both sizes.         */
+func TestValidateBuffersShortIndices(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, paraboloid, WithGrid(5, 4))
+
+    if err := canvas.ValidateBuffers(); err != nil {
+        t.Fatalf("ValidateBuffers on a valid canvas: %v", err)
+    }
+
+    canvas.Indices = canvas.Indices[:canvas.IndexSize - 2]
+    var err error = canvas.ValidateBuffers()
+
+    if !errors.Is(err, ErrBufferTooSmall) {
+        t.Fatalf("got %v, want ErrBufferTooSmall", err)
+    }
+
+    var message string = err.Error()
+
+    var want string = fmt.Sprintf(
+        "index slice has %d indices, IndexSize is %d",
+        canvas.IndexSize - 2, canvas.IndexSize,
+    )
+
+    if !strings.Contains(message, want) {
+        t.Errorf("message %q does not contain %q", message, want)
+    }
+}
+/*  End of TestValidateBuffersShortIndices.                                   */
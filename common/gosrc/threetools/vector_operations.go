@@ -0,0 +1,73 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Small helper routines for working with vectors in three dimensions.   *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  sqrt is provided here, needed for computing the length of a vector.       */
+import "math"
+
+/*  Computes the Euclidean dot product of two vectors in R^3.                 */
+func dotProduct(p, q [3]float32) float32 {
+    return p[0]*q[0] + p[1]*q[1] + p[2]*q[2]
+}
+
+/*  Computes the cross product p x q of two vectors in R^3.                   */
+func crossProduct(p, q [3]float32) [3]float32 {
+    return [3]float32{
+        p[1]*q[2] - p[2]*q[1],
+        p[2]*q[0] - p[0]*q[2],
+        p[0]*q[1] - p[1]*q[0],
+    }
+}
+
+/*  Computes the sum p + q of two vectors in R^3.                             */
+func vectorSum(p, q [3]float32) [3]float32 {
+    return [3]float32{p[0] + q[0], p[1] + q[1], p[2] + q[2]}
+}
+
+/*  Computes the difference p - q of two vectors in R^3.                      */
+func vectorDifference(p, q [3]float32) [3]float32 {
+    return [3]float32{p[0] - q[0], p[1] - q[1], p[2] - q[2]}
+}
+
+/*  Computes the scalar multiple c p of a vector in R^3.                      */
+func scaleVector(p [3]float32, c float32) [3]float32 {
+    return [3]float32{c * p[0], c * p[1], c * p[2]}
+}
+
+/*  Computes the Euclidean norm, the length, of a vector in R^3.              */
+func vectorNorm(p [3]float32) float32 {
+    return float32(math.Sqrt(float64(dotProduct(p, p))))
+}
+
+/*  Scales a vector to unit length. The zero vector is returned unchanged.    */
+func normalizeVector(p [3]float32) [3]float32 {
+    var norm float32 = vectorNorm(p)
+
+    if norm == 0.0 {
+        return p
+    }
+
+    return [3]float32{p[0] / norm, p[1] / norm, p[2] / norm}
+}
@@ -0,0 +1,64 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Returns the vertex of the mesh at a grid index.                       *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Errorf is provided here, used for wrapping the error with the indices.    */
+import "fmt"
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      VertexAt                                                              *
+ *  Purpose:                                                                  *
+ *      Returns the current position of the vertex at the given point of the  *
+ *      grid, read from the layout of the canvas, see LayoutColumnMajor. The  *
+ *      position includes any rotation applied to the mesh, so it matches     *
+ *      what is drawn. This is meant for picking and for tooltips with        *
+ *      coordinates.                                                          *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas with the mesh.                                         *
+ *      xIndex (uint32):                                                      *
+ *          The column of the vertex.                                         *
+ *      yIndex (uint32):                                                      *
+ *          The row of the vertex.                                            *
+ *  Output:                                                                   *
+ *      point ([3]float32):                                                   *
+ *          The x, y, and z values of the vertex.                             *
+ *      err (error):                                                          *
+ *          ErrIndexOutOfRange if the indices are outside the grid.           *
+ ******************************************************************************/
+func (self *Canvas) VertexAt(xIndex, yIndex uint32) ([3]float32, error) {
+
+    if (xIndex >= self.NxPts) || (yIndex >= self.NyPts) ||
+       (3 * int(self.gridIndex(xIndex, yIndex)) + 3 > self.MeshSize) {
+        return [3]float32{}, fmt.Errorf(
+            "%w: vertex (%d, %d) of a %dx%d grid",
+            ErrIndexOutOfRange, xIndex, yIndex, self.NxPts, self.NyPts,
+        )
+    }
+
+    return self.point(int(self.gridIndex(xIndex, yIndex))), nil
+}
+/*  End of VertexAt.                                                          */
@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes an orthonormal frame for viewing a mesh from a direction.    *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      viewBasis                                                             *
+ *  Purpose:                                                                  *
+ *      Computes the right, up, and forward vectors of a camera looking along *
+ *      a given direction. The up vector is chosen to be as close to the      *
+ *      positive z axis as possible, matching the default camera for the      *
+ *      animations. If the direction is parallel to the z axis, the positive  *
+ *      y axis is used instead.                                               *
+ *  Arguments:                                                                *
+ *      direction ([3]float32):                                               *
+ *          The viewing direction. This need not be normalized.               *
+ *  Output:                                                                   *
+ *      right ([3]float32):                                                   *
+ *          Unit vector pointing to the right of the screen.                  *
+ *      up ([3]float32):                                                      *
+ *          Unit vector pointing to the top of the screen.                    *
+ *      forward ([3]float32):                                                 *
+ *          Unit vector pointing into the screen.                             *
+ ******************************************************************************/
+func viewBasis(direction [3]float32) (right, up, forward [3]float32) {
+
+    /*  The reference "up" direction for the screen. This is the z axis since *
+     *  the surfaces are drawn with z as the vertical axis.                   */
+    var reference [3]float32 = [3]float32{0.0, 0.0, 1.0}
+
+    /*  The camera looks along the direction vector, normalize it.            */
+    forward = normalizeVector(direction)
+
+    /*  If the forward vector is (nearly) parallel to the z axis we can not   *
+     *  use the z axis to define the vertical direction. Use the y axis.      */
+    if vectorNorm(crossProduct(forward, reference)) < 1.0E-6 {
+        reference = [3]float32{0.0, 1.0, 0.0}
+    }
+
+    /*  The right vector is perpendicular to both the forward direction and   *
+     *  the reference vector. Normalize the cross product to compute it.      */
+    right = normalizeVector(crossProduct(forward, reference))
+
+    /*  The true up direction is orthogonal to both forward and right. Since  *
+     *  these are orthonormal, the cross product is already a unit vector.    */
+    up = crossProduct(right, forward)
+    return right, up, forward
+}
+/*  End of viewBasis.                                                         */
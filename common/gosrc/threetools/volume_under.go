@@ -0,0 +1,53 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Computes the signed volume under the mesh of a canvas.                *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      VolumeUnder                                                           *
+ *  Purpose:                                                                  *
+ *      Approximates the signed volume between the plane z = 0 and the        *
+ *      surface z = f(x, y) of a canvas with the trapezoidal rule, like       *
+ *      Integrate, but using the heights already stored in the mesh rather    *
+ *      than evaluating the surface again. Regions below the plane count as   *
+ *      negative volume.                                                      *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the surface.                                      *
+ *  Output:                                                                   *
+ *      volume (float32):                                                     *
+ *          The signed volume, zero for an empty grid.                        *
+ ******************************************************************************/
+func VolumeUnder(canvas *Canvas) float32 {
+
+    /*  The height of a node is the z component of its vertex.                */
+    var sample = func(xIndex, yIndex uint32) float64 {
+        var index uint32 = canvas.gridIndex(xIndex, yIndex)
+        return float64(canvas.Mesh[3 * index + 2])
+    }
+
+    return float32(integrateGrid(canvas, sample))
+}
+/*  End of VolumeUnder.                                                       */
@@ -0,0 +1,238 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Merges the duplicated vertices along the seams of a wrapped mesh.     *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Finds the representative of a vertex, compressing the path as we go.      */
+func findRoot(parent []int, index int) int {
+    for parent[index] != index {
+        parent[index] = parent[parent[index]]
+        index = parent[index]
+    }
+
+    return index
+}
+
+/*  Compacts a per-vertex buffer with the given number of floats per vertex,  *
+ *  moving each kept vertex to its new location.                              */
+func compactVertexBuffer(buffer []float32, newIndex []int, keep []bool,
+                         stride int, newCount int) []float32 {
+
+    /*  Variables for indexing over the vertices and their components.        */
+    var index, component int
+
+    for index = 0; index < len(keep); index++ {
+        if !keep[index] {
+            continue
+        }
+
+        /*  New indices never exceed old ones, so moving the data forward in  *
+         *  place does not overwrite anything that is still needed.           */
+        for component = 0; component < stride; component++ {
+            buffer[stride * newIndex[index] + component] =
+                buffer[stride * index + component]
+        }
+    }
+
+    return buffer[0:stride * newCount]
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      WeldSeamsWithin                                                       *
+ *  Purpose:                                                                  *
+ *      For wrapped topologies the first and last columns (and rows) are the  *
+ *      same points, stored twice. This merges each vertex on the seam with   *
+ *      its partner on the opposite edge, if the two are within epsilon of    *
+ *      each other, removes the duplicates from the mesh, and rewrites the    *
+ *      index and face buffers to use the merged vertices. The normal, color, *
+ *      and UV buffers are compacted along with the mesh if they are          *
+ *      populated. After welding the mesh is no longer a full rectangular     *
+ *      grid, so grid based routines like ComputeNormals and GenerateUVs      *
+ *      should be run before this one. Only the partners across a seam are    *
+ *      compared, so a large epsilon can not merge other vertices, but it     *
+ *      should still be small next to the figure, see RelativeEpsilon.        *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose seams are being welded.                          *
+ *      epsilon (float32):                                                    *
+ *          Vertices at most this far apart are the same point.               *
+ *  Output:                                                                   *
+ *      removed (int):                                                        *
+ *          The number of vertices removed from the mesh.                     *
+ ******************************************************************************/
+func (self *Canvas) WeldSeamsWithin(epsilon float32) int {
+
+    /*  Variables for indexing over the grid and the vertices.                */
+    var xIndex, yIndex, index int
+
+    /*  The grid dimensions, as integers for the index computations.          */
+    var width int = int(self.NxPts)
+    var height int = int(self.NyPts)
+
+    /*  The representative of each vertex, and the new index of each vertex.  */
+    var parent []int = make([]int, self.NumberOfPoints)
+    var newIndex []int = make([]int, self.NumberOfPoints)
+    var keep []bool = make([]bool, self.NumberOfPoints)
+
+    /*  The number of vertices that remain after welding.                     */
+    var newCount int = 0
+
+    /*  The types of the seams for the mesh type of the canvas.               */
+    wrapX, twistX, wrapY, twistY := self.seamTopology()
+
+    /*  The index of the vertex in column x and row y, see gridIndex.         */
+    var vertex = func(x, y int) int {
+        return int(self.gridIndex(uint32(x), uint32(y)))
+    }
+
+    /*  Merges two vertices if they are the same point, keeping the one with  *
+     *  the smaller index as the representative.                              */
+    var merge = func(first, second int) {
+        var distance float32 = vectorNorm(
+            vectorDifference(self.point(first), self.point(second)),
+        )
+
+        if distance > epsilon {
+            return
+        }
+
+        first = findRoot(parent, first)
+        second = findRoot(parent, second)
+
+        if first < second {
+            parent[second] = first
+        } else if second < first {
+            parent[first] = second
+        }
+    }
+
+    /*  Nothing to weld for empty meshes or meshes without seams.             */
+    if (self.NumberOfPoints == 0) || (!wrapX && !wrapY) {
+        return 0
+    }
+
+    /*  Initially every vertex represents itself.                             */
+    for index = 0; index < self.NumberOfPoints; index++ {
+        parent[index] = index
+    }
+
+    /*  The right edge is glued to the left edge, possibly with a twist.      */
+    if wrapX && (width > 1) {
+        for yIndex = 0; yIndex < height; yIndex++ {
+            var partner int = yIndex
+
+            if twistX {
+                partner = height - 1 - yIndex
+            }
+
+            merge(vertex(0, partner), vertex(width - 1, yIndex))
+        }
+    }
+
+    /*  The top edge is glued to the bottom edge, possibly with a twist.      */
+    if wrapY && (height > 1) {
+        for xIndex = 0; xIndex < width; xIndex++ {
+            var partner int = xIndex
+
+            if twistY {
+                partner = width - 1 - xIndex
+            }
+
+            merge(vertex(partner, 0), vertex(xIndex, height - 1))
+        }
+    }
+
+    /*  The representatives are kept, and are numbered in order. The others   *
+     *  are given the new index of their representative. Since the root of a  *
+     *  vertex never has a larger index, it has already been numbered.        */
+    for index = 0; index < self.NumberOfPoints; index++ {
+        var root int = findRoot(parent, index)
+
+        if root == index {
+            keep[index] = true
+            newIndex[index] = newCount
+            newCount++
+        } else {
+            newIndex[index] = newIndex[root]
+        }
+    }
+
+    /*  Rewrite the line segments and faces to use the new indices. The       *
+     *  restarts between line strips do not refer to a vertex, and are kept.  */
+    for index = 0; index < self.IndexSize; index++ {
+        if self.Indices[index] != StripRestartIndex {
+            self.Indices[index] = uint32(newIndex[self.Indices[index]])
+        }
+    }
+
+    for index = 0; index < len(self.Faces); index++ {
+        self.Faces[index] = uint32(newIndex[self.Faces[index]])
+    }
+
+    /*  Compact the mesh, and any of the per-vertex buffers that are in use.  */
+    self.Mesh = compactVertexBuffer(self.Mesh, newIndex, keep, 3, newCount)
+
+    if len(self.Normals) >= self.MeshSize {
+        self.Normals = compactVertexBuffer(
+            self.Normals, newIndex, keep, 3, newCount,
+        )
+    }
+
+    if len(self.Colors) >= 4 * self.NumberOfPoints {
+        self.Colors = compactVertexBuffer(
+            self.Colors, newIndex, keep, 4, newCount,
+        )
+    }
+
+    if len(self.UVs) >= 2 * self.NumberOfPoints {
+        self.UVs = compactVertexBuffer(self.UVs, newIndex, keep, 2, newCount)
+    }
+
+    /*  Update the sizes to reflect the removed vertices.                     */
+    var removed int = self.NumberOfPoints - newCount
+    self.NumberOfPoints = newCount
+    self.MeshSize = 3 * newCount
+    return removed
+}
+/*  End of WeldSeamsWithin.                                                   */
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      WeldSeams                                                             *
+ *  Purpose:                                                                  *
+ *      Welds the seams as WeldSeamsWithin does, with the fixed tolerance     *
+ *      WeldEpsilon. For figures much smaller or larger than the unit cube    *
+ *      use WeldSeamsWithin with an epsilon from RelativeEpsilon.             *
+ *  Arguments:                                                                *
+ *      self (*Canvas):                                                       *
+ *          The canvas whose seams are being welded.                          *
+ *  Output:                                                                   *
+ *      removed (int):                                                        *
+ *          The number of vertices removed from the mesh.                     *
+ ******************************************************************************/
+func (self *Canvas) WeldSeams() int {
+    return self.WeldSeamsWithin(WeldEpsilon)
+}
+/*  End of WeldSeams.                                                         */
@@ -0,0 +1,99 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for welding the duplicated vertices along the seams.            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Sincos and Pi are provided by math, used for the cylinder.                */
+import (
+    "math"
+    "testing"
+)
+
+/*  Creates a unit cylinder scaled by the given factor on an 8x5 grid, with   *
+ *  its wireframe, the first and last columns being the seam.                 */
+func newScaledCylinder(t *testing.T, scale float32) *Canvas {
+    t.Helper()
+
+    var canvas *Canvas = newTestCanvas(
+        t, WithGrid(8, 5), WithDomain(1.0, 1.0, 0.0, 0.0),
+        WithMeshType(CylindricalSquareWireframe),
+    )
+
+    canvas.GenerateMeshFromParametric(func(u, v float32) [3]float32 {
+        sinU, cosU := math.Sincos(2.0 * math.Pi * float64(u))
+        return [3]float32{
+            scale * float32(cosU), scale * float32(sinU), scale * v,
+        }
+    })
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    return canvas
+}
+/*  End of newScaledCylinder.                                                 */
+
+/*  The first and last columns of a cylinder are the same points, so welding  *
+ *  removes exactly NyPts vertices, and the wireframe then only refers to the *
+ *  vertices that remain.                                                     */
+func TestWeldSeamsCylinder(t *testing.T) {
+    var canvas *Canvas = newScaledCylinder(t, 1.0)
+    var before int = canvas.NumberOfPoints
+    var removed int = canvas.WeldSeams()
+
+    if removed != int(canvas.NyPts) {
+        t.Errorf("WeldSeams removed %d vertices, want %d",
+                 removed, canvas.NyPts)
+    }
+
+    if canvas.NumberOfPoints != before - int(canvas.NyPts) {
+        t.Errorf("%d vertices after welding, want %d",
+                 canvas.NumberOfPoints, before - int(canvas.NyPts))
+    }
+
+    for index := 0; index < canvas.IndexSize; index++ {
+        if int(canvas.Indices[index]) >= canvas.NumberOfPoints {
+            t.Fatalf("index %d refers to removed vertex %d",
+                     index, canvas.Indices[index])
+        }
+    }
+}
+/*  End of TestWeldSeamsCylinder.                                             */
+
+/*  With an epsilon relative to the size of the mesh, a tiny and a huge       *
+ *  cylinder both have exactly their seams welded, NyPts vertices each.       */
+func TestWeldSeamsWithinRelativeEpsilon(t *testing.T) {
+    for _, scale := range []float32{1.0E-4, 1.0E4} {
+        var canvas *Canvas = newScaledCylinder(t, scale)
+        var epsilon float32 = canvas.RelativeEpsilon(1.0E-6)
+        var removed int = canvas.WeldSeamsWithin(epsilon)
+
+        if removed != int(canvas.NyPts) {
+            t.Errorf("scale %g: removed %d vertices, want %d",
+                     scale, removed, canvas.NyPts)
+        }
+    }
+}
+/*  End of TestWeldSeamsWithinRelativeEpsilon.                                */
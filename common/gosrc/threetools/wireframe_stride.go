@@ -0,0 +1,58 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Helper routines for drawing every N-th line of a wireframe.           *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  Returns the wireframe stride of a canvas, treating zero as one.           */
+func (self *Canvas) wireframeStride() uint32 {
+    if self.WireframeStride == 0 {
+        return 1
+    }
+
+    return self.WireframeStride
+}
+
+/*  Determines if the grid line with the given index is drawn. The lines at   *
+ *  multiples of the stride are drawn, as is the last line so that the edge   *
+ *  of the figure is always present.                                          */
+func isWireframeLine(index, numberOfLines, stride uint32) bool {
+    return (index % stride == 0) || (index == numberOfLines - 1)
+}
+
+/*  Counts the grid lines that are drawn out of the given number of lines.    */
+func countWireframeLines(numberOfLines, stride uint32) uint32 {
+
+    /*  Zero lines means nothing to draw. Avoid the underflow below.          */
+    if numberOfLines == 0 {
+        return 0
+    }
+
+    /*  The multiples of the stride between 0 and numberOfLines - 1, and the  *
+     *  final line if it is not one of these multiples.                       */
+    if (numberOfLines - 1) % stride == 0 {
+        return (numberOfLines - 1) / stride + 1
+    }
+
+    return (numberOfLines - 1) / stride + 2
+}
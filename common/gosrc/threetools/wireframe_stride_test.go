@@ -0,0 +1,67 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for drawing only every few grid lines of the wireframe.         *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import "testing"
+
+/*  With a stride the wireframe draws the lines at multiples of the stride    *
+ *  and the final line, and nothing else. A 7x7 grid with stride 3 keeps the  *
+ *  lines 0, 3, and 6, and one with stride 4 keeps 0, 4, and 6. Either way    *
+ *  there are three lines of six segments in each direction.                  */
+func TestWireframeStrideSegmentCount(t *testing.T) {
+    for _, stride := range []uint32{3, 4} {
+        var canvas *Canvas = newGraphCanvas(
+            t, paraboloid, WithGrid(7, 7), WithWireframeStride(stride),
+        )
+
+        var segments []gridSegment = wireframeSegments(canvas)
+        var lines uint32 = countWireframeLines(7, stride)
+
+        if lines != 3 {
+            t.Errorf("stride %d: %d lines drawn, want 3", stride, lines)
+        }
+
+        if len(segments) != 36 {
+            t.Errorf("stride %d: %d segments, want 36",
+                     stride, len(segments))
+        }
+
+        for _, segment := range segments {
+            var onLine bool
+
+            if segment.y0 == segment.y1 {
+                onLine = isWireframeLine(segment.y0, 7, stride)
+            } else {
+                onLine = isWireframeLine(segment.x0, 7, stride)
+            }
+
+            if !onLine {
+                t.Errorf("stride %d: segment %+v is not on a drawn line",
+                         stride, segment)
+            }
+        }
+    }
+}
+/*  End of TestWireframeStrideSegmentCount.                                   */
@@ -0,0 +1,120 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Streams a Wavefront OBJ file of the mesh to a writer.                 *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+)
+
+/*  Writes the wireframe as line strips, one "l" line per strip, with the     *
+ *  strips split at StripRestartIndex. OBJ lines may list any number of       *
+ *  vertices, so the strips are kept as they are.                             */
+func writeOBJStrips(writer *bufio.Writer, indices []uint32) {
+    var started bool = false
+
+    for _, vertex := range indices {
+        if vertex == StripRestartIndex {
+            if started {
+                writer.WriteString("\n")
+                started = false
+            }
+
+            continue
+        }
+
+        if !started {
+            writer.WriteString("l")
+            started = true
+        }
+
+        fmt.Fprintf(writer, " %d", vertex + 1)
+    }
+
+    if started {
+        writer.WriteString("\n")
+    }
+}
+
+/******************************************************************************
+ *  Function:                                                                 *
+ *      WriteOBJ                                                              *
+ *  Purpose:                                                                  *
+ *      Writes a Wavefront OBJ file of the mesh to a writer as it is          *
+ *      produced, rather than building the whole file in memory, for large    *
+ *      meshes. Each active vertex is written as a "v" line, each segment of  *
+ *      the wireframe as an "l" line, and each triangle of the face buffer,   *
+ *      if populated, as an "f" line. A wireframe of line strips, see         *
+ *      LineStrips, gives one "l" line per strip. OBJ indices start at one,   *
+ *      not zero. The output is buffered and flushed before returning.        *
+ *  Arguments:                                                                *
+ *      canvas (*Canvas):                                                     *
+ *          The canvas with the mesh being written.                           *
+ *      w (io.Writer):                                                        *
+ *          The destination, for example a file.                              *
+ *  Output:                                                                   *
+ *      err (error):                                                          *
+ *          The first error from the writer, if any.                          *
+ ******************************************************************************/
+func WriteOBJ(canvas *Canvas, w io.Writer) error {
+
+    /*  Variable for indexing over the vertices, segments, and faces.         */
+    var index int
+
+    /*  Small writes are collected before being passed to the writer. Errors  *
+     *  are kept by the buffered writer and reported by Flush.                */
+    var writer *bufio.Writer = bufio.NewWriter(w)
+
+    for index = 0; index < canvas.MeshSize; index += 3 {
+        fmt.Fprintf(
+            writer, "v %g %g %g\n",
+            canvas.Mesh[index], canvas.Mesh[index + 1], canvas.Mesh[index + 2],
+        )
+    }
+
+    if canvas.LineStrips {
+        writeOBJStrips(writer, canvas.Indices[0:canvas.IndexSize])
+    } else {
+        for index = 0; index + 1 < canvas.IndexSize; index += 2 {
+            fmt.Fprintf(
+                writer, "l %d %d\n",
+                canvas.Indices[index] + 1, canvas.Indices[index + 1] + 1,
+            )
+        }
+    }
+
+    for index = 0; index + 2 < len(canvas.Faces); index += 3 {
+        fmt.Fprintf(
+            writer, "f %d %d %d\n",
+            canvas.Faces[index] + 1,
+            canvas.Faces[index + 1] + 1,
+            canvas.Faces[index + 2] + 1,
+        )
+    }
+
+    return writer.Flush()
+}
+/*  End of WriteOBJ.                                                          */
@@ -0,0 +1,119 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of threejs_figures.                                     *
+ *                                                                            *
+ *  threejs_figures is free software: you can redistribute it and/or modify   *
+ *  it under the terms of the GNU General Public License as published by      *
+ *  the Free Software Foundation, either version 3 of the License, or         *
+ *  (at your option) any later version.                                       *
+ *                                                                            *
+ *  threejs_figures is distributed in the hope that it will be useful,        *
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of            *
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the             *
+ *  GNU General Public License for more details.                              *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with threejs_figures.  If not, see <https://www.gnu.org/licenses/>. *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Tests for streaming OBJ files to a writer.                            *
+ ******************************************************************************
+ *  Author:     Ryan Maguire                                                  *
+ *  Date:       October 16, 2026                                              *
+ ******************************************************************************/
+package threetools
+
+/*  The output is collected in a buffer and split into lines.                 */
+import (
+    "bytes"
+    "errors"
+    "fmt"
+    "strings"
+    "testing"
+)
+
+/*  The error returned by every write to a failingWriter.                     */
+var errFailingWriter error = errors.New("write failed")
+
+/*  A writer that always fails, for checking that errors are reported.        */
+type failingWriter struct{}
+
+/*  Write rejects every write with errFailingWriter.                          */
+func (failingWriter) Write(p []byte) (int, error) {
+    return 0, errFailingWriter
+}
+/*  End of Write.                                                             */
+
+/*  Streaming to a buffer gives exactly the text of ExportOBJ, with a "v"     *
+ *  line per vertex, an "l" line per segment, and an "f" line per triangle,   *
+ *  the vertices counted from one.                                            */
+func TestWriteOBJMatchesExport(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(4, 3))
+    var buffer bytes.Buffer
+    canvas.GenerateTriangleFaces()
+
+    if err := WriteOBJ(canvas, &buffer); err != nil {
+        t.Fatalf("WriteOBJ: %v", err)
+    }
+
+    if buffer.String() != ExportOBJ(canvas) {
+        t.Fatalf("streamed OBJ differs from ExportOBJ")
+    }
+
+    /*  The number of lines of each kind, keyed by the first field.           */
+    var counts map[string]int = map[string]int{}
+    var lines []string = strings.Split(strings.TrimSpace(buffer.String()), "\n")
+
+    for _, line := range lines {
+        counts[strings.Fields(line)[0]]++
+    }
+
+    if (counts["v"] != canvas.NumberOfPoints) ||
+       (counts["l"] != canvas.IndexSize / 2) ||
+       (counts["f"] != len(canvas.Faces) / 3) {
+        t.Errorf("%d v, %d l, %d f lines, want %d, %d, %d",
+                 counts["v"], counts["l"], counts["f"], canvas.NumberOfPoints,
+                 canvas.IndexSize / 2, len(canvas.Faces) / 3)
+    }
+
+    var segment string = lines[canvas.NumberOfPoints]
+    var first, second uint32 = canvas.Indices[0] + 1, canvas.Indices[1] + 1
+
+    if segment != fmt.Sprintf("l %d %d", first, second) {
+        t.Errorf("first segment %q, want vertices %d and %d",
+                 segment, first, second)
+    }
+}
+/*  End of TestWriteOBJMatchesExport.                                         */
+
+/*  A wireframe of line strips is written with one "l" line per strip.        */
+func TestWriteOBJStrips(t *testing.T) {
+    var canvas *Canvas = newTestCanvas(t, WithGrid(4, 1), WithLineStrips(true))
+    var buffer bytes.Buffer
+
+    if err := canvas.GenerateRectangularWireframe(); err != nil {
+        t.Fatalf("GenerateRectangularWireframe: %v", err)
+    }
+
+    if err := WriteOBJ(canvas, &buffer); err != nil {
+        t.Fatalf("WriteOBJ: %v", err)
+    }
+
+    if !strings.HasSuffix(buffer.String(), "\nl 1 2 3 4\n") {
+        t.Errorf("strip not written as one line:\n%s", buffer.String())
+    }
+}
+/*  End of TestWriteOBJStrips.                                                */
+
+/*  An error from the writer is returned.                                     */
+func TestWriteOBJWriterError(t *testing.T) {
+    var canvas *Canvas = newGraphCanvas(t, saddle, WithGrid(4, 3))
+
+    var err error = WriteOBJ(canvas, failingWriter{})
+
+    if !errors.Is(err, errFailingWriter) {
+        t.Errorf("got %v, want the error of the writer", err)
+    }
+}
+/*  End of TestWriteOBJWriterError.                                           */
@@ -40,10 +40,10 @@ func surface(x, y float32) float32 {
 }
 /*  End of surface.                                                           */
 
-/*  Wrapper for the Go function MakeRectangularWireframe.                     */
+/*  Wrapper for the Go function MakeRectangularWireframe. Returns a result    *
+ *  object with the id of the canvas that was set up.                         */
 func setupMesh(this js.Value, args []js.Value) interface{} {
-    jsbindings.MakeRectangularWireframe(args, surface)
-    return nil
+    return jsbindings.MakeRectangularWireframe(args, surface)
 }
 /*  End of setupMesh.                                                         */
 